@@ -317,6 +317,13 @@ config:
   companyName: {{ .companyName }}
   delayInSeconds: {{ .delayInSeconds }}
   synchronize: {{ .synchronize }}
+  roleArn: {{ .roleArn | quote }}
+  externalId: {{ .externalId | quote }}
+  requirementsAnnotation: {{ .requirementsAnnotation | quote }}
+  {{- with .routes }}
+  routes:
+  {{- toYaml . | nindent 4 }}
+  {{- end }}
 {{ include "target" . }}
 {{- end }}
 
@@ -327,3 +334,161 @@ config:
   prefix: {{ .prefix }}
 {{ include "target" . }}
 {{- end }}
+
+{{- define "target.sns" -}}
+config:
+  accessKeyId: {{ .accessKeyId | quote }}
+  secretAccessKey: {{ .secretAccessKey | quote }}
+  region: {{ .region }}
+  endpoint: {{ .endpoint }}
+  topicArn: {{ .topicArn }}
+{{ include "target" . }}
+{{- end }}
+
+{{- define "target.sqs" -}}
+config:
+  accessKeyId: {{ .accessKeyId | quote }}
+  secretAccessKey: {{ .secretAccessKey | quote }}
+  region: {{ .region }}
+  endpoint: {{ .endpoint }}
+  queueUrl: {{ .queueUrl }}
+{{ include "target" . }}
+{{- end }}
+
+{{- define "target.defectdojo" -}}
+config:
+  host: {{ .host | quote }}
+  apiKey: {{ .apiKey | quote }}
+  certificate: {{ .certificate | quote }}
+  skipTLS: {{ .skipTLS }}
+  {{- with .headers }}
+  headers:
+    {{- toYaml . | nindent 4 }}
+  {{- end }}
+  productType: {{ .productType | quote }}
+  productTemplate: {{ .productTemplate | quote }}
+  engagementTemplate: {{ .engagementTemplate | quote }}
+{{ include "target" . }}
+{{- end }}
+
+{{- define "target.mattermost" -}}
+config:
+  webhook: {{ .webhook | quote }}
+  channel: {{ .channel | quote }}
+  username: {{ .username | quote }}
+  iconUrl: {{ .iconUrl | quote }}
+  certificate: {{ .certificate | quote }}
+  skipTLS: {{ .skipTLS }}
+  {{- with .headers }}
+  headers:
+    {{- toYaml . | nindent 4 }}
+  {{- end }}
+  {{- if .keepalive }}
+  keepalive:
+    interval: {{ .keepalive.interval | quote }}
+    {{- if .keepalive.params }}
+    params:
+      {{- toYaml .keepalive.params | nindent 6 }}
+    {{- end }}
+  {{- end }}
+{{ include "target" . }}
+{{- end }}
+
+{{- define "target.rocketchat" -}}
+config:
+  webhook: {{ .webhook | quote }}
+  channel: {{ .channel | quote }}
+  alias: {{ .alias | quote }}
+  avatar: {{ .avatar | quote }}
+  certificate: {{ .certificate | quote }}
+  skipTLS: {{ .skipTLS }}
+  {{- with .headers }}
+  headers:
+    {{- toYaml . | nindent 4 }}
+  {{- end }}
+  {{- if .keepalive }}
+  keepalive:
+    interval: {{ .keepalive.interval | quote }}
+    {{- if .keepalive.params }}
+    params:
+      {{- toYaml .keepalive.params | nindent 6 }}
+    {{- end }}
+  {{- end }}
+{{ include "target" . }}
+{{- end }}
+
+{{- define "target.webex" -}}
+config:
+  token: {{ .token | quote }}
+  roomId: {{ .roomId | quote }}
+  host: {{ .host | quote }}
+  certificate: {{ .certificate | quote }}
+  skipTLS: {{ .skipTLS }}
+  {{- with .headers }}
+  headers:
+    {{- toYaml . | nindent 4 }}
+  {{- end }}
+  {{- if .keepalive }}
+  keepalive:
+    interval: {{ .keepalive.interval | quote }}
+    {{- if .keepalive.params }}
+    params:
+      {{- toYaml .keepalive.params | nindent 6 }}
+    {{- end }}
+  {{- end }}
+{{ include "target" . }}
+{{- end }}
+
+{{- define "target.matrix" -}}
+config:
+  host: {{ .host | quote }}
+  accessToken: {{ .accessToken | quote }}
+  roomId: {{ .roomId | quote }}
+  certificate: {{ .certificate | quote }}
+  skipTLS: {{ .skipTLS }}
+  {{- with .headers }}
+  headers:
+    {{- toYaml . | nindent 4 }}
+  {{- end }}
+  {{- if .keepalive }}
+  keepalive:
+    interval: {{ .keepalive.interval | quote }}
+    {{- if .keepalive.params }}
+    params:
+      {{- toYaml .keepalive.params | nindent 6 }}
+    {{- end }}
+  {{- end }}
+{{ include "target" . }}
+{{- end }}
+
+{{- define "target.kubernetesevents" -}}
+config:
+  component: {{ .component | quote }}
+  burstSize: {{ .burstSize | default 0 }}
+{{ include "target" . }}
+{{- end }}
+
+{{- define "target.servicenow" -}}
+config:
+  host: {{ .host | quote }}
+  table: {{ .table | quote }}
+  username: {{ .username | quote }}
+  password: {{ .password | quote }}
+  clientId: {{ .clientId | quote }}
+  clientSecret: {{ .clientSecret | quote }}
+  certificate: {{ .certificate | quote }}
+  skipTLS: {{ .skipTLS }}
+  {{- with .headers }}
+  headers:
+    {{- toYaml . | nindent 4 }}
+  {{- end }}
+  {{- with .fields }}
+  fields:
+    {{- toYaml . | nindent 4 }}
+  {{- end }}
+  {{- with .resolveFields }}
+  resolveFields:
+    {{- toYaml . | nindent 4 }}
+  {{- end }}
+{{ include "target" . }}
+{{- end }}
@@ -0,0 +1,211 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/uptrace/bun/dialect"
+	"go.uber.org/zap"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/kyverno/policy-reporter/pkg/config"
+	"github.com/kyverno/policy-reporter/pkg/database"
+)
+
+func newDatabaseCMD(version string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "db",
+		Short: "Manage the Policy Reporter database",
+	}
+
+	// For local usage
+	cmd.PersistentFlags().StringP("kubeconfig", "k", "", "absolute path to the kubeconfig file, used to resolve the database secretRef")
+	cmd.PersistentFlags().StringP("config", "c", "", "target configuration file")
+	cmd.AddCommand(newMigrateCMD(version))
+	cmd.AddCommand(newExportCMD(version))
+	cmd.AddCommand(newImportCMD(version))
+
+	return cmd
+}
+
+func newMigrateCMD(version string) *cobra.Command {
+	var dryRun bool
+
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Apply all pending schema migrations to the configured database",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, logger, store, err := loadDatabaseStore(cmd, version, false)
+			if err != nil {
+				return err
+			}
+
+			pending, err := store.PendingMigrations(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			if len(pending) == 0 {
+				logger.Info("database schema is up to date", zap.String("type", c.Database.Type))
+				return nil
+			}
+
+			if dryRun {
+				for _, m := range pending {
+					logger.Info("pending database migration", zap.Int("version", m.Version), zap.String("name", m.Name))
+				}
+				return nil
+			}
+
+			applied, err := store.Migrate(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			logger.Info("database migrated", zap.Int("applied", len(applied)))
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "list pending migrations without applying them")
+
+	return cmd
+}
+
+func newExportCMD(version string) *cobra.Command {
+	var file string
+
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export all reports, results and the history of trends, findings and result events into a compressed NDJSON archive",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			_, logger, store, err := loadDatabaseStore(cmd, version, true)
+			if err != nil {
+				return err
+			}
+
+			out, err := os.Create(file)
+			if err != nil {
+				return err
+			}
+			defer out.Close()
+
+			stats, err := store.Export(cmd.Context(), out)
+			if err != nil {
+				return err
+			}
+
+			logger.Info("database exported", zap.String("file", file), zap.Any("rows", stats))
+
+			return out.Close()
+		},
+	}
+
+	cmd.Flags().StringVarP(&file, "file", "f", "policy-reporter.ndjson.gz", "path of the created archive")
+	cmd.Flags().StringP("dbfile", "d", "sqlite-database-v2.db", "path to the SQLite DB File, used if no external database is configured")
+
+	return cmd
+}
+
+func newImportCMD(version string) *cobra.Command {
+	var file string
+
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "Import an archive created by db export into an empty database",
+		Long: `Import an archive created by db export into an empty database.
+		The database is migrated to the current schema version, which has to match the schema version of the archive.
+		Reports are resynchronized from the cluster on the next start, the history of trends, findings and result events is kept.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			_, logger, store, err := loadDatabaseStore(cmd, version, false)
+			if err != nil {
+				return err
+			}
+
+			in, err := os.Open(file)
+			if err != nil {
+				return err
+			}
+			defer in.Close()
+
+			if _, err := store.Migrate(cmd.Context()); err != nil {
+				return err
+			}
+
+			stats, err := store.Import(cmd.Context(), in)
+			if err != nil {
+				return err
+			}
+
+			logger.Info("database imported", zap.String("file", file), zap.Any("rows", stats))
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&file, "file", "f", "policy-reporter.ndjson.gz", "path of the imported archive")
+
+	return cmd
+}
+
+// loadDatabaseStore connects to the configured external database. With sqlite enabled the existing
+// SQLite database file is used as fallback, it is opened without recreating it.
+func loadDatabaseStore(cmd *cobra.Command, version string, sqlite bool) (*config.Config, *zap.Logger, *database.Store, error) {
+	c, err := config.Load(cmd)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	c.Version = version
+
+	logger, err := config.SetupLogger(c)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to setup logger: %w", err)
+	}
+
+	switch c.Database.Type {
+	case database.MySQL, database.MariaDB, database.PostgreSQL:
+	default:
+		if !sqlite {
+			return nil, nil, nil, errors.New("an external database is required, the SQLite database is recreated on each start")
+		}
+
+		db, err := database.OpenSQLiteDB(c.DBFile)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("failed to open SQLite database: %w", err)
+		}
+
+		store, err := database.NewStore(db, c.Version)
+
+		return c, logger, store, err
+	}
+
+	// the kubernetes client is only required to read the database secretRef
+	k8sConfig := &rest.Config{}
+	if c.K8sClient.Kubeconfig != "" {
+		k8sConfig, err = clientcmd.BuildConfigFromFlags("", c.K8sClient.Kubeconfig)
+	} else if c.Database.SecretRef != "" {
+		k8sConfig, err = rest.InClusterConfig()
+	}
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	resolver := config.NewResolver(c, k8sConfig)
+
+	// the resolver falls back to SQLite if the configured database is not reachable
+	db := resolver.Database()
+	if db == nil || db.Dialect().Name() == dialect.SQLite {
+		return nil, nil, nil, errors.New("unable to create database connection")
+	}
+
+	store, err := resolver.Store(db)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return c, logger, store, nil
+}
@@ -16,6 +16,7 @@ func NewCLI(version string) *cobra.Command {
 	rootCmd.AddCommand(newVersionCMD(version))
 	rootCmd.AddCommand(newRunCMD(version))
 	rootCmd.AddCommand(newSendCMD())
+	rootCmd.AddCommand(newDatabaseCMD(version))
 
 	return rootCmd
 }
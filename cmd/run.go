@@ -5,10 +5,12 @@ import (
 	"errors"
 	"flag"
 	"fmt"
+	"time"
 
 	"github.com/spf13/cobra"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 
@@ -17,8 +19,10 @@ import (
 	v2 "github.com/kyverno/policy-reporter/pkg/api/v2"
 	"github.com/kyverno/policy-reporter/pkg/config"
 	"github.com/kyverno/policy-reporter/pkg/database"
+	"github.com/kyverno/policy-reporter/pkg/hub"
 	"github.com/kyverno/policy-reporter/pkg/listener"
 	"github.com/kyverno/policy-reporter/pkg/report"
+	"github.com/kyverno/policy-reporter/pkg/summary"
 )
 
 func newRunCMD(version string) *cobra.Command {
@@ -56,6 +60,10 @@ func newRunCMD(version string) *cobra.Command {
 			readinessProbe := config.NewReadinessProbe(c)
 
 			resolver := config.NewResolver(c, k8sConfig)
+			if _, err := resolver.Router(); err != nil {
+				return fmt.Errorf("invalid routing configuration: %w", err)
+			}
+
 			var wgClient, orClient report.PolicyReportClient
 
 			orClient, err = resolver.OpenReportsClient()
@@ -71,6 +79,11 @@ func newRunCMD(version string) *cobra.Command {
 				return errors.New("no valid reporting API group found in the cluster")
 			}
 
+			clusterClients, err := resolver.ClusterClients(cmd.Context())
+			if err != nil {
+				return err
+			}
+
 			secretInformer, err := resolver.SecretInformer()
 			if err != nil {
 				return err
@@ -79,6 +92,10 @@ func newRunCMD(version string) *cobra.Command {
 			g := &errgroup.Group{}
 
 			var store *database.Store
+			var trends *database.TrendRecorder
+			var replica *database.ReplicaMonitor
+			var findings report.FindingStore
+			var tracker *database.FindingTracker
 			servOptions := []api.ServerOption{
 				api.WithPort(c.API.Port),
 				api.WithHealthChecks([]api.HealthCheck{
@@ -120,18 +137,79 @@ func newRunCMD(version string) *cobra.Command {
 					return err
 				}
 
+				if replicaDB := resolver.ReplicaDatabase(); replicaDB != nil {
+					defer replicaDB.Close()
+
+					logger.Info("read replica enabled")
+					replica = resolver.ReplicaMonitor(store, replicaDB)
+
+					g.Go(func() error {
+						return replica.Run(cmd.Context())
+					})
+				}
+
+				if c.Trends.Enabled {
+					logger.Info("trend snapshots enabled")
+					trends = resolver.TrendRecorder(store)
+
+					g.Go(func() error {
+						return trends.Run(cmd.Context())
+					})
+				}
+
+				if c.Findings.Enabled || c.AuditLog.Enabled {
+					logger.Info("finding tracking enabled", zap.Bool("auditLog", c.AuditLog.Enabled))
+					tracker = resolver.FindingTracker(store)
+					findings = tracker
+				}
+
 				if !c.LeaderElection.Enabled || store.IsSQLite() {
-					store.PrepareDatabase(cmd.Context())
-					resolver.RegisterStoreListener(cmd.Context(), store)
+					if err := store.PrepareDatabase(cmd.Context()); err != nil {
+						return fmt.Errorf("failed to prepare database: %w", err)
+					}
+					resolver.RegisterStoreListener(cmd.Context(), store, findings)
+
+					if trends != nil {
+						trends.Start()
+					}
+
+					if replica != nil {
+						replica.Start()
+					}
 				}
 
 				logger.Info("REST api enabled")
 				servOptions = append(servOptions, v1.WithAPI(store, resolver.TargetClients(), resolver.ViolationsReporter()), v2.WithAPI(store, nsClient, c.Targets))
+
+				if c.CRD.Silence {
+					servOptions = append(servOptions, v2.WithSilences(resolver.Silences()))
+				}
+
+				if c.Hub.Ingest.Enabled {
+					logger.Info("hub ingest api enabled")
+					servOptions = append(servOptions, hub.WithIngest(resolver.HubTokens(), resolver.EventPublisher(), listener.Store, store))
+				}
+			}
+
+			hubClient := resolver.HubClient()
+			startHubAgent := func(ctx context.Context) {
+				if hubClient == nil {
+					return
+				}
+
+				logger.Info("push reports to hub", zap.String("cluster", c.Hub.Cluster))
+				if err := hubClient.Reset(ctx); err != nil {
+					logger.Error("failed to reset cluster reports on hub", zap.Error(err))
+				}
+
+				resolver.RegisterHubListener(hubClient)
 			}
 
 			if c.Metrics.Enabled {
 				logger.Info("metrics enabled")
-				resolver.RegisterMetricsListener()
+				if err := resolver.RegisterMetricsListener(); err != nil {
+					return err
+				}
 				servOptions = append(servOptions, api.WithMetrics())
 			}
 
@@ -140,6 +218,19 @@ func newRunCMD(version string) *cobra.Command {
 				servOptions = append(servOptions, api.WithProfiling())
 			}
 
+			var summaryManager *summary.Manager
+			if c.StatusSummary.Enabled {
+				logger.Info("namespace status summary enabled")
+				if err := resolver.RegisterSummaryListener(); err != nil {
+					return err
+				}
+
+				summaryManager, err = resolver.SummaryManager()
+				if err != nil {
+					return err
+				}
+			}
+
 			if !resolver.ResultCache().Shared() {
 				logger.Debug("register new result listener")
 				resolver.RegisterNewResultsListener()
@@ -155,23 +246,43 @@ func newRunCMD(version string) *cobra.Command {
 					logger.Info("started leadership")
 
 					if c.REST.Enabled && !store.IsSQLite() {
-						store.PrepareDatabase(cmd.Context())
+						if err := store.PrepareDatabase(cmd.Context()); err != nil {
+							logger.Error("failed to prepare database", zap.Error(err))
+						}
 						logger.Debug("register database persistence")
-						resolver.RegisterStoreListener(ctx, store)
+						resolver.RegisterStoreListener(ctx, store, findings)
 
-						if readinessProbe.Running() {
-							logger.Debug("trigger informer restart")
-							if orClient != nil {
-								orClient.Stop()
-							}
+						if trends != nil {
+							trends.Start()
+						}
 
-							if wgClient != nil {
-								wgClient.Stop()
-							}
+						if replica != nil {
+							replica.Start()
 						}
 					}
 
 					resolver.RegisterSendResultListener()
+					startHubAgent(ctx)
+
+					// the new leader has to persist all reports and push them to the reset hub, resync them with an informer restart
+					if readinessProbe.Running() && ((c.REST.Enabled && !store.IsSQLite()) || hubClient != nil) {
+						logger.Debug("trigger informer restart")
+						if orClient != nil {
+							orClient.Stop()
+						}
+
+						if wgClient != nil {
+							wgClient.Stop()
+						}
+
+						for _, client := range clusterClients {
+							client.Stop()
+						}
+					}
+
+					if summaryManager != nil {
+						summaryManager.Start()
+					}
 
 					readinessProbe.Ready()
 				}).RegisterOnNew(func(currentID, lockID string) {
@@ -185,11 +296,27 @@ func newRunCMD(version string) *cobra.Command {
 
 					if !store.IsSQLite() {
 						resolver.EventPublisher().UnregisterListener(listener.Store)
+
+						if trends != nil {
+							trends.Stop()
+						}
+
+						if replica != nil {
+							replica.Stop()
+						}
 					}
 
 					if resolver.HasTargets() {
 						resolver.UnregisterSendResultListener()
 					}
+
+					if hubClient != nil {
+						resolver.EventPublisher().UnregisterListener(listener.Hub)
+					}
+
+					if summaryManager != nil {
+						summaryManager.Stop()
+					}
 				})
 
 				g.Go(func() error {
@@ -197,6 +324,12 @@ func newRunCMD(version string) *cobra.Command {
 				})
 			} else {
 				resolver.RegisterSendResultListener()
+				startHubAgent(cmd.Context())
+
+				if summaryManager != nil {
+					summaryManager.Start()
+				}
+
 				readinessProbe.Ready()
 			}
 
@@ -224,6 +357,24 @@ func newRunCMD(version string) *cobra.Command {
 					return nil
 				})
 			}
+			if c.CRD.Silence {
+				g.Go(func() error {
+					stop := make(chan struct{})
+					client, err := resolver.SilenceClient()
+					if err != nil {
+						return err
+					}
+
+					if err := client.Run(stop); err != nil {
+						logger.Error("silence informer error", zap.Error(err))
+						return err
+					}
+
+					<-stop
+
+					return nil
+				})
+			}
 			if wgClient != nil {
 				g.Go(func() error {
 					logger.Info("wait for wgpolicy informer")
@@ -258,6 +409,32 @@ func newRunCMD(version string) *cobra.Command {
 				})
 			}
 
+			for _, client := range clusterClients {
+				g.Go(func() error {
+					readinessProbe.Wait()
+
+					for {
+						stop := make(chan struct{})
+						if err := client.Run(c.WorkerCount, stop); err != nil {
+							logger.Error("cluster informer client error", zap.Error(err))
+						}
+
+						logger.Debug("cluster informer restarts")
+					}
+				})
+			}
+
+			if tracker != nil {
+				g.Go(func() error {
+					readinessProbe.Wait()
+
+					clients := append([]report.PolicyReportClient{wgClient, orClient}, clusterClients...)
+					resolveOrphanedFindings(cmd.Context(), logger, tracker, resolver.EventPublisher(), clients)
+
+					return nil
+				})
+			}
+
 			g.Go(func() error {
 				collection := resolver.TargetClients()
 				if !c.CRD.TargetConfig && !collection.UsesSecrets() {
@@ -304,3 +481,39 @@ func newRunCMD(version string) *cobra.Command {
 
 	return cmd
 }
+
+// resolveOrphanedFindings resolves the open findings of reports deleted while no instance persisted the report events.
+// It waits until the initial reports of all clients are processed and only runs if this instance persists the reports.
+func resolveOrphanedFindings(ctx context.Context, logger *zap.Logger, tracker *database.FindingTracker, publisher report.EventPublisher, clients []report.PolicyReportClient) {
+	idle := 0
+
+	// the queue is checked twice in a row to not miss reports between dequeue and processing
+	err := wait.PollUntilContextCancel(ctx, 5*time.Second, false, func(context.Context) (bool, error) {
+		for _, client := range clients {
+			if client != nil && !client.Idle() {
+				idle = 0
+				return false, nil
+			}
+		}
+
+		idle++
+
+		return idle > 1, nil
+	})
+	if err != nil {
+		return
+	}
+
+	if _, ok := publisher.GetListener()[listener.Store]; !ok {
+		logger.Debug("skip orphaned findings, reports are persisted by the leader")
+		return
+	}
+
+	resolved, err := tracker.ResolveOrphanedFindings(ctx)
+	if err != nil {
+		logger.Error("failed to resolve findings of deleted reports", zap.Error(err))
+		return
+	}
+
+	logger.Info("resolved findings of deleted reports", zap.Int("findings", resolved))
+}
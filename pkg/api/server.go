@@ -41,6 +41,11 @@ func (s *Server) Register(path string, handler Handler) error {
 	return handler.Register(s.engine.Group(path, s.middleware...))
 }
 
+// RegisterPublic registers the handler without the server middleware, the handler authenticates its requests itself
+func (s *Server) RegisterPublic(path string, handler Handler) error {
+	return handler.Register(s.engine.Group(path))
+}
+
 func NewServer(engine *gin.Engine, options ...ServerOption) *Server {
 	server := &Server{
 		engine: engine,
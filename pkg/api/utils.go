@@ -58,7 +58,13 @@ func BuildFilter(ctx *gin.Context) db.Filter {
 		id = ctx.Query("id")
 	}
 
+	var suppressed *bool
+	if value, err := strconv.ParseBool(ctx.Query("suppressed")); err == nil {
+		suppressed = &value
+	}
+
 	return db.Filter{
+		Clusters:     ctx.QueryArray("clusters"),
 		Namespaces:   ctx.QueryArray("namespaces"),
 		Kinds:        ctx.QueryArray("kinds"),
 		Resources:    ctx.QueryArray("resources"),
@@ -74,6 +80,7 @@ func BuildFilter(ctx *gin.Context) db.Filter {
 		ResourceID:   id,
 		Exclude:      exclude,
 		Namespaced:   ctx.Query("namespaced") == "true",
+		Suppressed:   suppressed,
 	}
 }
 
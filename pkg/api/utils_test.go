@@ -12,6 +12,7 @@ import (
 
 	"github.com/kyverno/policy-reporter/pkg/api"
 	db "github.com/kyverno/policy-reporter/pkg/database"
+	"github.com/kyverno/policy-reporter/pkg/helper"
 )
 
 func TestSendResponseSuccess(t *testing.T) {
@@ -59,7 +60,7 @@ func TestBuildFilter(t *testing.T) {
 	filter := api.BuildFilter(&gin.Context{
 		Request: &http.Request{
 			URL: &url.URL{
-				RawQuery: "labels=env:test&labels=app:nginx&labels=invalid&exclude=kyverno:Pod&exclude=kyverno:Job&exclude=kyverno&status=pass&namespaced=true",
+				RawQuery: "labels=env:test&labels=app:nginx&labels=invalid&exclude=kyverno:Pod&exclude=kyverno:Job&exclude=kyverno&status=pass&namespaced=true&suppressed=false",
 			},
 		},
 	})
@@ -75,6 +76,7 @@ func TestBuildFilter(t *testing.T) {
 		},
 		Status:     []string{"pass"},
 		Namespaced: true,
+		Suppressed: helper.ToPointer(false),
 	}, filter)
 }
 
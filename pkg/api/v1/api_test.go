@@ -23,7 +23,7 @@ import (
 	"github.com/kyverno/policy-reporter/pkg/target/webhook"
 )
 
-var reconditioner = result.NewReconditioner(nil)
+var reconditioner = result.NewReconditioner(nil, nil, nil)
 
 func TestV1(t *testing.T) {
 	t.Parallel()
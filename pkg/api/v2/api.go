@@ -3,6 +3,8 @@ package v2
 import (
 	"errors"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"go.uber.org/zap"
@@ -16,6 +18,8 @@ import (
 
 var defaultOrder = []string{"resource_namespace", "resource_name", "resource_uid", "policy", "rule", "message"}
 
+var eventOrder = []string{"created", "id"}
+
 type APIHandler struct {
 	store    *db.Store
 	nsClient namespaces.Client
@@ -31,6 +35,7 @@ func (h *APIHandler) Register(engine *gin.RouterGroup) error {
 	engine.GET("resource/:id/source-categories", h.ListResourceCategories)
 
 	engine.POST("namespaces/resolve-selector", h.ResolveNamespaceSelector)
+	engine.GET("clusters", h.ListClusters)
 	engine.GET("namespaces", h.ListNamespaces)
 	engine.GET("sources", h.ListSources)
 	engine.GET("sources/:source/use-resources", h.UseResources)
@@ -39,11 +44,16 @@ func (h *APIHandler) Register(engine *gin.RouterGroup) error {
 	engine.GET("sources/categories", h.ListSourceWithCategories)
 	engine.GET("policies", h.ListPolicies)
 	engine.GET("findings", h.ListFindings)
+	engine.GET("findings/age", h.ListFindingAges)
+	engine.GET("findings/mttr", h.ListFindingRemediations)
 	engine.GET("severity-findings", h.ListSeverityFindings)
 	engine.GET("results-without-resources", h.ListResultsWithoutResource)
 	engine.GET("targets", h.ListTargets)
 	engine.GET("properties/:property", h.ListProperty)
 	engine.GET("total-results", h.ListTotalResults)
+	engine.GET("trends", h.ListTrends)
+	engine.GET("result-events", h.ListResultEvents)
+	engine.GET("result-events/csv", h.ExportResultEvents)
 
 	ns := engine.Group("namespace-scoped")
 	ns.GET("resource-results", h.ListNamespaceResourceResults)
@@ -97,6 +107,12 @@ func (h *APIHandler) ResolveNamespaceSelector(ctx *gin.Context) {
 	api.SendResponse(ctx, results, "failed to get namespaces for the provided selector", err)
 }
 
+func (h *APIHandler) ListClusters(ctx *gin.Context) {
+	list, err := h.store.FetchClusters(ctx)
+
+	api.SendResponse(ctx, list, "failed to load clusters", err)
+}
+
 func (h *APIHandler) ListNamespaces(ctx *gin.Context) {
 	list, err := h.store.FetchNamespaces(ctx, api.BuildFilter(ctx))
 
@@ -315,6 +331,88 @@ func (h *APIHandler) ListSeverityFindings(ctx *gin.Context) {
 	api.SendResponse(ctx, MapSeverityFindings(results), "failed to load findings", err)
 }
 
+func (h *APIHandler) ListTrends(ctx *gin.Context) {
+	group := ctx.DefaultQuery("groupBy", "status")
+	if _, ok := db.TrendGroups[group]; !ok {
+		ctx.AbortWithError(http.StatusBadRequest, errors.New("unsupported groupBy value"))
+		return
+	}
+
+	to := parseTime(ctx.Query("to"), time.Now())
+	from := parseTime(ctx.Query("from"), to.AddDate(0, 0, -30))
+
+	results, err := h.store.FetchTrends(ctx, group, api.BuildFilter(ctx), from, to)
+
+	api.SendResponse(ctx, MapTrends(results), "failed to load trends", err)
+}
+
+func (h *APIHandler) ListFindingAges(ctx *gin.Context) {
+	group := ctx.DefaultQuery("groupBy", "namespace")
+	if _, ok := db.FindingGroups[group]; !ok {
+		ctx.AbortWithError(http.StatusBadRequest, errors.New("unsupported groupBy value"))
+		return
+	}
+
+	results, err := h.store.FetchFindingAges(ctx, group, api.BuildFilter(ctx))
+
+	api.SendResponse(ctx, MapFindingAges(results), "failed to load finding ages", err)
+}
+
+func (h *APIHandler) ListFindingRemediations(ctx *gin.Context) {
+	group := ctx.DefaultQuery("groupBy", "namespace")
+	if _, ok := db.FindingGroups[group]; !ok {
+		ctx.AbortWithError(http.StatusBadRequest, errors.New("unsupported groupBy value"))
+		return
+	}
+
+	from := parseTime(ctx.Query("from"), time.Now().AddDate(0, 0, -90))
+
+	results, err := h.store.FetchFindingRemediations(ctx, group, api.BuildFilter(ctx), from)
+
+	api.SendResponse(ctx, MapFindingRemediations(results), "failed to load finding remediation times", err)
+}
+
+func (h *APIHandler) ListResultEvents(ctx *gin.Context) {
+	filter := buildEventFilter(ctx)
+
+	list, err := h.store.FetchResultEvents(ctx, filter, api.BuildPagination(ctx, eventOrder))
+	if err != nil {
+		zap.L().Error("failed to load result events", zap.Error(err))
+		ctx.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+	count, err := h.store.CountResultEvents(ctx, filter)
+
+	api.SendResponse(ctx, Paginated[ResultEvent]{Count: count, Items: MapResultEvents(list)}, "failed to load result events", err)
+}
+
+// ExportResultEvents streams all matching events ordered by creation, the status is sent before the first batch is loaded
+func (h *APIHandler) ExportResultEvents(ctx *gin.Context) {
+	ctx.Header("Content-Disposition", `attachment; filename="result-events.csv"`)
+	ctx.Header("Content-Type", "text/csv")
+	ctx.Status(http.StatusOK)
+
+	writer, err := NewResultEventsCSV(ctx.Writer)
+	if err == nil {
+		err = h.store.StreamResultEvents(ctx, buildEventFilter(ctx), api.BuildPagination(ctx, eventOrder).Direction, writer.Write)
+	}
+	if err != nil {
+		zap.L().Error("failed to export result events", zap.Error(err))
+	}
+}
+
+func buildEventFilter(ctx *gin.Context) db.EventFilter {
+	filter := db.EventFilter{Filter: api.BuildFilter(ctx), Types: ctx.QueryArray("types")}
+	if from := parseTime(ctx.Query("from"), time.Time{}); !from.IsZero() {
+		filter.From = from.Unix()
+	}
+	if to := parseTime(ctx.Query("to"), time.Time{}); !to.IsZero() {
+		filter.To = to.Unix()
+	}
+
+	return filter
+}
+
 func (h *APIHandler) ListTargets(ctx *gin.Context) {
 	api.SendResponse(ctx, h.targets, "failed to load findings", nil)
 }
@@ -332,3 +430,20 @@ func WithAPI(store *db.Store, client namespaces.Client, targets target.Targets)
 		return s.Register("v2", NewAPIHandler(store, client, MapConfigTargets(targets)))
 	}
 }
+
+// parseTime parses unix timestamps in seconds or RFC3339 values, the fallback is returned for empty or invalid values
+func parseTime(value string, fallback time.Time) time.Time {
+	if value == "" {
+		return fallback
+	}
+
+	if seconds, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return time.Unix(seconds, 0)
+	}
+
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t
+	}
+
+	return fallback
+}
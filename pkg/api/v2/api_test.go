@@ -4,8 +4,11 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -57,7 +60,7 @@ func newFakeClient() v1.NamespaceInterface {
 	).CoreV1().Namespaces()
 }
 
-var reconditioner = result.NewReconditioner(nil)
+var reconditioner = result.NewReconditioner(nil, nil, nil)
 
 func TestV2(t *testing.T) {
 	t.Parallel()
@@ -79,6 +82,22 @@ func TestV2(t *testing.T) {
 	store.Add(context.Background(), reconditioner.Prepare(&openreports.ReportAdapter{Report: fixtures.KyvernoPolicyReport}))
 	store.Add(context.Background(), reconditioner.Prepare(&openreports.ClusterReportAdapter{ClusterReport: fixtures.KyvernoClusterPolicyReport}))
 
+	trends := database.NewTrendRecorder(store, time.Hour, 0)
+	trends.Record(context.Background(), time.Unix(1700000000, 0))
+	trends.Start()
+	trends.Record(context.Background(), time.Unix(1700003600, 0))
+	trends.Record(context.Background(), time.Unix(1700007200, 0))
+
+	resolved := fixtures.KyvernoPolicyReport.DeepCopy()
+	resolved.Results = nil
+
+	started := time.Now().Unix()
+
+	findings := database.NewFindingTracker(store, "team").WithAuditLog(0)
+	findings.UpdateFindings(context.Background(), reconditioner.Prepare(fixtures.DefaultPolicyReport))
+	findings.UpdateFindings(context.Background(), reconditioner.Prepare(&openreports.ReportAdapter{Report: fixtures.KyvernoPolicyReport}))
+	findings.UpdateFindings(context.Background(), &openreports.ReportAdapter{Report: resolved})
+
 	client := namespaces.NewClient(newFakeClient(), gocache.New[string, []string](time.Second, time.Second))
 
 	gin.SetMode(gin.ReleaseMode)
@@ -419,6 +438,140 @@ func TestV2(t *testing.T) {
 		}
 	})
 
+	t.Run("ListTrends", func(t *testing.T) {
+		t.Parallel()
+		req, _ := http.NewRequest("GET", "/v2/trends?groupBy=status&namespaces=kyverno&from=1699990000&to=1700010000", nil)
+		w := httptest.NewRecorder()
+
+		server.Serve(w, req)
+
+		if ok := assert.Equal(t, http.StatusOK, w.Code); ok {
+			resp := v2.Trends{}
+
+			json.NewDecoder(w.Body).Decode(&resp)
+
+			assert.Equal(t, []int64{1700002800, 1700006400}, resp.Timestamps, "snapshots are truncated to the resolution and only recorded when started")
+			assert.Equal(t, []v2.TrendSeries{{Name: "pass", Values: []int{1, 1}}, {Name: "warn", Values: []int{1, 1}}}, resp.Series)
+		}
+	})
+
+	t.Run("ListTrends invalid group", func(t *testing.T) {
+		t.Parallel()
+		req, _ := http.NewRequest("GET", "/v2/trends?groupBy=kind", nil)
+		w := httptest.NewRecorder()
+
+		server.Serve(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("ListFindingAges", func(t *testing.T) {
+		t.Parallel()
+		req, _ := http.NewRequest("GET", "/v2/findings/age?groupBy=namespace&namespaces=test&namespaces=kyverno", nil)
+		w := httptest.NewRecorder()
+
+		server.Serve(w, req)
+
+		if ok := assert.Equal(t, http.StatusOK, w.Code); ok {
+			resp := make([]v2.FindingAge, 0)
+
+			json.NewDecoder(w.Body).Decode(&resp)
+
+			if assert.Len(t, resp, 1, "resolved findings should not be listed") {
+				assert.Equal(t, "test", resp[0].Name)
+				assert.Equal(t, 3, resp[0].Open)
+				assert.Equal(t, v2.FindingAgeBuckets{Day: 3}, resp[0].Buckets)
+			}
+		}
+	})
+
+	t.Run("ListFindingRemediations", func(t *testing.T) {
+		t.Parallel()
+		req, _ := http.NewRequest("GET", "/v2/findings/mttr?groupBy=policy&namespaces=test&namespaces=kyverno", nil)
+		w := httptest.NewRecorder()
+
+		server.Serve(w, req)
+
+		if ok := assert.Equal(t, http.StatusOK, w.Code); ok {
+			resp := make([]v2.FindingRemediation, 0)
+
+			json.NewDecoder(w.Body).Decode(&resp)
+
+			if assert.Len(t, resp, 1) {
+				assert.Equal(t, "required-limit", resp[0].Name)
+				assert.Equal(t, 1, resp[0].Resolved)
+			}
+		}
+	})
+
+	t.Run("ListResultEvents", func(t *testing.T) {
+		t.Parallel()
+		req, _ := http.NewRequest("GET", fmt.Sprintf("/v2/result-events?namespaces=kyverno&from=%d&page=1&offset=10", started), nil)
+		w := httptest.NewRecorder()
+
+		server.Serve(w, req)
+
+		if ok := assert.Equal(t, http.StatusOK, w.Code); ok {
+			resp := v2.Paginated[v2.ResultEvent]{}
+
+			json.NewDecoder(w.Body).Decode(&resp)
+
+			assert.Equal(t, 2, resp.Count)
+			if assert.Len(t, resp.Items, 2) {
+				assert.Equal(t, database.EventOpened, resp.Items[0].Type)
+				assert.Equal(t, database.EventResolved, resp.Items[1].Type)
+				assert.Equal(t, "required-limit", resp.Items[1].Policy)
+				assert.Equal(t, "nginx2", resp.Items[1].Name)
+			}
+		}
+	})
+
+	t.Run("ExportResultEvents", func(t *testing.T) {
+		t.Parallel()
+		req, _ := http.NewRequest("GET", fmt.Sprintf("/v2/result-events/csv?namespaces=kyverno&types=resolved&from=%d", started), nil)
+		w := httptest.NewRecorder()
+
+		server.Serve(w, req)
+
+		if ok := assert.Equal(t, http.StatusOK, w.Code); ok {
+			assert.Equal(t, "text/csv", w.Header().Get("Content-Type"))
+
+			lines := strings.Split(strings.TrimSpace(w.Body.String()), "\n")
+			if assert.Len(t, lines, 2) {
+				assert.True(t, strings.HasPrefix(lines[0], "id,timestamp,type,findingId,reportId"))
+				assert.Contains(t, lines[1], ",resolved,")
+			}
+		}
+	})
+
+	t.Run("ListFindingAges invalid group", func(t *testing.T) {
+		t.Parallel()
+		req, _ := http.NewRequest("GET", "/v2/findings/age?groupBy=kind", nil)
+		w := httptest.NewRecorder()
+
+		server.Serve(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("ListPolicyResults Suppressed", func(t *testing.T) {
+		t.Parallel()
+		for query, count := range map[string]int{"true": 0, "false": 2} {
+			req, _ := http.NewRequest("GET", "/v2/namespace-scoped/results?namespaces=kyverno&suppressed="+query, nil)
+			w := httptest.NewRecorder()
+
+			server.Serve(w, req)
+
+			if ok := assert.Equal(t, http.StatusOK, w.Code); ok {
+				resp := v2.Paginated[v2.PolicyResult]{}
+
+				json.NewDecoder(w.Body).Decode(&resp)
+
+				assert.Equal(t, count, resp.Count, "suppressed="+query)
+			}
+		}
+	})
+
 	t.Run("ListPolicyResults", func(t *testing.T) {
 		t.Parallel()
 		req, _ := http.NewRequest("GET", "/v2/cluster-scoped/results", nil)
@@ -531,3 +684,65 @@ func TestV2(t *testing.T) {
 		}
 	})
 }
+
+func TestV2Clusters(t *testing.T) {
+	t.Parallel()
+	db, err := database.NewSQLiteDB(filepath.Join(t.TempDir(), "db_v2_clusters.db"))
+	if err != nil {
+		assert.Fail(t, "failed to init SQLite DB")
+	}
+
+	store, err := database.NewStore(db, "1.0")
+	if err != nil {
+		assert.Fail(t, "failed to init Store")
+	}
+
+	if err := store.PrepareDatabase(context.Background()); err != nil {
+		assert.Fail(t, "failed to prepare Store")
+	}
+
+	store.Add(context.Background(), reconditioner.Prepare(&openreports.ReportAdapter{Report: fixtures.KyvernoPolicyReport.DeepCopy()}))
+	store.Add(context.Background(), reconditioner.WithCluster("cluster-a").Prepare(&openreports.ReportAdapter{Report: fixtures.KyvernoPolicyReport.DeepCopy()}))
+
+	client := namespaces.NewClient(newFakeClient(), gocache.New[string, []string](time.Second, time.Second))
+
+	gin.SetMode(gin.ReleaseMode)
+
+	server := api.NewServer(gin.New(), v2.WithAPI(store, client, target.Targets{}))
+
+	t.Run("ListClusters", func(t *testing.T) {
+		t.Parallel()
+		req, _ := http.NewRequest("GET", "/v2/clusters", nil)
+		w := httptest.NewRecorder()
+
+		server.Serve(w, req)
+
+		if ok := assert.Equal(t, http.StatusOK, w.Code); ok {
+			resp := make([]string, 0, 1)
+
+			json.NewDecoder(w.Body).Decode(&resp)
+
+			assert.Equal(t, []string{"cluster-a"}, resp)
+		}
+	})
+
+	t.Run("ListClusterResults", func(t *testing.T) {
+		t.Parallel()
+		req, _ := http.NewRequest("GET", "/v2/namespace-scoped/results?clusters=cluster-a", nil)
+		w := httptest.NewRecorder()
+
+		server.Serve(w, req)
+
+		if ok := assert.Equal(t, http.StatusOK, w.Code); ok {
+			resp := v2.Paginated[v2.PolicyResult]{}
+
+			json.NewDecoder(w.Body).Decode(&resp)
+
+			assert.Equal(t, len(fixtures.KyvernoPolicyReport.Results), resp.Count)
+			for _, item := range resp.Items {
+				assert.Equal(t, "cluster-a", item.Cluster)
+			}
+		}
+	})
+
+}
@@ -0,0 +1,45 @@
+package v2
+
+import (
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/kyverno/policy-reporter/pkg/api"
+	"github.com/kyverno/policy-reporter/pkg/helper"
+	"github.com/kyverno/policy-reporter/pkg/silence"
+)
+
+type SilenceHandler struct {
+	store *silence.Store
+}
+
+func (h *SilenceHandler) Register(engine *gin.RouterGroup) error {
+	engine.GET("silences", h.ListSilences)
+
+	return nil
+}
+
+// ListSilences returns the active silences, all=true includes expired and scheduled silences
+func (h *SilenceHandler) ListSilences(ctx *gin.Context) {
+	list := h.store.Active()
+	if ctx.Query("all") == "true" {
+		list = h.store.List()
+	}
+
+	now := time.Now()
+
+	api.SendResponse(ctx, helper.Map(list, func(s silence.Silence) Silence {
+		return MapSilence(s, now)
+	}), "failed to load silences", nil)
+}
+
+func NewSilenceHandler(store *silence.Store) *SilenceHandler {
+	return &SilenceHandler{store: store}
+}
+
+func WithSilences(store *silence.Store) api.ServerOption {
+	return func(s *api.Server) error {
+		return s.Register("v2", NewSilenceHandler(store))
+	}
+}
@@ -0,0 +1,73 @@
+package v2_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kyverno/policy-reporter/pkg/api"
+	v2 "github.com/kyverno/policy-reporter/pkg/api/v2"
+	"github.com/kyverno/policy-reporter/pkg/silence"
+	"github.com/kyverno/policy-reporter/pkg/validate"
+)
+
+func TestSilences(t *testing.T) {
+	t.Parallel()
+	schedule, _ := silence.NewSchedule("0 22 * * 6", 4*time.Hour, "")
+
+	store := silence.NewStore()
+	store.Add(silence.Silence{
+		Name:      "migration",
+		Matchers:  silence.Matchers{Namespaces: validate.RuleSets{Include: []string{"payments"}}},
+		CreatedBy: "jane",
+		Comment:   "database migration",
+	})
+	store.Add(silence.Silence{Name: "expired", EndsAt: time.Now().Add(-time.Hour)})
+	store.Add(silence.Silence{Name: "weekend", StartsAt: time.Now().Add(time.Hour), Schedule: schedule})
+
+	gin.SetMode(gin.ReleaseMode)
+
+	server := api.NewServer(gin.New(), v2.WithSilences(store))
+
+	t.Run("ListActiveSilences", func(t *testing.T) {
+		t.Parallel()
+		req, _ := http.NewRequest("GET", "/v2/silences", nil)
+		w := httptest.NewRecorder()
+
+		server.Serve(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		resp := make([]v2.Silence, 0, 1)
+		json.NewDecoder(w.Body).Decode(&resp)
+
+		assert.Len(t, resp, 1)
+		assert.Equal(t, "migration", resp[0].Name)
+		assert.Equal(t, []string{"payments"}, resp[0].Matchers.Namespaces)
+		assert.Equal(t, "jane", resp[0].CreatedBy)
+		assert.True(t, resp[0].Active)
+	})
+	t.Run("ListAllSilences", func(t *testing.T) {
+		t.Parallel()
+		req, _ := http.NewRequest("GET", "/v2/silences?all=true", nil)
+		w := httptest.NewRecorder()
+
+		server.Serve(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		resp := make([]v2.Silence, 0, 3)
+		json.NewDecoder(w.Body).Decode(&resp)
+
+		assert.Len(t, resp, 3)
+		assert.Equal(t, "expired", resp[0].Name)
+		assert.False(t, resp[0].Active)
+		assert.NotNil(t, resp[0].EndsAt)
+		assert.Equal(t, "4h0m0s", resp[2].Schedule.Duration)
+	})
+}
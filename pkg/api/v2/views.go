@@ -1,8 +1,14 @@
 package v2
 
 import (
+	"encoding/csv"
 	"fmt"
+	"io"
+	"math"
 	"net/url"
+	"sort"
+	"strconv"
+	"time"
 
 	"github.com/kyverno/policy-reporter/pkg/crd/api/policyreport/v1alpha2"
 	"github.com/kyverno/policy-reporter/pkg/crd/api/targetconfig"
@@ -10,7 +16,12 @@ import (
 	db "github.com/kyverno/policy-reporter/pkg/database"
 	"github.com/kyverno/policy-reporter/pkg/filters"
 	"github.com/kyverno/policy-reporter/pkg/helper"
+	"github.com/kyverno/policy-reporter/pkg/openreports"
+	"github.com/kyverno/policy-reporter/pkg/silence"
 	"github.com/kyverno/policy-reporter/pkg/target"
+	"github.com/kyverno/policy-reporter/pkg/target/events"
+	"github.com/kyverno/policy-reporter/pkg/target/servicenow"
+	"github.com/kyverno/policy-reporter/pkg/target/webex"
 )
 
 type StatusList struct {
@@ -376,6 +387,7 @@ func MapPolicies(results []db.PolicyReportFilter) []*Policy {
 
 type PolicyResult struct {
 	ID         string            `json:"id"`
+	Cluster    string            `json:"cluster,omitempty"`
 	Namespace  string            `json:"namespace,omitempty"`
 	Kind       string            `json:"kind"`
 	APIVersion string            `json:"apiVersion"`
@@ -390,17 +402,19 @@ type PolicyResult struct {
 	Severity   string            `json:"severity,omitempty"`
 	Timestamp  int64             `json:"timestamp,omitempty"`
 	Properties map[string]string `json:"properties,omitempty"`
+	Suppressed bool              `json:"suppressed,omitempty"`
 }
 
 func MapPolicyResults(results []db.PolicyReportResult) []PolicyResult {
 	return helper.Map(results, func(res db.PolicyReportResult) PolicyResult {
 		return PolicyResult{
 			ID:         res.ID,
+			Cluster:    res.Cluster,
 			Namespace:  res.Resource.Namespace,
 			Kind:       res.Resource.Kind,
 			APIVersion: res.Resource.APIVersion,
 			Name:       res.Resource.Name,
-			ResourceID: res.Resource.GetID(),
+			ResourceID: openreports.ClusterID(res.Cluster, res.Resource.GetID()),
 			Message:    res.Message,
 			Category:   res.Category,
 			Policy:     res.Policy,
@@ -410,6 +424,7 @@ func MapPolicyResults(results []db.PolicyReportResult) []PolicyResult {
 			Severity:   res.Severity,
 			Timestamp:  res.Created,
 			Properties: res.Properties,
+			Suppressed: res.Suppressed,
 		}
 	})
 }
@@ -478,6 +493,183 @@ func MapSeverityFindings(results []db.SeverityCount) Findings {
 	return Findings{Counts: helper.ToList(findings), Total: total, PerResult: totals}
 }
 
+type TrendSeries struct {
+	Name   string `json:"name"`
+	Values []int  `json:"values"`
+}
+
+type Trends struct {
+	Timestamps []int64       `json:"timestamps"`
+	Series     []TrendSeries `json:"series"`
+}
+
+// MapTrends maps the trend counts into series with a value for each snapshot time, missing values are 0
+func MapTrends(results []db.TrendCount) Trends {
+	trends := Trends{Timestamps: make([]int64, 0), Series: make([]TrendSeries, 0)}
+
+	index := make(map[int64]int)
+	for _, r := range results {
+		if _, ok := index[r.Created]; !ok {
+			index[r.Created] = len(trends.Timestamps)
+			trends.Timestamps = append(trends.Timestamps, r.Created)
+		}
+	}
+
+	series := make(map[string]int)
+	for _, r := range results {
+		i, ok := series[r.Name]
+		if !ok {
+			i = len(trends.Series)
+			series[r.Name] = i
+			trends.Series = append(trends.Series, TrendSeries{Name: r.Name, Values: make([]int, len(trends.Timestamps))})
+		}
+
+		trends.Series[i].Values[index[r.Created]] += r.Count
+	}
+
+	sort.Slice(trends.Series, func(i, j int) bool {
+		return trends.Series[i].Name < trends.Series[j].Name
+	})
+
+	return trends
+}
+
+type FindingAgeBuckets struct {
+	Day     int `json:"day"`
+	Week    int `json:"week"`
+	Month   int `json:"month"`
+	Quarter int `json:"quarter"`
+	Older   int `json:"older"`
+}
+
+type FindingAge struct {
+	Name    string            `json:"name"`
+	Open    int               `json:"open"`
+	Oldest  int64             `json:"oldest"`
+	Buckets FindingAgeBuckets `json:"buckets"`
+}
+
+// MapFindingAges maps the open findings per group, each bucket counts the findings opened within the bucket range but not within a smaller one
+func MapFindingAges(results []db.FindingAge) []FindingAge {
+	list := make([]FindingAge, 0, len(results))
+	for _, r := range results {
+		list = append(list, FindingAge{
+			Name:   r.Name,
+			Open:   r.Total,
+			Oldest: r.Oldest,
+			Buckets: FindingAgeBuckets{
+				Day:     r.AgeDay,
+				Week:    r.AgeWeek,
+				Month:   r.AgeMonth,
+				Quarter: r.AgeQuarter,
+				Older:   r.AgeOlder,
+			},
+		})
+	}
+
+	return list
+}
+
+type FindingRemediation struct {
+	Name     string `json:"name"`
+	Resolved int    `json:"resolved"`
+	// MTTR is the mean time to remediate in seconds
+	MTTR int64 `json:"mttr"`
+}
+
+func MapFindingRemediations(results []db.FindingRemediation) []FindingRemediation {
+	list := make([]FindingRemediation, 0, len(results))
+	for _, r := range results {
+		list = append(list, FindingRemediation{Name: r.Name, Resolved: r.Resolved, MTTR: int64(math.Round(r.MTTR))})
+	}
+
+	return list
+}
+
+type ResultEvent struct {
+	ID               int64  `json:"id"`
+	Timestamp        int64  `json:"timestamp"`
+	Type             string `json:"type"`
+	FindingID        string `json:"findingId"`
+	ReportID         string `json:"reportId"`
+	Namespace        string `json:"namespace,omitempty"`
+	Source           string `json:"source"`
+	Policy           string `json:"policy"`
+	Rule             string `json:"rule,omitempty"`
+	Severity         string `json:"severity,omitempty"`
+	PreviousSeverity string `json:"previousSeverity,omitempty"`
+	Kind             string `json:"kind,omitempty"`
+	Name             string `json:"name,omitempty"`
+}
+
+func MapResultEvents(results []db.ResultEvent) []ResultEvent {
+	list := make([]ResultEvent, 0, len(results))
+	for _, r := range results {
+		list = append(list, ResultEvent{
+			ID:               r.ID,
+			Timestamp:        r.Created,
+			Type:             r.Type,
+			FindingID:        r.FindingID,
+			ReportID:         r.PolicyReportID,
+			Namespace:        r.Namespace,
+			Source:           r.Source,
+			Policy:           r.Policy,
+			Rule:             r.Rule,
+			Severity:         r.Severity,
+			PreviousSeverity: r.PreviousSeverity,
+			Kind:             r.ResourceKind,
+			Name:             r.ResourceName,
+		})
+	}
+
+	return list
+}
+
+var resultEventHeader = []string{"id", "timestamp", "type", "findingId", "reportId", "namespace", "source", "policy", "rule", "severity", "previousSeverity", "kind", "name"}
+
+// ResultEventsCSV writes result events as CSV, timestamps are formatted as RFC3339 in UTC
+type ResultEventsCSV struct {
+	writer *csv.Writer
+}
+
+// Write the events and flush them to the underlying writer
+func (c *ResultEventsCSV) Write(results []db.ResultEvent) error {
+	for _, r := range results {
+		err := c.writer.Write([]string{
+			strconv.FormatInt(r.ID, 10),
+			time.Unix(r.Created, 0).UTC().Format(time.RFC3339),
+			r.Type,
+			r.FindingID,
+			r.PolicyReportID,
+			r.Namespace,
+			r.Source,
+			r.Policy,
+			r.Rule,
+			r.Severity,
+			r.PreviousSeverity,
+			r.ResourceKind,
+			r.ResourceName,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	c.writer.Flush()
+
+	return c.writer.Error()
+}
+
+// NewResultEventsCSV writes the header row and returns a writer for the event rows
+func NewResultEventsCSV(w io.Writer) (*ResultEventsCSV, error) {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(resultEventHeader); err != nil {
+		return nil, err
+	}
+
+	return &ResultEventsCSV{writer: writer}, nil
+}
+
 func MapResourceCategoryToSourceDetails(categories []db.ResourceCategory) []*SourceDetails {
 	list := make(map[string]*SourceDetails, 0)
 
@@ -633,19 +825,23 @@ func MapWebhhokToTarget(typeName string) func(ta *targetconfig.Config[v1alpha1.W
 	return func(ta *targetconfig.Config[v1alpha1.WebhookOptions]) *Target {
 		t := MapBaseToTarget(ta)
 		t.Type = typeName
-		t.SkipTLS = ta.Config.SkipTLS
-		t.UseTLS = ta.Config.Certificate != ""
+		mapWebhookOptions(t, *ta.Config)
 
-		if u, err := url.Parse(ta.Config.Webhook); err == nil {
-			t.Host = fmt.Sprintf("%s://%s", u.Scheme, u.Host)
-			t.Auth = u.User != nil
-		}
+		return t
+	}
+}
 
-		if v, ok := ta.Config.Headers["Authorization"]; ok && v != "" {
-			t.Auth = true
-		}
+func mapWebhookOptions(t *Target, options v1alpha1.WebhookOptions) {
+	t.SkipTLS = options.SkipTLS
+	t.UseTLS = options.Certificate != ""
 
-		return t
+	if u, err := url.Parse(options.Webhook); err == nil {
+		t.Host = fmt.Sprintf("%s://%s", u.Scheme, u.Host)
+		t.Auth = u.User != nil
+	}
+
+	if v, ok := options.Headers["Authorization"]; ok && v != "" {
+		t.Auth = true
 	}
 }
 
@@ -683,6 +879,28 @@ func MapKinesisToTarget(ta *targetconfig.Config[v1alpha1.KinesisOptions]) *Targe
 	return t
 }
 
+func MapSNSToTarget(ta *targetconfig.Config[v1alpha1.SNSOptions]) *Target {
+	t := MapBaseToTarget(ta)
+	t.Type = "SNS"
+	t.Host = ta.Config.Endpoint
+	t.Properties["topic"] = ta.Config.TopicARN
+	t.Properties["region"] = ta.Config.Region
+	t.Auth = true
+
+	return t
+}
+
+func MapSQSToTarget(ta *targetconfig.Config[v1alpha1.SQSOptions]) *Target {
+	t := MapBaseToTarget(ta)
+	t.Type = "SQS"
+	t.Host = ta.Config.Endpoint
+	t.Properties["queue"] = ta.Config.QueueURL
+	t.Properties["region"] = ta.Config.Region
+	t.Auth = true
+
+	return t
+}
+
 func MapSecurityHubToTarget(ta *targetconfig.Config[v1alpha1.SecurityHubOptions]) *Target {
 	t := MapBaseToTarget(ta)
 	t.Type = "SecurityHub"
@@ -702,6 +920,80 @@ func MapSplunkToTarget(ta *targetconfig.Config[v1alpha1.SplunkOptions]) *Target
 	return t
 }
 
+func MapDefectDojoToTarget(ta *targetconfig.Config[v1alpha1.DefectDojoOptions]) *Target {
+	t := MapBaseToTarget(ta)
+	t.Type = "DefectDojo"
+	t.Host = ta.Config.Host
+	t.SkipTLS = ta.Config.SkipTLS
+	t.UseTLS = ta.Config.Certificate != ""
+	t.Properties["productType"] = ta.Config.ProductType
+	t.Auth = true
+
+	return t
+}
+
+func MapMattermostToTarget(ta *targetconfig.Config[v1alpha1.MattermostOptions]) *Target {
+	t := MapBaseToTarget(ta)
+	t.Type = "Mattermost"
+	mapWebhookOptions(t, ta.Config.WebhookOptions)
+	t.Properties["channel"] = ta.Config.Channel
+
+	return t
+}
+
+func MapRocketChatToTarget(ta *targetconfig.Config[v1alpha1.RocketChatOptions]) *Target {
+	t := MapBaseToTarget(ta)
+	t.Type = "RocketChat"
+	mapWebhookOptions(t, ta.Config.WebhookOptions)
+	t.Properties["channel"] = ta.Config.Channel
+
+	return t
+}
+
+func MapWebexToTarget(ta *targetconfig.Config[v1alpha1.WebexOptions]) *Target {
+	t := MapBaseToTarget(ta)
+	t.Type = "Webex"
+	t.Host = helper.Defaults(ta.Config.Host, webex.DefaultHost)
+	t.SkipTLS = ta.Config.SkipTLS
+	t.UseTLS = ta.Config.Certificate != ""
+	t.Properties["roomId"] = ta.Config.RoomID
+	t.Auth = true
+
+	return t
+}
+
+func MapMatrixToTarget(ta *targetconfig.Config[v1alpha1.MatrixOptions]) *Target {
+	t := MapBaseToTarget(ta)
+	t.Type = "Matrix"
+	t.Host = ta.Config.Host
+	t.SkipTLS = ta.Config.SkipTLS
+	t.UseTLS = ta.Config.Certificate != ""
+	t.Properties["roomId"] = ta.Config.RoomID
+	t.Auth = true
+
+	return t
+}
+
+func MapServiceNowToTarget(ta *targetconfig.Config[v1alpha1.ServiceNowOptions]) *Target {
+	t := MapBaseToTarget(ta)
+	t.Type = "ServiceNow"
+	t.Host = ta.Config.Host
+	t.SkipTLS = ta.Config.SkipTLS
+	t.UseTLS = ta.Config.Certificate != ""
+	t.Properties["table"] = helper.Defaults(ta.Config.Table, servicenow.IncidentTable)
+	t.Auth = true
+
+	return t
+}
+
+func MapKubernetesEventsToTarget(ta *targetconfig.Config[v1alpha1.KubernetesEventsOptions]) *Target {
+	t := MapBaseToTarget(ta)
+	t.Type = "KubernetesEvents"
+	t.Properties["component"] = helper.Defaults(ta.Config.Component, events.DefaultComponent)
+
+	return t
+}
+
 func MapGCSToTarget(ta *targetconfig.Config[v1alpha1.GCSOptions]) *Target {
 	t := MapBaseToTarget(ta)
 	t.Type = "GoogleCloudStore"
@@ -759,10 +1051,19 @@ func MapConfigTargets(c target.Targets) map[string][]*Target {
 	targets["telegram"] = MapTargets(c.Telegram, MapTelegramToTarget)
 	targets["s3"] = MapTargets(c.S3, MapS3ToTarget)
 	targets["kinesis"] = MapTargets(c.Kinesis, MapKinesisToTarget)
+	targets["sns"] = MapTargets(c.SNS, MapSNSToTarget)
+	targets["sqs"] = MapTargets(c.SQS, MapSQSToTarget)
 	targets["securityHub"] = MapTargets(c.SecurityHub, MapSecurityHubToTarget)
 	targets["gcs"] = MapTargets(c.GCS, MapGCSToTarget)
 	targets["alertManager"] = MapTargets(c.AlertManager, MapAlertManagerToTarget)
 	targets["splunk"] = MapTargets(c.Splunk, MapSplunkToTarget)
+	targets["defectDojo"] = MapTargets(c.DefectDojo, MapDefectDojoToTarget)
+	targets["mattermost"] = MapTargets(c.Mattermost, MapMattermostToTarget)
+	targets["rocketChat"] = MapTargets(c.RocketChat, MapRocketChatToTarget)
+	targets["webex"] = MapTargets(c.Webex, MapWebexToTarget)
+	targets["matrix"] = MapTargets(c.Matrix, MapMatrixToTarget)
+	targets["serviceNow"] = MapTargets(c.ServiceNow, MapServiceNowToTarget)
+	targets["kubernetesEvents"] = MapTargets(c.KubernetesEvents, MapKubernetesEventsToTarget)
 
 	for k, v := range targets {
 		if len(v) == 0 {
@@ -786,3 +1087,63 @@ func MapResultPropertyList(results []db.ResultProperty) []ResultProperty {
 		}
 	})
 }
+
+type SilenceMatchers struct {
+	Namespaces []string `json:"namespaces,omitempty"`
+	Policies   []string `json:"policies,omitempty"`
+	Rules      []string `json:"rules,omitempty"`
+	Severities []string `json:"severities,omitempty"`
+	Kinds      []string `json:"kinds,omitempty"`
+	Resources  []string `json:"resources,omitempty"`
+	Targets    []string `json:"targets,omitempty"`
+}
+
+type SilenceSchedule struct {
+	Cron     string `json:"cron"`
+	Duration string `json:"duration"`
+	TimeZone string `json:"timeZone,omitempty"`
+}
+
+type Silence struct {
+	Name      string           `json:"name"`
+	Matchers  SilenceMatchers  `json:"matchers"`
+	StartsAt  time.Time        `json:"startsAt"`
+	EndsAt    *time.Time       `json:"endsAt,omitempty"`
+	Schedule  *SilenceSchedule `json:"schedule,omitempty"`
+	CreatedBy string           `json:"createdBy,omitempty"`
+	Comment   string           `json:"comment,omitempty"`
+	Active    bool             `json:"active"`
+}
+
+func MapSilence(s silence.Silence, now time.Time) Silence {
+	view := Silence{
+		Name: s.Name,
+		Matchers: SilenceMatchers{
+			Namespaces: s.Matchers.Namespaces.Include,
+			Policies:   s.Matchers.Policies.Include,
+			Rules:      s.Matchers.Rules.Include,
+			Severities: s.Matchers.Severities.Include,
+			Kinds:      s.Matchers.Kinds.Include,
+			Resources:  s.Matchers.Resources.Include,
+			Targets:    s.Matchers.Targets,
+		},
+		StartsAt:  s.StartsAt,
+		CreatedBy: s.CreatedBy,
+		Comment:   s.Comment,
+		Active:    s.Active(now),
+	}
+
+	if !s.EndsAt.IsZero() {
+		view.EndsAt = &s.EndsAt
+	}
+
+	if s.Schedule != nil {
+		view.Schedule = &SilenceSchedule{
+			Cron:     s.Schedule.Cron,
+			Duration: s.Schedule.Duration.String(),
+			TimeZone: s.Schedule.TimeZone,
+		}
+	}
+
+	return view
+}
@@ -33,6 +33,21 @@ func TestV2Views(t *testing.T) {
 		assert.Equal(t, map[string]interface{}{"team": "marketing"}, filter.Selector)
 	})
 
+	t.Run("MapTrends", func(t *testing.T) {
+		t.Parallel()
+		trends := v2.MapTrends([]database.TrendCount{
+			{Created: 100, Name: "fail", Count: 4},
+			{Created: 100, Name: "pass", Count: 2},
+			{Created: 200, Name: "pass", Count: 3},
+		})
+
+		assert.Equal(t, []int64{100, 200}, trends.Timestamps)
+		assert.Equal(t, []v2.TrendSeries{
+			{Name: "fail", Values: []int{4, 0}},
+			{Name: "pass", Values: []int{2, 3}},
+		}, trends.Series)
+	})
+
 	t.Run("MapResourceCategoryToSourceDetails", func(t *testing.T) {
 		t.Parallel()
 		result := v2.MapResourceCategoryToSourceDetails([]database.ResourceCategory{
@@ -294,6 +309,169 @@ func TestV2Views(t *testing.T) {
 		assert.True(t, target.Auth)
 	})
 
+	t.Run("MapSNSToTarget", func(t *testing.T) {
+		t.Parallel()
+		target := v2.MapSNSToTarget(&targetconfig.Config[v1alpha1.SNSOptions]{
+			Name:            "Target",
+			MinimumSeverity: "medium",
+			Config: &v1alpha1.SNSOptions{
+				TopicARN: "arn:aws:sns:eu-central-1:000000000000:policy-reporter",
+				AWSConfig: v1alpha1.AWSConfig{
+					Region:   "eu-central-1",
+					Endpoint: "https://sns.aws.com",
+				},
+			},
+			Valid: true,
+		})
+
+		assert.Equal(t, "SNS", target.Type)
+		assert.Equal(t, "https://sns.aws.com", target.Host)
+		assert.Equal(t, "arn:aws:sns:eu-central-1:000000000000:policy-reporter", target.Properties["topic"])
+		assert.Equal(t, "eu-central-1", target.Properties["region"])
+		assert.True(t, target.Auth)
+	})
+
+	t.Run("MapSQSToTarget", func(t *testing.T) {
+		t.Parallel()
+		target := v2.MapSQSToTarget(&targetconfig.Config[v1alpha1.SQSOptions]{
+			Name:            "Target",
+			MinimumSeverity: "medium",
+			Config: &v1alpha1.SQSOptions{
+				QueueURL: "https://sqs.eu-central-1.amazonaws.com/000000000000/policy-reporter",
+				AWSConfig: v1alpha1.AWSConfig{
+					Region:   "eu-central-1",
+					Endpoint: "https://sqs.aws.com",
+				},
+			},
+			Valid: true,
+		})
+
+		assert.Equal(t, "SQS", target.Type)
+		assert.Equal(t, "https://sqs.aws.com", target.Host)
+		assert.Equal(t, "https://sqs.eu-central-1.amazonaws.com/000000000000/policy-reporter", target.Properties["queue"])
+		assert.Equal(t, "eu-central-1", target.Properties["region"])
+		assert.True(t, target.Auth)
+	})
+
+	t.Run("MapDefectDojoToTarget", func(t *testing.T) {
+		t.Parallel()
+		target := v2.MapDefectDojoToTarget(&targetconfig.Config[v1alpha1.DefectDojoOptions]{
+			Name:            "Target",
+			MinimumSeverity: "medium",
+			Config: &v1alpha1.DefectDojoOptions{
+				HostOptions: v1alpha1.HostOptions{Host: "https://defectdojo.example.com", SkipTLS: true},
+				APIKey:      "token",
+				ProductType: "Kubernetes",
+			},
+			Valid: true,
+		})
+
+		assert.Equal(t, "DefectDojo", target.Type)
+		assert.Equal(t, "https://defectdojo.example.com", target.Host)
+		assert.Equal(t, "Kubernetes", target.Properties["productType"])
+		assert.True(t, target.SkipTLS)
+		assert.True(t, target.Auth)
+	})
+
+	t.Run("MapMattermostToTarget", func(t *testing.T) {
+		t.Parallel()
+		target := v2.MapMattermostToTarget(&targetconfig.Config[v1alpha1.MattermostOptions]{
+			Name: "Target",
+			Config: &v1alpha1.MattermostOptions{
+				WebhookOptions: v1alpha1.WebhookOptions{Webhook: "https://mattermost.example.com/hooks/xxx"},
+				Channel:        "policy-reporter",
+			},
+			Valid: true,
+		})
+
+		assert.Equal(t, "Mattermost", target.Type)
+		assert.Equal(t, "https://mattermost.example.com", target.Host)
+		assert.Equal(t, "policy-reporter", target.Properties["channel"])
+	})
+
+	t.Run("MapRocketChatToTarget", func(t *testing.T) {
+		t.Parallel()
+		target := v2.MapRocketChatToTarget(&targetconfig.Config[v1alpha1.RocketChatOptions]{
+			Name: "Target",
+			Config: &v1alpha1.RocketChatOptions{
+				WebhookOptions: v1alpha1.WebhookOptions{Webhook: "https://rocket.example.com/hooks/xxx", SkipTLS: true},
+				Channel:        "#policy-reporter",
+			},
+			Valid: true,
+		})
+
+		assert.Equal(t, "RocketChat", target.Type)
+		assert.Equal(t, "https://rocket.example.com", target.Host)
+		assert.Equal(t, "#policy-reporter", target.Properties["channel"])
+		assert.True(t, target.SkipTLS)
+	})
+
+	t.Run("MapWebexToTarget", func(t *testing.T) {
+		t.Parallel()
+		target := v2.MapWebexToTarget(&targetconfig.Config[v1alpha1.WebexOptions]{
+			Name: "Target",
+			Config: &v1alpha1.WebexOptions{
+				Token:  "token",
+				RoomID: "room",
+			},
+			Valid: true,
+		})
+
+		assert.Equal(t, "Webex", target.Type)
+		assert.Equal(t, "https://webexapis.com", target.Host)
+		assert.Equal(t, "room", target.Properties["roomId"])
+		assert.True(t, target.Auth)
+	})
+
+	t.Run("MapMatrixToTarget", func(t *testing.T) {
+		t.Parallel()
+		target := v2.MapMatrixToTarget(&targetconfig.Config[v1alpha1.MatrixOptions]{
+			Name: "Target",
+			Config: &v1alpha1.MatrixOptions{
+				HostOptions: v1alpha1.HostOptions{Host: "https://matrix.example.com"},
+				AccessToken: "token",
+				RoomID:      "!room:example.com",
+			},
+			Valid: true,
+		})
+
+		assert.Equal(t, "Matrix", target.Type)
+		assert.Equal(t, "https://matrix.example.com", target.Host)
+		assert.Equal(t, "!room:example.com", target.Properties["roomId"])
+		assert.True(t, target.Auth)
+	})
+
+	t.Run("MapServiceNowToTarget", func(t *testing.T) {
+		t.Parallel()
+		target := v2.MapServiceNowToTarget(&targetconfig.Config[v1alpha1.ServiceNowOptions]{
+			Name: "Target",
+			Config: &v1alpha1.ServiceNowOptions{
+				HostOptions: v1alpha1.HostOptions{Host: "https://example.service-now.com"},
+				Table:       "sn_si_incident",
+				Username:    "admin",
+				Password:    "password",
+			},
+			Valid: true,
+		})
+
+		assert.Equal(t, "ServiceNow", target.Type)
+		assert.Equal(t, "https://example.service-now.com", target.Host)
+		assert.Equal(t, "sn_si_incident", target.Properties["table"])
+		assert.True(t, target.Auth)
+	})
+
+	t.Run("MapKubernetesEventsToTarget", func(t *testing.T) {
+		t.Parallel()
+		target := v2.MapKubernetesEventsToTarget(&targetconfig.Config[v1alpha1.KubernetesEventsOptions]{
+			Name:   "Target",
+			Config: &v1alpha1.KubernetesEventsOptions{},
+			Valid:  true,
+		})
+
+		assert.Equal(t, "KubernetesEvents", target.Type)
+		assert.Equal(t, "policy-reporter", target.Properties["component"])
+	})
+
 	t.Run("MapSecurityHubToTarget", func(t *testing.T) {
 		t.Parallel()
 		target := v2.MapSecurityHubToTarget(&targetconfig.Config[v1alpha1.SecurityHubOptions]{
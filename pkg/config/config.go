@@ -1,6 +1,11 @@
 package config
 
-import "github.com/kyverno/policy-reporter/pkg/target"
+import (
+	"fmt"
+
+	"github.com/kyverno/policy-reporter/pkg/database"
+	"github.com/kyverno/policy-reporter/pkg/target"
+)
 
 type ValueFilter struct {
 	Include  []string       `mapstructure:"include"`
@@ -22,12 +27,15 @@ type TargetFilter struct {
 }
 
 type MetricsFilter struct {
-	Namespaces ValueFilter `mapstructure:"namespaces"`
-	Policies   ValueFilter `mapstructure:"policies"`
-	Severities ValueFilter `mapstructure:"severities"`
-	Status     ValueFilter `mapstructure:"status"`
-	Sources    ValueFilter `mapstructure:"sources"`
-	Kinds      ValueFilter `mapstructure:"kinds"`
+	Namespaces          ValueFilter `mapstructure:"namespaces"`
+	Policies            ValueFilter `mapstructure:"policies"`
+	Severities          ValueFilter `mapstructure:"severities"`
+	Status              ValueFilter `mapstructure:"status"`
+	Sources             ValueFilter `mapstructure:"sources"`
+	Kinds               ValueFilter `mapstructure:"kinds"`
+	ResourceLabels      ValueFilter `mapstructure:"resourceLabels"`
+	ResourceAnnotations ValueFilter `mapstructure:"resourceAnnotations"`
+	CEL                 string      `mapstructure:"cel"`
 }
 
 // SMTP configuration
@@ -118,6 +126,7 @@ type ReportFilter struct {
 	Sources               ValueFilter `mapstructure:"sources"`
 	Kinds                 ValueFilter `mapstructure:"kinds"`
 	DisableClusterReports bool        `mapstructure:"disableClusterReports"`
+	CEL                   string      `mapstructure:"cel"`
 }
 
 // Redis configuration
@@ -148,10 +157,20 @@ type LeaderElection struct {
 }
 
 // K8sClient config struct
+// K8sCluster is an additional cluster watched by this instance, its reports are tagged with the cluster name
+type K8sCluster struct {
+	Name       string `mapstructure:"name"`
+	Kubeconfig string `mapstructure:"kubeconfig"`
+	// SecretRef references a secret with the kubeconfig in the "kubeconfig" key
+	SecretRef string `mapstructure:"secretRef"`
+	Context   string `mapstructure:"context"`
+}
+
 type K8sClient struct {
-	QPS        float32 `mapstructure:"qps"`
-	Burst      int     `mapstructure:"burst"`
-	Kubeconfig string  `mapstructure:"kubeconfig"`
+	QPS        float32      `mapstructure:"qps"`
+	Burst      int          `mapstructure:"burst"`
+	Kubeconfig string       `mapstructure:"kubeconfig"`
+	Clusters   []K8sCluster `mapstructure:"clusters"`
 }
 
 type Logging struct {
@@ -162,21 +181,85 @@ type Logging struct {
 }
 
 type Database struct {
-	Type            string `mapstructure:"type"`
-	DSN             string `mapstructure:"dsn"`
-	Username        string `mapstructure:"username"`
-	Password        string `mapstructure:"password"`
-	Database        string `mapstructure:"database"`
-	Host            string `mapstructure:"host"`
-	EnableSSL       bool   `mapstructure:"enableSSL"`
-	SecretRef       string `mapstructure:"secretRef"`
-	MountedSecret   string `mapstructure:"mountedSecret"`
-	MaxOpenConns    int    `mapstructure:"maxOpenConnections"`
-	MaxIdleConns    int    `mapstructure:"maxIdleConnections"`
-	ConnMaxLifetime int    `mapstructure:"connectionMaxLifetime"` // in minutes
-	ConnMaxIdleTime int    `mapstructure:"connectionMaxIdleTime"` // in minutes
-	Timeout         int    `mapstructure:"timeout"`               // in seconds
-	Metrics         bool   `mapstructure:"metrics"`
+	Type            string      `mapstructure:"type"`
+	DSN             string      `mapstructure:"dsn"`
+	Username        string      `mapstructure:"username"`
+	Password        string      `mapstructure:"password"`
+	Database        string      `mapstructure:"database"`
+	Host            string      `mapstructure:"host"`
+	EnableSSL       bool        `mapstructure:"enableSSL"`
+	SecretRef       string      `mapstructure:"secretRef"`
+	MountedSecret   string      `mapstructure:"mountedSecret"`
+	MaxOpenConns    int         `mapstructure:"maxOpenConnections"`
+	MaxIdleConns    int         `mapstructure:"maxIdleConnections"`
+	ConnMaxLifetime int         `mapstructure:"connectionMaxLifetime"` // in minutes
+	ConnMaxIdleTime int         `mapstructure:"connectionMaxIdleTime"` // in minutes
+	Timeout         int         `mapstructure:"timeout"`               // in seconds
+	Metrics         bool        `mapstructure:"metrics"`
+	ReadReplica     ReadReplica `mapstructure:"readReplica"`
+}
+
+// Validate rejects unsupported database types, an empty type uses SQLite
+func (d Database) Validate() error {
+	switch d.Type {
+	case "", database.SQLite, database.MySQL, database.MariaDB, database.PostgreSQL:
+		return nil
+	}
+
+	return fmt.Errorf("unsupported database type %q, supported types are %s, %s and %s", d.Type, database.MySQL, database.MariaDB, database.PostgreSQL)
+}
+
+// ReadReplica configuration, an optional read-only database for API queries of PostgreSQL, MySQL and MariaDB
+type ReadReplica struct {
+	DSN           string `mapstructure:"dsn"`
+	SecretRef     string `mapstructure:"secretRef"`
+	MountedSecret string `mapstructure:"mountedSecret"`
+	CheckInterval int    `mapstructure:"checkInterval"` // in seconds
+}
+
+// Trends configuration, records periodic snapshots of the aggregated result counts into the database
+type Trends struct {
+	Enabled    bool `mapstructure:"enabled"`
+	Resolution int  `mapstructure:"resolution"` // in minutes
+	Retention  int  `mapstructure:"retention"`  // in days
+}
+
+// Findings configuration, tracks first seen, last seen and resolution of violations in the database
+type Findings struct {
+	Enabled      bool   `mapstructure:"enabled"`
+	TeamProperty string `mapstructure:"teamProperty"`
+}
+
+// AuditLog configuration, records the state transitions of findings as append only events
+type AuditLog struct {
+	Enabled   bool `mapstructure:"enabled"`
+	Retention int  `mapstructure:"retention"` // in days
+}
+
+type HubToken struct {
+	Cluster string `mapstructure:"cluster"`
+	Token   string `mapstructure:"token"`
+}
+
+type HubIngest struct {
+	Enabled bool       `mapstructure:"enabled"`
+	Tokens  []HubToken `mapstructure:"tokens"`
+}
+
+type HubAgent struct {
+	Enabled     bool   `mapstructure:"enabled"`
+	Host        string `mapstructure:"host"`
+	Token       string `mapstructure:"token"`
+	SkipTLS     bool   `mapstructure:"skipTLS"`
+	Certificate string `mapstructure:"certificate"`
+}
+
+// Hub configuration, aggregates the reports of multiple clusters into a single store
+type Hub struct {
+	// Cluster name the reports of this instance are tagged with
+	Cluster string    `mapstructure:"cluster"`
+	Ingest  HubIngest `mapstructure:"ingest"`
+	Agent   HubAgent  `mapstructure:"agent"`
 }
 
 type SourceSelector struct {
@@ -192,6 +275,8 @@ type SourceFilter struct {
 	Namespaces            ValueFilter    `mapstructure:"namespaces"`
 	UncontrolledOnly      bool           `mapstructure:"uncontrolledOnly"`
 	DisableClusterReports bool           `mapstructure:"disableClusterReports"`
+	ResourceLabels        ValueFilter    `mapstructure:"resourceLabels"`
+	ResourceAnnotations   ValueFilter    `mapstructure:"resourceAnnotations"`
 }
 
 type CustomID struct {
@@ -205,8 +290,53 @@ type SourceConfig struct {
 	SelfassignNamespaces bool           `mapstructure:"selfassignNamespaces"`
 }
 
+// OverrideMatchers configuration, all configured matchers have to match
+type OverrideMatchers struct {
+	Sources    ValueFilter `mapstructure:"sources"`
+	Policies   ValueFilter `mapstructure:"policies"`
+	Rules      ValueFilter `mapstructure:"rules"`
+	Categories ValueFilter `mapstructure:"categories"`
+	Severities ValueFilter `mapstructure:"severities"`
+}
+
+// Override configuration to set severity, category or properties of matching results
+type Override struct {
+	Match      OverrideMatchers  `mapstructure:"match"`
+	Severity   string            `mapstructure:"severity"`
+	Category   string            `mapstructure:"category"`
+	Properties map[string]string `mapstructure:"properties"`
+}
+
+// NamespaceEnrichment configuration, copies the listed namespace labels and annotations into the result properties
+type NamespaceEnrichment struct {
+	Labels      []string `mapstructure:"labels"`
+	Annotations []string `mapstructure:"annotations"`
+}
+
+// OwnerResolution configuration, resolves the top level owner of Pod, ReplicaSet and Job results
+type OwnerResolution struct {
+	Enabled bool `mapstructure:"enabled"`
+}
+
+// Suppression configuration, honors the suppress annotations on the affected resources
+type Suppression struct {
+	Enabled          bool `mapstructure:"enabled"`
+	SendToTargets    bool `mapstructure:"sendToTargets"`
+	IncludeInMetrics bool `mapstructure:"includeInMetrics"`
+}
+
 type CRD struct {
 	TargetConfig bool `mapstructure:"targetConfig"`
+	Silence      bool `mapstructure:"silence"`
+}
+
+// StatusSummary configuration, writes a summary ConfigMap into each namespace with reports
+type StatusSummary struct {
+	Enabled bool   `mapstructure:"enabled"`
+	Name    string `mapstructure:"name"`
+	// Delay in seconds to debounce updates of a namespace
+	Delay       int `mapstructure:"delay"`
+	TopPolicies int `mapstructure:"topPolicies"`
 }
 
 type PeriodicSyncConfig struct {
@@ -219,28 +349,72 @@ type AutoMemoryLimit struct {
 	Ratio   float64 `mapstructure:"ratio"`
 }
 
+// RouteMatchers configuration, all configured matchers have to match
+type RouteMatchers struct {
+	Namespaces      ValueFilter       `mapstructure:"namespaces"`
+	NamespaceLabels map[string]string `mapstructure:"namespaceLabels"`
+	Policies        ValueFilter       `mapstructure:"policies"`
+	Severities      ValueFilter       `mapstructure:"severities"`
+	Sources         ValueFilter       `mapstructure:"sources"`
+	ReportLabels    map[string]string `mapstructure:"reportLabels"`
+}
+
+// Route configuration of the routing tree
+type Route struct {
+	Receiver string        `mapstructure:"receiver"`
+	Continue bool          `mapstructure:"continue"`
+	Matchers RouteMatchers `mapstructure:"matchers"`
+	Routes   []Route       `mapstructure:"routes"`
+}
+
+// Receiver configuration, references targets by name
+type Receiver struct {
+	Name    string   `mapstructure:"name"`
+	Targets []string `mapstructure:"targets"`
+}
+
+// Routing configuration
+type Routing struct {
+	Route     Route      `mapstructure:"route"`
+	Receivers []Receiver `mapstructure:"receivers"`
+}
+
+func (r Routing) Enabled() bool {
+	return len(r.Receivers) > 0
+}
+
 // Config of the PolicyReporter
 type Config struct {
-	Version         string
-	Namespace       string             `mapstructure:"namespace"`
-	API             API                `mapstructure:"api"`
-	WorkerCount     int                `mapstructure:"worker"`
-	DBFile          string             `mapstructure:"dbfile"`
-	Metrics         Metrics            `mapstructure:"metrics"`
-	REST            REST               `mapstructure:"rest"`
-	ReportFilter    ReportFilter       `mapstructure:"reportFilter"`
-	SourceFilters   []SourceFilter     `mapstructure:"sourceFilters"`
-	Redis           Redis              `mapstructure:"redis"`
-	Profiling       Profiling          `mapstructure:"profiling"`
-	EmailReports    EmailReports       `mapstructure:"emailReports"`
-	LeaderElection  LeaderElection     `mapstructure:"leaderElection"`
-	K8sClient       K8sClient          `mapstructure:"k8sClient"`
-	Logging         Logging            `mapstructure:"logging"`
-	Database        Database           `mapstructure:"database"`
-	Targets         target.Targets     `mapstructure:"target"`
-	SourceConfig    []SourceConfig     `mapstructure:"sourceConfig"`
-	Templates       Templates          `mapstructure:"templates"`
-	CRD             CRD                `mapstructure:"crd"`
-	PeriodicSync    PeriodicSyncConfig `mapstructure:"periodicSync"`
-	AutoMemoryLimit AutoMemoryLimit    `mapstructure:"autoMemoryLimit"`
+	Version             string
+	Namespace           string              `mapstructure:"namespace"`
+	API                 API                 `mapstructure:"api"`
+	WorkerCount         int                 `mapstructure:"worker"`
+	DBFile              string              `mapstructure:"dbfile"`
+	Metrics             Metrics             `mapstructure:"metrics"`
+	REST                REST                `mapstructure:"rest"`
+	ReportFilter        ReportFilter        `mapstructure:"reportFilter"`
+	SourceFilters       []SourceFilter      `mapstructure:"sourceFilters"`
+	Redis               Redis               `mapstructure:"redis"`
+	Profiling           Profiling           `mapstructure:"profiling"`
+	EmailReports        EmailReports        `mapstructure:"emailReports"`
+	LeaderElection      LeaderElection      `mapstructure:"leaderElection"`
+	K8sClient           K8sClient           `mapstructure:"k8sClient"`
+	Logging             Logging             `mapstructure:"logging"`
+	Database            Database            `mapstructure:"database"`
+	Targets             target.Targets      `mapstructure:"target"`
+	Routing             Routing             `mapstructure:"routing"`
+	SourceConfig        []SourceConfig      `mapstructure:"sourceConfig"`
+	Overrides           []Override          `mapstructure:"overrides"`
+	NamespaceEnrichment NamespaceEnrichment `mapstructure:"namespaceEnrichment"`
+	OwnerResolution     OwnerResolution     `mapstructure:"ownerResolution"`
+	Suppression         Suppression         `mapstructure:"suppression"`
+	Templates           Templates           `mapstructure:"templates"`
+	CRD                 CRD                 `mapstructure:"crd"`
+	PeriodicSync        PeriodicSyncConfig  `mapstructure:"periodicSync"`
+	StatusSummary       StatusSummary       `mapstructure:"statusSummary"`
+	Trends              Trends              `mapstructure:"trends"`
+	Findings            Findings            `mapstructure:"findings"`
+	AuditLog            AuditLog            `mapstructure:"auditLog"`
+	Hub                 Hub                 `mapstructure:"hub"`
+	AutoMemoryLimit     AutoMemoryLimit     `mapstructure:"autoMemoryLimit"`
 }
@@ -132,11 +132,13 @@ func Load(cmd *cobra.Command) (*Config, error) {
 
 	c := &Config{}
 
-	err := v.Unmarshal(c)
+	if err := v.Unmarshal(c); err != nil {
+		return c, err
+	}
 
 	if c.DBFile == "" {
 		c.DBFile = "sqlite-database.db"
 	}
 
-	return c, err
+	return c, c.Database.Validate()
 }
@@ -1,6 +1,8 @@
 package config_test
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/spf13/cobra"
@@ -66,3 +68,18 @@ func Test_Load(t *testing.T) {
 		t.Errorf("Unexpected DBFile Config: %s", c.DBFile)
 	}
 }
+
+func Test_LoadUnsupportedDatabase(t *testing.T) {
+	t.Parallel()
+	file := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(file, []byte("database:\n  type: clickhouse\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	cmd := createCMD()
+	_ = cmd.Flags().Set("config", file)
+
+	if _, err := config.Load(cmd); err == nil {
+		t.Error("Expected an error for an unsupported database type")
+	}
+}
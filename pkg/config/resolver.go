@@ -5,6 +5,7 @@ import (
 	"crypto/tls"
 	"crypto/x509"
 	"errors"
+	"fmt"
 	"os"
 	"strings"
 	"time"
@@ -12,17 +13,23 @@ import (
 	"github.com/gin-gonic/gin"
 	goredis "github.com/go-redis/redis/v8"
 	_ "github.com/mattn/go-sqlite3"
+	reportsv1alpha1 "github.com/openreports/reports-api/apis/openreports.io/v1alpha1"
 	"github.com/openreports/reports-api/pkg/client/clientset/versioned"
 	"github.com/openreports/reports-api/pkg/client/clientset/versioned/typed/openreports.io/v1alpha1"
 	"github.com/uptrace/bun"
 	"github.com/uptrace/bun/dialect"
 	mail "github.com/xhit/go-simple-mail/v2"
 	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
 	k8s "k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/metadata"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/restmapper"
+	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/client-go/util/workqueue"
 	gocache "zgo.at/zcache/v2"
 
@@ -34,22 +41,31 @@ import (
 	"github.com/kyverno/policy-reporter/pkg/email"
 	"github.com/kyverno/policy-reporter/pkg/email/summary"
 	"github.com/kyverno/policy-reporter/pkg/email/violations"
+	"github.com/kyverno/policy-reporter/pkg/expression"
 	"github.com/kyverno/policy-reporter/pkg/helper"
+	"github.com/kyverno/policy-reporter/pkg/hub"
 	"github.com/kyverno/policy-reporter/pkg/kubernetes"
 	"github.com/kyverno/policy-reporter/pkg/kubernetes/jobs"
 	"github.com/kyverno/policy-reporter/pkg/kubernetes/namespaces"
 	orclient "github.com/kyverno/policy-reporter/pkg/kubernetes/openreports"
 	"github.com/kyverno/policy-reporter/pkg/kubernetes/pods"
+	"github.com/kyverno/policy-reporter/pkg/kubernetes/policies"
 	"github.com/kyverno/policy-reporter/pkg/kubernetes/replicasets"
+	"github.com/kyverno/policy-reporter/pkg/kubernetes/resources"
 	"github.com/kyverno/policy-reporter/pkg/kubernetes/secrets"
 	wgpolicyclient "github.com/kyverno/policy-reporter/pkg/kubernetes/wgpolicy"
 	"github.com/kyverno/policy-reporter/pkg/leaderelection"
 	"github.com/kyverno/policy-reporter/pkg/listener"
 	"github.com/kyverno/policy-reporter/pkg/listener/metrics"
+	"github.com/kyverno/policy-reporter/pkg/openreports"
 	"github.com/kyverno/policy-reporter/pkg/report"
 	"github.com/kyverno/policy-reporter/pkg/report/result"
+	"github.com/kyverno/policy-reporter/pkg/routing"
+	"github.com/kyverno/policy-reporter/pkg/silence"
+	statussummary "github.com/kyverno/policy-reporter/pkg/summary"
 	"github.com/kyverno/policy-reporter/pkg/target"
 	"github.com/kyverno/policy-reporter/pkg/target/factory"
+	"github.com/kyverno/policy-reporter/pkg/target/http"
 	"github.com/kyverno/policy-reporter/pkg/targetconfig"
 	"github.com/kyverno/policy-reporter/pkg/validate"
 )
@@ -63,6 +79,7 @@ type Resolver struct {
 	publisher          report.EventPublisher
 	policyStore        *database.Store
 	database           *bun.DB
+	replicaDatabase    *bun.DB
 	wgpolicyClient     report.PolicyReportClient
 	openreportsClient  report.PolicyReportClient
 	leaderElector      *leaderelection.Client
@@ -72,8 +89,12 @@ type Resolver struct {
 	targetConfigClient *targetconfig.Client
 	logger             *zap.Logger
 	resultListener     *listener.ResultListener
+	router             *routing.Router
 	orClient           v1alpha1.OpenreportsV1alpha1Interface
 	wgClient           v1alpha2.Wgpolicyk8sV1alpha2Interface
+	resourceClient     resources.Client
+	silences           *silence.Store
+	summaryManager     *statussummary.Manager
 }
 
 // APIServer resolver method
@@ -153,6 +174,48 @@ func (r *Resolver) Database() *bun.DB {
 	return r.database
 }
 
+// ReplicaDatabase resolver method, returns nil if no read replica is configured
+func (r *Resolver) ReplicaDatabase() *bun.DB {
+	if r.replicaDatabase != nil {
+		return r.replicaDatabase
+	}
+
+	replica := r.config.Database.ReadReplica
+	if replica.DSN == "" && replica.SecretRef == "" && replica.MountedSecret == "" {
+		return nil
+	}
+
+	// query metrics are registered once for the primary connection
+	config := Database{
+		DSN:             replica.DSN,
+		SecretRef:       replica.SecretRef,
+		MountedSecret:   replica.MountedSecret,
+		MaxOpenConns:    r.config.Database.MaxOpenConns,
+		MaxIdleConns:    r.config.Database.MaxIdleConns,
+		ConnMaxLifetime: r.config.Database.ConnMaxLifetime,
+		ConnMaxIdleTime: r.config.Database.ConnMaxIdleTime,
+		Timeout:         r.config.Database.Timeout,
+	}
+
+	factory := r.DatabaseFactory()
+
+	switch r.config.Database.Type {
+	case database.MySQL, database.MariaDB:
+		r.replicaDatabase = factory.NewMySQL(config)
+	case database.PostgreSQL:
+		r.replicaDatabase = factory.NewPostgres(config)
+	default:
+		zap.L().Warn("read replica is not supported for the configured database", zap.String("type", r.config.Database.Type))
+		return nil
+	}
+
+	if r.replicaDatabase != nil {
+		zap.L().Info("read replica connection created")
+	}
+
+	return r.replicaDatabase
+}
+
 // PolicyReportStore resolver method
 func (r *Resolver) Store(db *bun.DB) (*database.Store, error) {
 	if r.policyStore != nil {
@@ -160,11 +223,61 @@ func (r *Resolver) Store(db *bun.DB) (*database.Store, error) {
 	}
 
 	s, err := database.NewStore(db, r.config.Version)
+	if s != nil {
+		s.SetClusters(r.Clusters()...)
+	}
 	r.policyStore = s
 
 	return r.policyStore, err
 }
 
+// TrendRecorder resolver method
+func (r *Resolver) TrendRecorder(store *database.Store) *database.TrendRecorder {
+	resolution := time.Hour
+	if r.config.Trends.Resolution > 0 {
+		resolution = time.Duration(r.config.Trends.Resolution) * time.Minute
+	}
+
+	retention := 90 * 24 * time.Hour
+	if r.config.Trends.Retention > 0 {
+		retention = time.Duration(r.config.Trends.Retention) * 24 * time.Hour
+	}
+
+	return database.NewTrendRecorder(store, resolution, retention)
+}
+
+// ReplicaMonitor resolver method, configures the replica as read database of the store
+func (r *Resolver) ReplicaMonitor(store *database.Store, replica *bun.DB) *database.ReplicaMonitor {
+	store.SetReplica(replica)
+
+	interval := 10 * time.Second
+	if r.config.Database.ReadReplica.CheckInterval > 0 {
+		interval = time.Duration(r.config.Database.ReadReplica.CheckInterval) * time.Second
+	}
+
+	return database.NewReplicaMonitor(store, interval)
+}
+
+// FindingTracker resolver method
+func (r *Resolver) FindingTracker(store *database.Store) *database.FindingTracker {
+	team := r.config.Findings.TeamProperty
+	if team == "" {
+		team = "team"
+	}
+
+	tracker := database.NewFindingTracker(store, team)
+	if !r.config.AuditLog.Enabled {
+		return tracker
+	}
+
+	retention := 365 * 24 * time.Hour
+	if r.config.AuditLog.Retention > 0 {
+		retention = time.Duration(r.config.AuditLog.Retention) * 24 * time.Hour
+	}
+
+	return tracker.WithAuditLog(retention)
+}
+
 // LeaderElectionClient resolver method
 func (r *Resolver) LeaderElectionClient() (*leaderelection.Client, error) {
 	if r.leaderElector != nil {
@@ -234,6 +347,117 @@ func (r *Resolver) ReconditionerConfigs() map[string]result.ReconditionerConfig
 	return configs
 }
 
+// Reconditioner resolver method
+func (r *Resolver) Reconditioner() (*result.Reconditioner, error) {
+	enrichers := make([]result.Enricher, 0, 3)
+
+	owners, err := r.OwnerResolver()
+	if err != nil {
+		return nil, err
+	}
+	if owners != nil {
+		enrichers = append(enrichers, owners)
+	}
+
+	namespaces, err := r.NamespaceEnricher()
+	if err != nil {
+		return nil, err
+	}
+	if namespaces != nil {
+		enrichers = append(enrichers, namespaces)
+	}
+
+	suppressor, err := r.Suppressor()
+	if err != nil {
+		return nil, err
+	}
+	if suppressor != nil {
+		enrichers = append(enrichers, suppressor)
+	}
+
+	return result.NewReconditioner(r.ReconditionerConfigs(), r.Overrides(), enrichers).WithCluster(r.config.Hub.Cluster), nil
+}
+
+// Suppressor resolver method, returns nil if the suppression is disabled
+func (r *Resolver) Suppressor() (*result.Suppressor, error) {
+	if !r.config.Suppression.Enabled {
+		return nil, nil
+	}
+
+	client, err := r.ResourceClient()
+	if err != nil {
+		return nil, err
+	}
+
+	return result.NewSuppressor(client), nil
+}
+
+// OwnerResolver resolver method, returns nil if the owner resolution is disabled
+func (r *Resolver) OwnerResolver() (*result.OwnerResolver, error) {
+	if !r.config.OwnerResolution.Enabled {
+		return nil, nil
+	}
+
+	podsClient, err := r.PodClient()
+	if err != nil {
+		return nil, err
+	}
+
+	jobsClient, err := r.JobClient()
+	if err != nil {
+		return nil, err
+	}
+
+	replicasetsClient, err := r.ReplicaSetClient()
+	if err != nil {
+		return nil, err
+	}
+
+	return result.NewOwnerResolver(podsClient, replicasetsClient, jobsClient, gocache.New[types.UID, *corev1.ObjectReference](5*time.Minute, time.Minute)), nil
+}
+
+// NamespaceEnricher resolver method, returns nil without configured labels or annotations
+func (r *Resolver) NamespaceEnricher() (*result.NamespaceEnricher, error) {
+	config := r.config.NamespaceEnrichment
+	if len(config.Labels)+len(config.Annotations) == 0 {
+		return nil, nil
+	}
+
+	client, err := r.NamespaceClient()
+	if err != nil {
+		return nil, err
+	}
+
+	return result.NewNamespaceEnricher(client, config.Labels, config.Annotations), nil
+}
+
+// Overrides resolver method, overrides with an unknown severity are ignored
+func (r *Resolver) Overrides() []result.Override {
+	overrides := make([]result.Override, 0, len(r.config.Overrides))
+	for i, o := range r.config.Overrides {
+		severity := reportsv1alpha1.ResultSeverity(strings.ToLower(o.Severity))
+		if _, ok := openreports.SeverityLevel[severity]; !ok {
+			zap.L().Error("invalid override severity, override is ignored", zap.Int("index", i), zap.String("severity", o.Severity))
+			continue
+		}
+
+		overrides = append(overrides, result.Override{
+			Match: result.OverrideMatchers{
+				Sources:    ToRuleSet(o.Match.Sources),
+				Policies:   ToRuleSet(o.Match.Policies),
+				Rules:      ToRuleSet(o.Match.Rules),
+				Categories: ToRuleSet(o.Match.Categories),
+				Severities: ToRuleSet(o.Match.Severities),
+			},
+			Severity:   severity,
+			Category:   o.Category,
+			Properties: o.Properties,
+		})
+	}
+
+	return overrides
+}
+
 // EventPublisher resolver method
 func (r *Resolver) WGPolicyQueue() (*wgpolicyclient.WGPolicyQueue, error) {
 	polrClient, err := r.WgPolicyCRClient()
@@ -256,13 +480,28 @@ func (r *Resolver) WGPolicyQueue() (*wgpolicyclient.WGPolicyQueue, error) {
 		return nil, err
 	}
 
+	resourceClient, err := r.ResourceClient()
+	if err != nil {
+		return nil, err
+	}
+
+	reportValidations, err := r.reportFilterValidations()
+	if err != nil {
+		return nil, err
+	}
+
+	reconditioner, err := r.Reconditioner()
+	if err != nil {
+		return nil, err
+	}
+
 	return wgpolicyclient.NewWGPolicyQueue(
 		kubernetes.NewDebouncer(1*time.Minute, r.EventPublisher()),
 		workqueue.NewTypedRateLimitingQueueWithConfig(workqueue.DefaultTypedControllerRateLimiter[string](), workqueue.TypedRateLimitingQueueConfig[string]{
 			Name: "wgreport-queue",
 		}),
 		polrClient,
-		report.NewSourceFilter(podsClient, jobsClient, replicasetsClient, gocache.New[types.UID, bool](1*time.Minute, 10*time.Second), helper.Map(r.config.SourceFilters, func(f SourceFilter) report.SourceValidation {
+		report.NewSourceFilter(podsClient, jobsClient, replicasetsClient, resourceClient, gocache.New[types.UID, bool](1*time.Minute, 10*time.Second), append(helper.Map(r.config.SourceFilters, func(f SourceFilter) report.SourceValidation {
 			return report.SourceValidation{
 				Selector:              report.ReportSelector(f.Selector),
 				Kinds:                 ToRuleSet(f.Kinds),
@@ -271,9 +510,11 @@ func (r *Resolver) WGPolicyQueue() (*wgpolicyclient.WGPolicyQueue, error) {
 				Namespaces:            ToRuleSet(f.Namespaces),
 				UncontrolledOnly:      f.UncontrolledOnly,
 				DisableClusterReports: f.DisableClusterReports,
+				ResourceLabels:        ToRuleSet(f.ResourceLabels),
+				ResourceAnnotations:   ToRuleSet(f.ResourceAnnotations),
 			}
-		})),
-		result.NewReconditioner(r.ReconditionerConfigs()),
+		}), reportValidations...)),
+		reconditioner,
 	), nil
 }
 
@@ -298,13 +539,28 @@ func (r *Resolver) ORQueue() (*orclient.ORQueue, error) {
 		return nil, err
 	}
 
+	resourceClient, err := r.ResourceClient()
+	if err != nil {
+		return nil, err
+	}
+
+	reportValidations, err := r.reportFilterValidations()
+	if err != nil {
+		return nil, err
+	}
+
+	reconditioner, err := r.Reconditioner()
+	if err != nil {
+		return nil, err
+	}
+
 	return orclient.NewORQueue(
 		kubernetes.NewDebouncer(1*time.Minute, r.EventPublisher()),
 		workqueue.NewTypedRateLimitingQueueWithConfig(workqueue.DefaultTypedControllerRateLimiter[string](), workqueue.TypedRateLimitingQueueConfig[string]{
 			Name: "orreport-queue",
 		}),
 		polrClient,
-		report.NewSourceFilter(podsClient, jobsClient, replicasetsClient, gocache.New[types.UID, bool](1*time.Minute, 10*time.Second), helper.Map(r.config.SourceFilters, func(f SourceFilter) report.SourceValidation {
+		report.NewSourceFilter(podsClient, jobsClient, replicasetsClient, resourceClient, gocache.New[types.UID, bool](1*time.Minute, 10*time.Second), append(helper.Map(r.config.SourceFilters, func(f SourceFilter) report.SourceValidation {
 			return report.SourceValidation{
 				Selector:              report.ReportSelector(f.Selector),
 				Kinds:                 ToRuleSet(f.Kinds),
@@ -312,9 +568,11 @@ func (r *Resolver) ORQueue() (*orclient.ORQueue, error) {
 				Namespaces:            ToRuleSet(f.Namespaces),
 				UncontrolledOnly:      f.UncontrolledOnly,
 				DisableClusterReports: f.DisableClusterReports,
+				ResourceLabels:        ToRuleSet(f.ResourceLabels),
+				ResourceAnnotations:   ToRuleSet(f.ResourceAnnotations),
 			}
-		})),
-		result.NewReconditioner(r.ReconditionerConfigs()),
+		}), reportValidations...)),
+		reconditioner,
 	), nil
 }
 
@@ -335,9 +593,14 @@ func (r *Resolver) RegisterSendResultListener() {
 		r.RegisterNewResultsListener()
 	}
 
-	r.resultListener.RegisterListener(listener.NewSendResultListener(targets))
-	r.resultListener.RegisterScopeListener(listener.NewSendScopeResultsListener(targets))
-	r.resultListener.RegisterSyncListener(listener.NewSendSyncResultsListener(targets))
+	router, err := r.Router()
+	if err != nil {
+		zap.L().Error("invalid routing configuration, results are sent to all matching targets", zap.Error(err))
+	}
+
+	r.resultListener.RegisterListener(listener.NewSendResultListener(targets, router, r.Silences()))
+	r.resultListener.RegisterScopeListener(listener.NewSendScopeResultsListener(targets, router, r.Silences()))
+	r.resultListener.RegisterSyncListener(listener.NewSendSyncResultsListener(targets, r.Silences()))
 }
 
 // UnregisterSendResultListener resolver method
@@ -354,22 +617,169 @@ func (r *Resolver) UnregisterSendResultListener() {
 	r.resultListener.UnregisterScopeListener()
 }
 
+// Router resolver method, returns nil without configured receivers
+func (r *Resolver) Router() (*routing.Router, error) {
+	if r.router != nil || !r.config.Routing.Enabled() {
+		return r.router, nil
+	}
+
+	var nsClient namespaces.Client
+	if usesNamespaceLabels(r.config.Routing.Route) {
+		client, err := r.NamespaceClient()
+		if err != nil {
+			return nil, err
+		}
+
+		nsClient = client
+	}
+
+	router, err := routing.NewRouter(
+		mapRoute(r.config.Routing.Route),
+		helper.Map(r.config.Routing.Receivers, func(receiver Receiver) routing.Receiver {
+			return routing.Receiver{Name: receiver.Name, Targets: receiver.Targets}
+		}),
+		nsClient,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	clients := r.TargetClients()
+	for _, t := range clients.SyncClients() {
+		if router.Routed(t.Name()) {
+			zap.L().Warn("synchronized targets are not controlled by the routing tree", zap.String("target", t.Name()))
+		}
+	}
+
+	// targets of TargetConfig resources are created later, unknown names are not rejected
+	names := helper.Map(clients.Clients(), func(c target.Client) string { return c.Name() })
+	for _, name := range router.UnknownTargets(names) {
+		zap.L().Warn("receiver references an unknown target", zap.String("target", name))
+	}
+
+	r.router = router
+
+	return r.router, nil
+}
+
 // RegisterStoreListener resolver method
-func (r *Resolver) RegisterStoreListener(ctx context.Context, store report.PolicyReportStore) {
-	r.EventPublisher().RegisterListener(listener.Store, listener.NewStoreListener(store))
+func (r *Resolver) RegisterStoreListener(ctx context.Context, store report.PolicyReportStore, findings report.FindingStore) {
+	r.EventPublisher().RegisterListener(listener.Store, listener.NewStoreListener(store, findings))
+}
+
+// HubClient resolver method, returns nil if the agent mode is disabled
+func (r *Resolver) HubClient() *hub.Client {
+	agent := r.config.Hub.Agent
+	if !agent.Enabled || agent.Host == "" {
+		return nil
+	}
+
+	return hub.NewClient(agent.Host, agent.Token, r.config.Hub.Cluster, http.NewClient(agent.Certificate, agent.SkipTLS))
+}
+
+// RegisterHubListener pushes all report lifecycle events to the hub
+func (r *Resolver) RegisterHubListener(client *hub.Client) {
+	r.EventPublisher().RegisterListener(listener.Hub, listener.NewHubListener(client))
+}
+
+// HubTokens returns the configured ingest tokens of the agent clusters
+func (r *Resolver) HubTokens() []hub.Token {
+	return helper.Map(r.config.Hub.Ingest.Tokens, func(t HubToken) hub.Token {
+		return hub.Token{Cluster: t.Cluster, Token: t.Token}
+	})
+}
+
+// SummaryManager resolver method
+func (r *Resolver) SummaryManager() (*statussummary.Manager, error) {
+	if r.summaryManager != nil {
+		return r.summaryManager, nil
+	}
+
+	clientset, err := r.Clientset()
+	if err != nil {
+		return nil, err
+	}
+
+	config := r.config.StatusSummary
+
+	delay := 30 * time.Second
+	if config.Delay > 0 {
+		delay = time.Duration(config.Delay) * time.Second
+	}
+
+	top := 5
+	if config.TopPolicies > 0 {
+		top = config.TopPolicies
+	}
+
+	r.summaryManager = statussummary.NewManager(
+		statussummary.NewConfigMapWriter(clientset.CoreV1(), helper.Defaults(config.Name, statussummary.DefaultName)),
+		delay,
+		top,
+	).WithCluster(r.config.Hub.Cluster)
+
+	return r.summaryManager, nil
+}
+
+// RegisterSummaryListener resolver method
+func (r *Resolver) RegisterSummaryListener() error {
+	manager, err := r.SummaryManager()
+	if err != nil {
+		return err
+	}
+
+	r.EventPublisher().RegisterListener(listener.Summary, listener.NewSummaryListener(manager))
+
+	return nil
 }
 
 // RegisterMetricsListener resolver method
-func (r *Resolver) RegisterMetricsListener() {
+func (r *Resolver) RegisterMetricsListener() error {
+	resultFilter := metrics.NewResultFilter(
+		ToRuleSet(r.config.Metrics.Filter.Namespaces),
+		ToRuleSet(r.config.Metrics.Filter.Status),
+		ToRuleSet(r.config.Metrics.Filter.Policies),
+		ToRuleSet(r.config.Metrics.Filter.Sources),
+		ToRuleSet(r.config.Metrics.Filter.Severities),
+		ToRuleSet(r.config.Metrics.Filter.Kinds),
+	)
+
+	if r.config.Metrics.Filter.CEL != "" {
+		nsClient, err := r.NamespaceClient()
+		if err != nil {
+			return err
+		}
+
+		program, err := expression.Compile(r.config.Metrics.Filter.CEL, nsClient)
+		if err != nil {
+			return fmt.Errorf("invalid metrics.filter.cel: %w", err)
+		}
+
+		resultFilter.AddReportValidation(program.MatchResult)
+	}
+
+	labels := ToRuleSet(r.config.Metrics.Filter.ResourceLabels)
+	annotations := ToRuleSet(r.config.Metrics.Filter.ResourceAnnotations)
+
+	if labels.Enabled() || annotations.Enabled() {
+		resourceClient, err := r.ResourceClient()
+		if err != nil {
+			return err
+		}
+
+		resultFilter.AddReportValidation(func(rep openreports.ReportInterface, res openreports.ResultAdapter) bool {
+			return report.ValidateResourceMetadata(resourceClient, report.ResultResource(rep, res), labels, annotations)
+		})
+	}
+
+	if !r.config.Suppression.IncludeInMetrics {
+		resultFilter.AddValidation(func(res openreports.ResultAdapter) bool {
+			return !result.IsSuppressed(res)
+		})
+	}
+
 	r.EventPublisher().RegisterListener(listener.Metrics, listener.NewMetricsListener(
-		metrics.NewResultFilter(
-			ToRuleSet(r.config.Metrics.Filter.Namespaces),
-			ToRuleSet(r.config.Metrics.Filter.Status),
-			ToRuleSet(r.config.Metrics.Filter.Policies),
-			ToRuleSet(r.config.Metrics.Filter.Sources),
-			ToRuleSet(r.config.Metrics.Filter.Severities),
-			ToRuleSet(r.config.Metrics.Filter.Kinds),
-		),
+		resultFilter,
 		metrics.NewReportFilter(
 			ToRuleSet(r.config.Metrics.Filter.Namespaces),
 			ToRuleSet(r.config.Metrics.Filter.Sources),
@@ -377,6 +787,8 @@ func (r *Resolver) RegisterMetricsListener() {
 		r.config.Metrics.Mode,
 		r.config.Metrics.CustomLabels,
 	))
+
+	return nil
 }
 
 // Clientset resolver method
@@ -460,6 +872,44 @@ func (r *Resolver) JobClient() (jobs.Client, error) {
 	return jobs.NewClient(clientset.BatchV1()), nil
 }
 
+// ResourceClient resolver method, metadata informers are started on demand for each resource type
+func (r *Resolver) ResourceClient() (resources.Client, error) {
+	if r.resourceClient != nil {
+		return r.resourceClient, nil
+	}
+
+	client, err := r.CRDMetadataClient()
+	if err != nil {
+		return nil, err
+	}
+
+	clientset, err := r.Clientset()
+	if err != nil {
+		return nil, err
+	}
+
+	r.resourceClient = resources.NewClient(
+		client,
+		restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(clientset.Discovery())),
+		wait.NeverStop,
+	)
+
+	return r.resourceClient, nil
+}
+
+// PolicyClient resolver method
+func (r *Resolver) PolicyClient() (policies.Client, error) {
+	client, err := r.CRDMetadataClient()
+	if err != nil {
+		return nil, err
+	}
+
+	return policies.NewClient(
+		client,
+		gocache.New[string, map[string]string](5*time.Minute, 15*time.Second),
+	), nil
+}
+
 func (r *Resolver) TargetFactory() target.Factory {
 	if r.targetFactory != nil {
 		return r.targetFactory
@@ -470,7 +920,25 @@ func (r *Resolver) TargetFactory() target.Factory {
 		zap.L().Error("failed to create namespace client", zap.Error(err))
 	}
 
-	r.targetFactory = factory.NewFactory(r.SecretClient(), target.NewResultFilterFactory(ns))
+	opts := make([]factory.Option, 0, 2)
+	if policyClient, err := r.PolicyClient(); err == nil {
+		opts = append(opts, factory.WithPolicyClient(policyClient))
+	} else {
+		zap.L().Error("failed to create policy client", zap.Error(err))
+	}
+
+	if clientset, err := r.Clientset(); err == nil {
+		opts = append(opts, factory.WithEventsClient(clientset.CoreV1(), r.config.Hub.Cluster))
+	} else {
+		zap.L().Error("failed to create events client", zap.Error(err))
+	}
+
+	rs, err := r.ResourceClient()
+	if err != nil {
+		zap.L().Error("failed to create resource metadata client", zap.Error(err))
+	}
+
+	r.targetFactory = factory.NewFactory(r.SecretClient(), target.NewResultFilterFactory(ns, rs).IncludeSuppressed(r.config.Suppression.SendToTargets), opts...)
 
 	return r.targetFactory
 }
@@ -609,11 +1077,17 @@ func (r *Resolver) SummaryGenerator() (*summary.Generator, error) {
 		return nil, err
 	}
 
+	suppressor, err := r.Suppressor()
+	if err != nil {
+		return nil, err
+	}
+
 	return summary.NewGenerator(
 		orclient,
 		wgpolicyclient,
 		EmailReportFilterFromConfig(nsclient, r.config.EmailReports.Summary.Filter),
 		!r.config.EmailReports.Summary.Filter.DisableClusterReports,
+		suppressor,
 	), nil
 }
 
@@ -756,13 +1230,39 @@ func (r *Resolver) TargetConfigClient() (*targetconfig.Client, error) {
 		return nil, err
 	}
 
-	tcc := targetconfig.NewClient(tcClient, r.TargetFactory(), r.TargetClients(), orClient, wgpolicyClient)
+	router, err := r.Router()
+	if err != nil {
+		return nil, err
+	}
+
+	tcc := targetconfig.NewClient(tcClient, r.TargetFactory(), r.TargetClients(), orClient, wgpolicyClient, router, r.Silences())
 	tcc.ConfigureInformer()
 
 	r.targetConfigClient = tcc
 	return tcc, nil
 }
 
+// Silences resolver method, returns nil if the Silence CRD is disabled
+func (r *Resolver) Silences() *silence.Store {
+	if r.silences == nil && r.config.CRD.Silence {
+		r.silences = silence.NewStore()
+	}
+
+	return r.silences
+}
+
+func (r *Resolver) SilenceClient() (*silence.Client, error) {
+	client, err := crds.NewForConfig(r.k8sConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	sc := silence.NewClient(client, r.Silences())
+	sc.ConfigureInformer()
+
+	return sc, nil
+}
+
 func (r *Resolver) WGPolicyReportClient() (report.PolicyReportClient, error) {
 	if r.wgpolicyClient != nil {
 		return r.wgpolicyClient, nil
@@ -839,6 +1339,164 @@ func (r *Resolver) OpenReportsClient() (report.PolicyReportClient, error) {
 	return r.openreportsClient, nil
 }
 
+// Clusters returns the names of all clusters watched by this instance, the local cluster first
+func (r *Resolver) Clusters() []string {
+	clusters := []string{r.config.Hub.Cluster}
+	for _, c := range r.config.K8sClient.Clusters {
+		clusters = append(clusters, c.Name)
+	}
+
+	return clusters
+}
+
+// ClusterResolver creates a Resolver for an additional cluster. It shares the EventPublisher and the result cache
+// with this Resolver and tags all reports of the cluster with its name.
+func (r *Resolver) ClusterResolver(ctx context.Context, cluster K8sCluster) (*Resolver, error) {
+	if cluster.Name == "" {
+		return nil, errors.New("cluster name is required")
+	}
+	if cluster.Name == r.config.Hub.Cluster {
+		return nil, fmt.Errorf("cluster name %s is already used by the local cluster", cluster.Name)
+	}
+
+	k8sConfig, err := r.clusterConfig(ctx, cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	c := *r.config
+	c.Hub.Cluster = cluster.Name
+
+	child := NewResolver(&c, k8sConfig)
+	child.publisher = r.EventPublisher()
+	child.resultCache = r.ResultCache()
+	child.logger = r.logger
+
+	return child, nil
+}
+
+// ClusterClients resolves the report clients of all additional clusters. Invalid cluster names fail,
+// clusters which can't be reached are logged and skipped to not block the local cluster.
+func (r *Resolver) ClusterClients(ctx context.Context) ([]report.PolicyReportClient, error) {
+	clients := make([]report.PolicyReportClient, 0, 2*len(r.config.K8sClient.Clusters))
+	names := make(map[string]bool, len(r.config.K8sClient.Clusters))
+
+	for _, cluster := range r.config.K8sClient.Clusters {
+		if cluster.Name == "" {
+			return nil, errors.New("cluster name is required")
+		}
+		if cluster.Name == r.config.Hub.Cluster {
+			return nil, fmt.Errorf("cluster name %s is already used by the local cluster", cluster.Name)
+		}
+		if names[cluster.Name] {
+			return nil, fmt.Errorf("duplicated cluster name %s", cluster.Name)
+		}
+		names[cluster.Name] = true
+
+		cc, err := r.clusterClients(ctx, cluster)
+		if err != nil {
+			zap.L().Error("skip additional cluster", zap.String("cluster", cluster.Name), zap.Error(err))
+			continue
+		}
+
+		clients = append(clients, cc...)
+
+		zap.L().Info("watch additional cluster", zap.String("cluster", cluster.Name))
+	}
+
+	return clients, nil
+}
+
+func (r *Resolver) clusterClients(ctx context.Context, cluster K8sCluster) ([]report.PolicyReportClient, error) {
+	child, err := r.ClusterResolver(ctx, cluster)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve cluster: %w", err)
+	}
+
+	clients := make([]report.PolicyReportClient, 0, 2)
+
+	orClient, err := child.OpenReportsClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create openreports client: %w", err)
+	}
+	if orClient != nil {
+		clients = append(clients, orClient)
+	}
+
+	wgClient, err := child.WGPolicyReportClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create wgpolicy client: %w", err)
+	}
+	if wgClient != nil {
+		clients = append(clients, wgClient)
+	}
+
+	if len(clients) == 0 {
+		return nil, errors.New("no valid reporting API group found")
+	}
+
+	return clients, nil
+}
+
+func (r *Resolver) clusterConfig(ctx context.Context, cluster K8sCluster) (*rest.Config, error) {
+	var kubeconfig []byte
+
+	if cluster.SecretRef != "" {
+		client := r.SecretClient()
+		if client == nil {
+			return nil, errors.New("failed to create secret client")
+		}
+
+		values, err := client.Get(ctx, cluster.SecretRef)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load kubeconfig secret: %w", err)
+		}
+
+		kubeconfig = []byte(values.Kubeconfig)
+	} else {
+		content, err := os.ReadFile(cluster.Kubeconfig)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read kubeconfig: %w", err)
+		}
+
+		kubeconfig = content
+	}
+
+	apiConfig, err := clientcmd.Load(kubeconfig)
+	if err != nil {
+		return nil, fmt.Errorf("invalid kubeconfig: %w", err)
+	}
+
+	k8sConfig, err := clientcmd.NewDefaultClientConfig(*apiConfig, &clientcmd.ConfigOverrides{CurrentContext: cluster.Context}).ClientConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	k8sConfig.QPS = r.config.K8sClient.QPS
+	k8sConfig.Burst = r.config.K8sClient.Burst
+
+	return k8sConfig, nil
+}
+
+// reportFilterValidations of the global report filter which require the complete report
+func (r *Resolver) reportFilterValidations() ([]report.SourceValidation, error) {
+	if r.config.ReportFilter.CEL == "" {
+		return nil, nil
+	}
+
+	nsClient, err := r.NamespaceClient()
+	if err != nil {
+		return nil, err
+	}
+
+	program, err := expression.CompileReport(r.config.ReportFilter.CEL, nsClient)
+	if err != nil {
+		return nil, fmt.Errorf("invalid reportFilter.cel: %w", err)
+	}
+
+	return []report.SourceValidation{{Expression: program}}, nil
+}
+
 func (r *Resolver) ReportFilter() *report.MetaFilter {
 	return report.NewMetaFilter(
 		r.config.ReportFilter.DisableClusterReports,
@@ -940,6 +1598,36 @@ func EmailReportFilterFromConfig(client namespaces.Client, config EmailReportFil
 	)
 }
 
+func mapRoute(route Route) *routing.Route {
+	return &routing.Route{
+		Receiver: route.Receiver,
+		Continue: route.Continue,
+		Matchers: routing.Matchers{
+			Namespaces:      ToRuleSet(route.Matchers.Namespaces),
+			NamespaceLabels: route.Matchers.NamespaceLabels,
+			Policies:        ToRuleSet(route.Matchers.Policies),
+			Severities:      ToRuleSet(route.Matchers.Severities),
+			Sources:         ToRuleSet(route.Matchers.Sources),
+			ReportLabels:    route.Matchers.ReportLabels,
+		},
+		Routes: helper.Map(route.Routes, mapRoute),
+	}
+}
+
+func usesNamespaceLabels(route Route) bool {
+	if len(route.Matchers.NamespaceLabels) > 0 {
+		return true
+	}
+
+	for _, child := range route.Routes {
+		if usesNamespaceLabels(child) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func ToRuleSet(filter ValueFilter) validate.RuleSets {
 	return validate.RuleSets{
 		Include:  filter.Include,
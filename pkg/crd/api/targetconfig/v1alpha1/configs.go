@@ -128,6 +128,16 @@ type KinesisOptions struct {
 	StreamName string `mapstructure:"streamName" json:"streamName"`
 }
 
+type SNSOptions struct {
+	AWSConfig `mapstructure:",squash" json:",inline"`
+	TopicARN  string `mapstructure:"topicArn" json:"topicArn"`
+}
+
+type SQSOptions struct {
+	AWSConfig `mapstructure:",squash" json:",inline"`
+	QueueURL  string `mapstructure:"queueUrl" json:"queueUrl"`
+}
+
 type SecurityHubOptions struct {
 	AWSConfig   `mapstructure:",squash" json:",inline"`
 	AccountID   string `mapstructure:"accountId" json:"accountId"`
@@ -138,6 +148,109 @@ type SecurityHubOptions struct {
 	DelayInSeconds int `mapstructure:"delayInSeconds" json:"delayInSeconds"`
 	// +optional
 	Synchronize bool `mapstructure:"synchronize" json:"synchronize"`
+	// +optional
+	RoleARN string `mapstructure:"roleArn" json:"roleArn"`
+	// +optional
+	ExternalID string `mapstructure:"externalId" json:"externalId"`
+	// +optional
+	RequirementsAnnotation string `mapstructure:"requirementsAnnotation" json:"requirementsAnnotation"`
+	// +optional
+	Routes []SecurityHubRoute `mapstructure:"routes" json:"routes"`
+}
+
+type SecurityHubRoute struct {
+	// +optional
+	Namespaces []string `mapstructure:"namespaces" json:"namespaces"`
+	// +optional
+	ReportLabels map[string]string `mapstructure:"reportLabels" json:"reportLabels"`
+	AccountID    string            `mapstructure:"accountId" json:"accountId"`
+	// +optional
+	Region string `mapstructure:"region" json:"region"`
+	// +optional
+	RoleARN string `mapstructure:"roleArn" json:"roleArn"`
+	// +optional
+	ExternalID string `mapstructure:"externalId" json:"externalId"`
+}
+
+type DefectDojoOptions struct {
+	HostOptions `mapstructure:",squash" json:",inline"`
+	APIKey      string `mapstructure:"apiKey" json:"apiKey"`
+	// +optional
+	ProductType string `mapstructure:"productType" json:"productType"`
+	// +optional
+	ProductTemplate string `mapstructure:"productTemplate" json:"productTemplate"`
+	// +optional
+	EngagementTemplate string `mapstructure:"engagementTemplate" json:"engagementTemplate"`
+}
+
+type MattermostOptions struct {
+	WebhookOptions `mapstructure:",squash" json:",inline"`
+	// +optional
+	Channel string `mapstructure:"channel" json:"channel"`
+	// +optional
+	Username string `mapstructure:"username" json:"username"`
+	// +optional
+	IconURL string `mapstructure:"iconUrl" json:"iconUrl"`
+}
+
+type RocketChatOptions struct {
+	WebhookOptions `mapstructure:",squash" json:",inline"`
+	// +optional
+	Channel string `mapstructure:"channel" json:"channel"`
+	// +optional
+	Alias string `mapstructure:"alias" json:"alias"`
+	// +optional
+	Avatar string `mapstructure:"avatar" json:"avatar"`
+}
+
+type WebexOptions struct {
+	Token  string `mapstructure:"token" json:"token"`
+	RoomID string `mapstructure:"roomId" json:"roomId"`
+	// +optional
+	Host string `mapstructure:"host" json:"host"`
+	// +optional
+	SkipTLS bool `mapstructure:"skipTLS" json:"skipTLS"`
+	// +optional
+	Certificate string `mapstructure:"certificate" json:"certificate"`
+	// +optional
+	Headers map[string]string `mapstructure:"headers" json:"headers"`
+	// +optional
+	Keepalive *KeepaliveConfig `mapstructure:"keepalive" json:"keepalive"`
+}
+
+type MatrixOptions struct {
+	HostOptions `mapstructure:",squash" json:",inline"`
+	AccessToken string `mapstructure:"accessToken" json:"accessToken"`
+	RoomID      string `mapstructure:"roomId" json:"roomId"`
+	// +optional
+	Keepalive *KeepaliveConfig `mapstructure:"keepalive" json:"keepalive"`
+}
+
+type ServiceNowOptions struct {
+	HostOptions `mapstructure:",squash" json:",inline"`
+	// +optional
+	Table string `mapstructure:"table" json:"table"`
+	// +optional
+	Username string `mapstructure:"username" json:"username"`
+	// +optional
+	Password string `mapstructure:"password" json:"password"`
+	// +optional
+	ClientID string `mapstructure:"clientId" json:"clientId"`
+	// +optional
+	ClientSecret string `mapstructure:"clientSecret" json:"clientSecret"`
+	// +optional
+	Fields map[string]string `mapstructure:"fields" json:"fields"`
+	// +optional
+	ResolveFields map[string]string `mapstructure:"resolveFields" json:"resolveFields"`
+}
+
+type KubernetesEventsOptions struct {
+	// Component used as event source, defaults to policy-reporter
+	// +optional
+	Component string `mapstructure:"component" json:"component"`
+	// BurstSize of the per object event rate limit
+	// +optional
+	BurstSize int `mapstructure:"burstSize" json:"burstSize"`
 }
 
 type GCSOptions struct {
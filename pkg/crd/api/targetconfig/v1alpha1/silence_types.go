@@ -0,0 +1,118 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SilenceMatchers select the muted results, all configured matchers have to match.
+// Wildcards are supported for all values except severities.
+type SilenceMatchers struct {
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty"`
+
+	// +optional
+	Policies []string `json:"policies,omitempty"`
+
+	// +optional
+	Rules []string `json:"rules,omitempty"`
+
+	// +optional
+	Severities []string `json:"severities,omitempty"`
+
+	// Kinds of the affected resource
+	// +optional
+	Kinds []string `json:"kinds,omitempty"`
+
+	// Names of the affected resource
+	// +optional
+	Resources []string `json:"resources,omitempty"`
+
+	// Names of the muted targets, empty means all targets
+	// +optional
+	Targets []string `json:"targets,omitempty"`
+}
+
+// SilenceSchedule defines a recurring maintenance window
+type SilenceSchedule struct {
+	// Cron expression for the start of each window
+	Cron string `json:"cron"`
+
+	// Duration of each window, e.g. 2h
+	Duration metav1.Duration `json:"duration"`
+
+	// IANA time zone of the cron expression, defaults to UTC
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+}
+
+// SilenceSpec defines the desired state of Silence.
+type SilenceSpec struct {
+	Matchers SilenceMatchers `json:"matchers"`
+
+	// Start of the silence, defaults to the creation time
+	// +optional
+	StartsAt *metav1.Time `json:"startsAt,omitempty"`
+
+	// End of the silence, an empty value never expires
+	// +optional
+	EndsAt *metav1.Time `json:"endsAt,omitempty"`
+
+	// Recurring window within startsAt and endsAt
+	// +optional
+	Schedule *SilenceSchedule `json:"schedule,omitempty"`
+
+	// +optional
+	CreatedBy string `json:"createdBy,omitempty"`
+
+	// +optional
+	Comment string `json:"comment,omitempty"`
+}
+
+// SilenceStatus defines the observed state of Silence.
+type SilenceStatus struct{}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=silences,scope=Cluster
+// +kubebuilder:printcolumn:name="Starts",type=string,JSONPath=`.spec.startsAt`
+// +kubebuilder:printcolumn:name="Ends",type=string,JSONPath=`.spec.endsAt`
+// +kubebuilder:printcolumn:name="Schedule",type=string,JSONPath=`.spec.schedule.cron`
+// +kubebuilder:printcolumn:name="Comment",type=string,JSONPath=`.spec.comment`
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +genclient
+
+// Silence mutes matching results in the target pipeline for a time range or a recurring window.
+type Silence struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SilenceSpec   `json:"spec,omitempty"`
+	Status SilenceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SilenceList contains a list of Silence.
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type SilenceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Silence `json:"items"`
+}
@@ -29,10 +29,19 @@ import (
 // +kubebuilder:oneOf:={required:{loki}}
 // +kubebuilder:oneOf:={required:{securityHub}}
 // +kubebuilder:oneOf:={required:{kinesis}}
+// +kubebuilder:oneOf:={required:{sns}}
+// +kubebuilder:oneOf:={required:{sqs}}
 // +kubebuilder:oneOf:={required:{splunk}}
 // +kubebuilder:oneOf:={required:{teams}}
 // +kubebuilder:oneOf:={required:{jira}}
 // +kubebuilder:oneOf:={required:{alertManager}}
+// +kubebuilder:oneOf:={required:{defectDojo}}
+// +kubebuilder:oneOf:={required:{mattermost}}
+// +kubebuilder:oneOf:={required:{rocketChat}}
+// +kubebuilder:oneOf:={required:{webex}}
+// +kubebuilder:oneOf:={required:{matrix}}
+// +kubebuilder:oneOf:={required:{serviceNow}}
+// +kubebuilder:oneOf:={required:{kubernetesEvents}}
 
 // TargetConfigSpec defines the desired state of TargetConfig.
 type TargetConfigSpec struct {
@@ -65,6 +74,12 @@ type TargetConfigSpec struct {
 	// +optional
 	Kinesis *KinesisOptions `json:"kinesis,omitempty"`
 
+	// +optional
+	SNS *SNSOptions `json:"sns,omitempty"`
+
+	// +optional
+	SQS *SQSOptions `json:"sqs,omitempty"`
+
 	// +optional
 	Teams *WebhookOptions `json:"teams,omitempty"`
 
@@ -77,6 +92,27 @@ type TargetConfigSpec struct {
 	// +optional
 	Splunk *SplunkOptions `json:"splunk,omitempty"`
 
+	// +optional
+	DefectDojo *DefectDojoOptions `json:"defectDojo,omitempty"`
+
+	// +optional
+	Mattermost *MattermostOptions `json:"mattermost,omitempty"`
+
+	// +optional
+	RocketChat *RocketChatOptions `json:"rocketChat,omitempty"`
+
+	// +optional
+	Webex *WebexOptions `json:"webex,omitempty"`
+
+	// +optional
+	Matrix *MatrixOptions `json:"matrix,omitempty"`
+
+	// +optional
+	ServiceNow *ServiceNowOptions `json:"serviceNow,omitempty"`
+
+	// +optional
+	KubernetesEvents *KubernetesEventsOptions `json:"kubernetesEvents,omitempty"`
+
 	// +kubebuilder:default=true
 	// +optional
 	SkipExisting bool `json:"skipExistingOnStartup,omitempty"`
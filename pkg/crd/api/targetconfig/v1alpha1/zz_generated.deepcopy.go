@@ -70,6 +70,23 @@ func (in *Config) DeepCopy() *Config {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DefectDojoOptions) DeepCopyInto(out *DefectDojoOptions) {
+	*out = *in
+	in.HostOptions.DeepCopyInto(&out.HostOptions)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefectDojoOptions.
+func (in *DefectDojoOptions) DeepCopy() *DefectDojoOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(DefectDojoOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ElasticsearchOptions) DeepCopyInto(out *ElasticsearchOptions) {
 	*out = *in
@@ -192,6 +209,22 @@ func (in *KinesisOptions) DeepCopy() *KinesisOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesEventsOptions) DeepCopyInto(out *KubernetesEventsOptions) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesEventsOptions.
+func (in *KubernetesEventsOptions) DeepCopy() *KubernetesEventsOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesEventsOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LokiOptions) DeepCopyInto(out *LokiOptions) {
 	*out = *in
@@ -209,6 +242,62 @@ func (in *LokiOptions) DeepCopy() *LokiOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MatrixOptions) DeepCopyInto(out *MatrixOptions) {
+	*out = *in
+	in.HostOptions.DeepCopyInto(&out.HostOptions)
+	if in.Keepalive != nil {
+		in, out := &in.Keepalive, &out.Keepalive
+		*out = new(KeepaliveConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MatrixOptions.
+func (in *MatrixOptions) DeepCopy() *MatrixOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(MatrixOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MattermostOptions) DeepCopyInto(out *MattermostOptions) {
+	*out = *in
+	in.WebhookOptions.DeepCopyInto(&out.WebhookOptions)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MattermostOptions.
+func (in *MattermostOptions) DeepCopy() *MattermostOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(MattermostOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RocketChatOptions) DeepCopyInto(out *RocketChatOptions) {
+	*out = *in
+	in.WebhookOptions.DeepCopyInto(&out.WebhookOptions)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RocketChatOptions.
+func (in *RocketChatOptions) DeepCopy() *RocketChatOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(RocketChatOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *S3Options) DeepCopyInto(out *S3Options) {
 	*out = *in
@@ -226,10 +315,51 @@ func (in *S3Options) DeepCopy() *S3Options {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SNSOptions) DeepCopyInto(out *SNSOptions) {
+	*out = *in
+	out.AWSConfig = in.AWSConfig
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SNSOptions.
+func (in *SNSOptions) DeepCopy() *SNSOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(SNSOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SQSOptions) DeepCopyInto(out *SQSOptions) {
+	*out = *in
+	out.AWSConfig = in.AWSConfig
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SQSOptions.
+func (in *SQSOptions) DeepCopy() *SQSOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(SQSOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecurityHubOptions) DeepCopyInto(out *SecurityHubOptions) {
 	*out = *in
 	out.AWSConfig = in.AWSConfig
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]SecurityHubRoute, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -243,6 +373,240 @@ func (in *SecurityHubOptions) DeepCopy() *SecurityHubOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityHubRoute) DeepCopyInto(out *SecurityHubRoute) {
+	*out = *in
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ReportLabels != nil {
+		in, out := &in.ReportLabels, &out.ReportLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityHubRoute.
+func (in *SecurityHubRoute) DeepCopy() *SecurityHubRoute {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityHubRoute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceNowOptions) DeepCopyInto(out *ServiceNowOptions) {
+	*out = *in
+	in.HostOptions.DeepCopyInto(&out.HostOptions)
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ResolveFields != nil {
+		in, out := &in.ResolveFields, &out.ResolveFields
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceNowOptions.
+func (in *ServiceNowOptions) DeepCopy() *ServiceNowOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceNowOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Silence) DeepCopyInto(out *Silence) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Silence.
+func (in *Silence) DeepCopy() *Silence {
+	if in == nil {
+		return nil
+	}
+	out := new(Silence)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Silence) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SilenceList) DeepCopyInto(out *SilenceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Silence, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SilenceList.
+func (in *SilenceList) DeepCopy() *SilenceList {
+	if in == nil {
+		return nil
+	}
+	out := new(SilenceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SilenceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SilenceMatchers) DeepCopyInto(out *SilenceMatchers) {
+	*out = *in
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Policies != nil {
+		in, out := &in.Policies, &out.Policies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Severities != nil {
+		in, out := &in.Severities, &out.Severities
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Kinds != nil {
+		in, out := &in.Kinds, &out.Kinds
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SilenceMatchers.
+func (in *SilenceMatchers) DeepCopy() *SilenceMatchers {
+	if in == nil {
+		return nil
+	}
+	out := new(SilenceMatchers)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SilenceSchedule) DeepCopyInto(out *SilenceSchedule) {
+	*out = *in
+	out.Duration = in.Duration
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SilenceSchedule.
+func (in *SilenceSchedule) DeepCopy() *SilenceSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(SilenceSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SilenceSpec) DeepCopyInto(out *SilenceSpec) {
+	*out = *in
+	in.Matchers.DeepCopyInto(&out.Matchers)
+	if in.StartsAt != nil {
+		in, out := &in.StartsAt, &out.StartsAt
+		*out = (*in).DeepCopy()
+	}
+	if in.EndsAt != nil {
+		in, out := &in.EndsAt, &out.EndsAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = new(SilenceSchedule)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SilenceSpec.
+func (in *SilenceSpec) DeepCopy() *SilenceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SilenceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SilenceStatus) DeepCopyInto(out *SilenceStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SilenceStatus.
+func (in *SilenceStatus) DeepCopy() *SilenceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SilenceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SlackOptions) DeepCopyInto(out *SlackOptions) {
 	*out = *in
@@ -380,13 +744,23 @@ func (in *TargetConfigSpec) DeepCopyInto(out *TargetConfigSpec) {
 	if in.SecurityHub != nil {
 		in, out := &in.SecurityHub, &out.SecurityHub
 		*out = new(SecurityHubOptions)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Kinesis != nil {
 		in, out := &in.Kinesis, &out.Kinesis
 		*out = new(KinesisOptions)
 		**out = **in
 	}
+	if in.SNS != nil {
+		in, out := &in.SNS, &out.SNS
+		*out = new(SNSOptions)
+		**out = **in
+	}
+	if in.SQS != nil {
+		in, out := &in.SQS, &out.SQS
+		*out = new(SQSOptions)
+		**out = **in
+	}
 	if in.Teams != nil {
 		in, out := &in.Teams, &out.Teams
 		*out = new(WebhookOptions)
@@ -407,6 +781,41 @@ func (in *TargetConfigSpec) DeepCopyInto(out *TargetConfigSpec) {
 		*out = new(SplunkOptions)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DefectDojo != nil {
+		in, out := &in.DefectDojo, &out.DefectDojo
+		*out = new(DefectDojoOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Mattermost != nil {
+		in, out := &in.Mattermost, &out.Mattermost
+		*out = new(MattermostOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RocketChat != nil {
+		in, out := &in.RocketChat, &out.RocketChat
+		*out = new(RocketChatOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Webex != nil {
+		in, out := &in.Webex, &out.Webex
+		*out = new(WebexOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Matrix != nil {
+		in, out := &in.Matrix, &out.Matrix
+		*out = new(MatrixOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceNow != nil {
+		in, out := &in.ServiceNow, &out.ServiceNow
+		*out = new(ServiceNowOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KubernetesEvents != nil {
+		in, out := &in.KubernetesEvents, &out.KubernetesEvents
+		*out = new(KubernetesEventsOptions)
+		**out = **in
+	}
 	return
 }
 
@@ -453,6 +862,34 @@ func (in *TelegramOptions) DeepCopy() *TelegramOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebexOptions) DeepCopyInto(out *WebexOptions) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Keepalive != nil {
+		in, out := &in.Keepalive, &out.Keepalive
+		*out = new(KeepaliveConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebexOptions.
+func (in *WebexOptions) DeepCopy() *WebexOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(WebexOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WebhookOptions) DeepCopyInto(out *WebhookOptions) {
 	*out = *in
@@ -29,6 +29,7 @@ import (
 
 type PolicyreporterV1alpha1Interface interface {
 	RESTClient() rest.Interface
+	SilencesGetter
 	TargetConfigsGetter
 }
 
@@ -37,6 +38,10 @@ type PolicyreporterV1alpha1Client struct {
 	restClient rest.Interface
 }
 
+func (c *PolicyreporterV1alpha1Client) Silences(namespace string) SilenceInterface {
+	return newSilences(c, namespace)
+}
+
 func (c *PolicyreporterV1alpha1Client) TargetConfigs(namespace string) TargetConfigInterface {
 	return newTargetConfigs(c, namespace)
 }
@@ -24,6 +24,8 @@ import (
 
 // Interface provides access to all the informers in this group version.
 type Interface interface {
+	// Silences returns a SilenceInformer.
+	Silences() SilenceInformer
 	// TargetConfigs returns a TargetConfigInformer.
 	TargetConfigs() TargetConfigInformer
 }
@@ -39,6 +41,11 @@ func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakList
 	return &version{factory: f, namespace: namespace, tweakListOptions: tweakListOptions}
 }
 
+// Silences returns a SilenceInformer.
+func (v *version) Silences() SilenceInformer {
+	return &silenceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // TargetConfigs returns a TargetConfigInformer.
 func (v *version) TargetConfigs() TargetConfigInformer {
 	return &targetConfigInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -18,6 +18,14 @@ limitations under the License.
 
 package v1alpha1
 
+// SilenceListerExpansion allows custom methods to be added to
+// SilenceLister.
+type SilenceListerExpansion interface{}
+
+// SilenceNamespaceListerExpansion allows custom methods to be added to
+// SilenceNamespaceLister.
+type SilenceNamespaceListerExpansion interface{}
+
 // TargetConfigListerExpansion allows custom methods to be added to
 // TargetConfigLister.
 type TargetConfigListerExpansion interface{}
@@ -0,0 +1,348 @@
+package database
+
+import (
+	"compress/gzip"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+// ArchiveFormat is the version of the archive layout, independent of the database schema version
+const ArchiveFormat = 1
+
+const archiveBatchSize = 100
+
+// ArchiveHeader is the first line of an archive
+type ArchiveHeader struct {
+	Format  int    `json:"format"`
+	Schema  int    `json:"schema"`
+	Version string `json:"version"`
+	Created int64  `json:"created"`
+}
+
+// ArchiveStats counts the exported or imported rows per table
+type ArchiveStats map[string]int
+
+// archiveRow is a single row of a table, encoded as the JSON representation of its model to be independent of the dialect
+type archiveRow struct {
+	Table string          `json:"table"`
+	Row   json.RawMessage `json:"row"`
+}
+
+// archivedResult includes the report ID, which is omitted in the JSON representation of a result
+type archivedResult struct {
+	*PolicyReportResult
+	PolicyReportID string `json:"policyReportID"`
+}
+
+// archiveTable exports all rows of a table in batches and imports a batch of decoded rows
+type archiveTable struct {
+	name   string
+	export func(ctx context.Context, tx bun.Tx, write func(rows []any) error) error
+	insert func(ctx context.Context, tx bun.Tx, rows []json.RawMessage) error
+}
+
+// archiveTables are exported and imported in the order of their foreign keys,
+// rows are exported ordered by a unique key of the table to page with the last exported key
+var archiveTables = []archiveTable{
+	{
+		name: "policy_report",
+		export: exportArchiveRows([]string{"pr.id"}, func(r PolicyReport) []any {
+			return []any{r.ID}
+		}),
+		insert: insertArchiveRows[PolicyReport](),
+	},
+	{
+		name: "policy_report_result",
+		export: exportArchiveRows([]string{"r.id"}, func(r PolicyReportResult) []any {
+			return []any{r.ID}
+		}, func(r PolicyReportResult) any {
+			return archivedResult{PolicyReportResult: &r, PolicyReportID: r.PolicyReportID}
+		}),
+		insert: func(ctx context.Context, tx bun.Tx, rows []json.RawMessage) error {
+			results := make([]*PolicyReportResult, 0, len(rows))
+			for _, row := range rows {
+				result := archivedResult{}
+				if err := json.Unmarshal(row, &result); err != nil {
+					return err
+				}
+
+				result.PolicyReportResult.PolicyReportID = result.PolicyReportID
+				results = append(results, result.PolicyReportResult)
+			}
+
+			return insertArchiveModels(ctx, tx, results)
+		},
+	},
+	{
+		name: "policy_report_resource",
+		export: exportArchiveRows([]string{"res.policy_report_id", "res.id", "res.source", "res.category"}, func(r ResourceResult) []any {
+			return []any{r.PolicyReportID, r.ID, r.Source, r.Category}
+		}),
+		insert: insertArchiveRows[ResourceResult](),
+	},
+	{
+		name: "policy_report_filter",
+		export: exportArchiveRows([]string{
+			"f.policy_report_id", "f.resource_namespace", "f.resource_kind", "f.resource_api", "f.source", "f.category", "f.policy", "f.severity", "f.result",
+		}, func(f PolicyReportFilter) []any {
+			return []any{f.PolicyReportID, f.Namespace, f.Kind, f.API, f.Source, f.Category, f.Policy, f.Severity, f.Result}
+		}),
+		insert: insertArchiveRows[PolicyReportFilter](),
+	},
+	{
+		name: "policy_report_trend",
+		export: exportArchiveRows([]string{
+			"t.created", "t.cluster", "t.source", "t.namespace", "t.policy", "t.result", "t.severity",
+		}, func(t Trend) []any {
+			return []any{t.Created, t.Cluster, t.Source, t.Namespace, t.Policy, t.Result, t.Severity}
+		}),
+		insert: insertArchiveRows[Trend](),
+	},
+	{
+		name: "policy_report_finding",
+		export: exportArchiveRows([]string{"fi.id"}, func(f Finding) []any {
+			return []any{f.ID}
+		}),
+		insert: insertArchiveRows[Finding](),
+	},
+	{
+		name: "policy_report_result_event",
+		export: exportArchiveRows([]string{"ev.id"}, func(e ResultEvent) []any {
+			return []any{e.ID}
+		}),
+		// generated IDs are assigned by the importing database
+		insert: insertArchiveRows[ResultEvent]("id"),
+	},
+}
+
+// exportArchiveRows pages through all rows of the model with the last exported key, the optional mapper
+// changes the encoded representation of a row
+func exportArchiveRows[T any](keys []string, key func(T) []any, mapper ...func(T) any) func(ctx context.Context, tx bun.Tx, write func(rows []any) error) error {
+	columns := strings.Join(keys, ", ")
+	condition := fmt.Sprintf("(%s) > (%s)", columns, strings.TrimSuffix(strings.Repeat("?, ", len(keys)), ", "))
+
+	return func(ctx context.Context, tx bun.Tx, write func(rows []any) error) error {
+		var cursor []any
+
+		for {
+			list := make([]T, 0, archiveBatchSize)
+
+			query := tx.NewSelect().Model(&list).OrderExpr(columns).Limit(archiveBatchSize)
+			if cursor != nil {
+				query.Where(condition, cursor...)
+			}
+
+			if err := query.Scan(ctx); err != nil {
+				return err
+			}
+
+			rows := make([]any, 0, len(list))
+			for _, item := range list {
+				if len(mapper) > 0 {
+					rows = append(rows, mapper[0](item))
+				} else {
+					rows = append(rows, item)
+				}
+			}
+
+			if err := write(rows); err != nil {
+				return err
+			}
+
+			if len(list) < archiveBatchSize {
+				return nil
+			}
+
+			cursor = key(list[len(list)-1])
+		}
+	}
+}
+
+// insertArchiveRows decodes the rows into the model and inserts them without the excluded columns
+func insertArchiveRows[T any](exclude ...string) func(ctx context.Context, tx bun.Tx, rows []json.RawMessage) error {
+	return func(ctx context.Context, tx bun.Tx, rows []json.RawMessage) error {
+		list := make([]*T, 0, len(rows))
+		for _, row := range rows {
+			value := new(T)
+			if err := json.Unmarshal(row, value); err != nil {
+				return err
+			}
+
+			list = append(list, value)
+		}
+
+		return insertArchiveModels(ctx, tx, list, exclude...)
+	}
+}
+
+func insertArchiveModels[T any](ctx context.Context, tx bun.Tx, list []*T, exclude ...string) error {
+	// ExcludeColumn lists all remaining columns explicitly, otherwise bun omits columns with defaults
+	// for all rows if the first row has a zero value
+	_, err := tx.NewInsert().Model(&list).ExcludeColumn(exclude...).Exec(ctx)
+
+	return err
+}
+
+// Export writes all reports with their results and aggregates and the history of trends, findings and result events
+// as gzip compressed NDJSON, the first line is the ArchiveHeader with the schema version of the database.
+func (s *Store) Export(ctx context.Context, w io.Writer) (ArchiveStats, error) {
+	version, err := s.SchemaVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	gz := gzip.NewWriter(w)
+	encoder := json.NewEncoder(gz)
+
+	err = encoder.Encode(ArchiveHeader{
+		Format:  ArchiveFormat,
+		Schema:  version,
+		Version: s.version,
+		Created: time.Now().Unix(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	stats := make(ArchiveStats, len(archiveTables))
+
+	// a single read-only transaction exports a consistent state of all tables while reports are updated
+	err = s.read().RunInTx(ctx, &sql.TxOptions{ReadOnly: true}, func(ctx context.Context, tx bun.Tx) error {
+		for _, table := range archiveTables {
+			err := table.export(ctx, tx, func(rows []any) error {
+				for _, row := range rows {
+					content, err := json.Marshal(row)
+					if err != nil {
+						return err
+					}
+
+					if err := encoder.Encode(archiveRow{Table: table.name, Row: content}); err != nil {
+						return err
+					}
+
+					stats[table.name]++
+				}
+
+				return nil
+			})
+			if err != nil {
+				return fmt.Errorf("failed to export %s: %w", table.name, err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return stats, err
+	}
+
+	return stats, gz.Close()
+}
+
+// Import reads an archive created by Export within a single transaction.
+// The archive has to match the schema version of the database and the database must not contain any data.
+func (s *Store) Import(ctx context.Context, r io.Reader) (ArchiveStats, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("invalid archive: %w", err)
+	}
+	defer gz.Close()
+
+	decoder := json.NewDecoder(gz)
+
+	header := ArchiveHeader{}
+	if err := decoder.Decode(&header); err != nil {
+		return nil, fmt.Errorf("invalid archive header: %w", err)
+	}
+
+	if header.Format != ArchiveFormat {
+		return nil, fmt.Errorf("unsupported archive format %d", header.Format)
+	}
+
+	version, err := s.SchemaVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if header.Schema != version {
+		return nil, fmt.Errorf("archive schema version %d does not match database schema version %d", header.Schema, version)
+	}
+
+	tables := make(map[string]*archiveTable, len(archiveTables))
+	for i := range archiveTables {
+		tables[archiveTables[i].name] = &archiveTables[i]
+	}
+
+	stats := make(ArchiveStats, len(archiveTables))
+
+	err = s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		for _, table := range archiveTables {
+			exists, err := tx.NewSelect().Table(table.name).Limit(1).Exists(ctx)
+			if err != nil {
+				return err
+			}
+			if exists {
+				return fmt.Errorf("database is not empty, %s contains data", table.name)
+			}
+		}
+
+		var table *archiveTable
+		batch := make([]json.RawMessage, 0, archiveBatchSize)
+
+		flush := func() error {
+			if table == nil || len(batch) == 0 {
+				return nil
+			}
+
+			if err := table.insert(ctx, tx, batch); err != nil {
+				return fmt.Errorf("failed to import %s: %w", table.name, err)
+			}
+
+			stats[table.name] += len(batch)
+			batch = batch[:0]
+
+			return nil
+		}
+
+		for {
+			row := archiveRow{}
+			if err := decoder.Decode(&row); errors.Is(err, io.EOF) {
+				break
+			} else if err != nil {
+				return fmt.Errorf("invalid archive row: %w", err)
+			}
+
+			next, ok := tables[row.Table]
+			if !ok {
+				return fmt.Errorf("unknown table %s", row.Table)
+			}
+
+			if next != table {
+				if err := flush(); err != nil {
+					return err
+				}
+
+				table = next
+			}
+
+			batch = append(batch, row.Row)
+			if len(batch) >= archiveBatchSize {
+				if err := flush(); err != nil {
+					return err
+				}
+			}
+		}
+
+		return flush()
+	})
+
+	return stats, err
+}
@@ -0,0 +1,106 @@
+package database_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kyverno/policy-reporter/pkg/database"
+)
+
+func Test_Archive(t *testing.T) {
+	ctx := context.Background()
+
+	newStore := func(name string) *database.Store {
+		db, err := database.NewSQLiteDB(filepath.Join(t.TempDir(), name))
+		if !assert.Nil(t, err) {
+			t.FailNow()
+		}
+		t.Cleanup(func() { db.Close() })
+
+		store, err := database.NewStore(db, "1.0")
+		assert.Nil(t, err)
+		_, err = store.Migrate(ctx)
+		assert.Nil(t, err)
+
+		return store
+	}
+
+	db, err := database.NewSQLiteDB(filepath.Join(t.TempDir(), "export.db"))
+	if !assert.Nil(t, err) {
+		return
+	}
+	defer db.Close()
+
+	source := seedStore(t, db)
+
+	// rows with the same leading key columns across several export batches
+	events := make([]database.ResultEvent, 0, 250)
+	trends := make([]database.Trend, 0, 250)
+	for i := range 250 {
+		events = append(events, database.ResultEvent{Created: 1, Type: database.EventOpened, Namespace: "archive"})
+		trends = append(trends, database.Trend{Created: 1, Source: "archive", Namespace: "archive", Policy: fmt.Sprintf("policy-%d", i), Result: "fail", Count: i})
+	}
+	_, err = db.NewInsert().Model(&events).Exec(ctx)
+	assert.Nil(t, err)
+	_, err = db.NewInsert().Model(&trends).Exec(ctx)
+	assert.Nil(t, err)
+
+	eventCount, err := db.NewSelect().Model((*database.ResultEvent)(nil)).Count(ctx)
+	assert.Nil(t, err)
+	trendCount, err := db.NewSelect().Model((*database.Trend)(nil)).Count(ctx)
+	assert.Nil(t, err)
+
+	archive := new(bytes.Buffer)
+	exported, err := source.Export(ctx, archive)
+	if !assert.Nil(t, err) {
+		return
+	}
+	assert.Equal(t, 4, exported["policy_report"])
+	assert.Equal(t, eventCount, exported["policy_report_result_event"])
+	assert.Equal(t, trendCount, exported["policy_report_trend"])
+
+	t.Run("import", func(t *testing.T) {
+		target := newStore("import.db")
+
+		imported, err := target.Import(ctx, bytes.NewReader(archive.Bytes()))
+		if !assert.Nil(t, err) {
+			return
+		}
+		assert.Equal(t, exported, imported)
+
+		for _, c := range newCompatibilityCases("") {
+			expected, err := c.run(ctx, source)
+			assert.Nil(t, err)
+
+			value, err := c.run(ctx, target)
+			assert.Nil(t, err)
+
+			assert.JSONEq(t, normalize(t, expected, false), normalize(t, value, false), c.name)
+		}
+
+		_, err = target.Import(ctx, bytes.NewReader(archive.Bytes()))
+		assert.ErrorContains(t, err, "database is not empty")
+	})
+
+	t.Run("schema version mismatch", func(t *testing.T) {
+		content := new(bytes.Buffer)
+		gz := gzip.NewWriter(content)
+		assert.Nil(t, json.NewEncoder(gz).Encode(database.ArchiveHeader{Format: database.ArchiveFormat, Schema: 1}))
+		assert.Nil(t, gz.Close())
+
+		_, err := newStore("mismatch.db").Import(ctx, content)
+		assert.ErrorContains(t, err, "does not match database schema version")
+	})
+
+	t.Run("invalid archive", func(t *testing.T) {
+		_, err := newStore("invalid.db").Import(ctx, bytes.NewReader([]byte("{}")))
+		assert.ErrorContains(t, err, "invalid archive")
+	})
+}
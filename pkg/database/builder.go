@@ -39,6 +39,14 @@ func (q *QueryBuilder) FilterValue(column string, value string) *QueryBuilder {
 	return q
 }
 
+func (q *QueryBuilder) FilterBool(column string, value *bool) *QueryBuilder {
+	if value != nil {
+		q.query.Where(column+" = ?", *value)
+	}
+
+	return q
+}
+
 func (q *QueryBuilder) WithEmpty(column string) *QueryBuilder {
 	q.query.Where(column + " = ''")
 
@@ -214,10 +222,9 @@ func (q *QueryBuilder) FilterReportLabels(labels map[string]string) *QueryBuilde
 	return q
 }
 
+// FilterLabels filters queries on the policy_report model itself, which is already aliased as pr
 func (q *QueryBuilder) FilterLabels(labels map[string]string) *QueryBuilder {
 	if len(labels) > 0 {
-		q.query.Join("JOIN policy_report AS pr ON pr.id = policy_report_id")
-
 		for key, value := range labels {
 			q.query.Where(fmt.Sprintf(q.jsonExtractLayout(), key), value)
 		}
@@ -35,7 +35,37 @@ const (
 
 type Store struct {
 	db      *bun.DB
+	replica *Replica
 	version string
+	// clusters handled by this instance, reports of other clusters are kept on CleanUp
+	clusters []string
+}
+
+// SetClusters configures the clusters handled by this instance, an empty cluster name represents untagged reports
+func (s *Store) SetClusters(clusters ...string) {
+	s.clusters = clusters
+}
+
+// SetReplica configures a read-only database for all Fetch and Count queries, writes stay on the primary.
+// Queries use the replica while it is available, see ReplicaMonitor.
+func (s *Store) SetReplica(db *bun.DB) *Replica {
+	if db == nil {
+		s.replica = nil
+		return nil
+	}
+
+	s.replica = &Replica{db: db}
+
+	return s.replica
+}
+
+// read returns the replica for queries if it is available and the primary otherwise
+func (s *Store) read() *bun.DB {
+	if s.replica != nil && s.replica.Available() {
+		return s.replica.db
+	}
+
+	return s.db
 }
 
 ///////////////////////////////
@@ -45,8 +75,9 @@ type Store struct {
 func (s *Store) FetchPolicyReports(ctx context.Context, filter Filter, pagination Pagination) ([]PolicyReport, error) {
 	list := make([]PolicyReport, 0)
 
-	err := FromQuery(s.db.NewSelect().Model(&list)).
+	err := FromQuery(s.read().NewSelect().Model(&list)).
 		FilterMap(map[string][]string{
+			"pr.cluster":   filter.Clusters,
 			"pr.source":    filter.Sources,
 			"pr.namespace": filter.Namespaces,
 		}).
@@ -59,8 +90,9 @@ func (s *Store) FetchPolicyReports(ctx context.Context, filter Filter, paginatio
 }
 
 func (s *Store) CountPolicyReports(ctx context.Context, filter Filter) (int, error) {
-	return FromQuery(s.db.NewSelect().Model((*PolicyReport)(nil))).
+	return FromQuery(s.read().NewSelect().Model((*PolicyReport)(nil))).
 		FilterMap(map[string][]string{
+			"pr.cluster":   filter.Clusters,
 			"pr.source":    filter.Sources,
 			"pr.namespace": filter.Namespaces,
 		}).
@@ -73,9 +105,10 @@ func (s *Store) CountPolicyReports(ctx context.Context, filter Filter) (int, err
 func (s *Store) FetchClusterPolicyReports(ctx context.Context, filter Filter, pagination Pagination) ([]PolicyReport, error) {
 	list := make([]PolicyReport, 0)
 
-	err := FromQuery(s.db.NewSelect().Model(&list)).
+	err := FromQuery(s.read().NewSelect().Model(&list)).
 		FilterMap(map[string][]string{
-			"pr.source": filter.Sources,
+			"pr.cluster": filter.Clusters,
+			"pr.source":  filter.Sources,
 		}).
 		FilterLabels(filter.ReportLabel).
 		FilterValue("pr.type", report.ClusterPolicyReportType).
@@ -86,9 +119,10 @@ func (s *Store) FetchClusterPolicyReports(ctx context.Context, filter Filter, pa
 }
 
 func (s *Store) CountClusterPolicyReports(ctx context.Context, filter Filter) (int, error) {
-	return FromQuery(s.db.NewSelect().Model((*PolicyReport)(nil))).
+	return FromQuery(s.read().NewSelect().Model((*PolicyReport)(nil))).
 		FilterMap(map[string][]string{
-			"pr.source": filter.Sources,
+			"pr.cluster": filter.Clusters,
+			"pr.source":  filter.Sources,
 		}).
 		FilterLabels(filter.ReportLabel).
 		FilterValue("pr.type", report.ClusterPolicyReportType).
@@ -99,7 +133,7 @@ func (s *Store) CountClusterPolicyReports(ctx context.Context, filter Filter) (i
 func (s *Store) FetchRuleStatusCounts(ctx context.Context, policy, rule string) ([]StatusCount, error) {
 	counts := make([]StatusCount, 0)
 
-	err := s.db.NewSelect().
+	err := s.read().NewSelect().
 		Table("policy_report_result").
 		ColumnExpr("COUNT(id) as count, result as status").
 		Where("rule = ?", rule).
@@ -117,8 +151,9 @@ func (s *Store) FetchNamespaces(ctx context.Context, filter Filter) ([]string, e
 func (s *Store) FetchNamespacedFilter(ctx context.Context, column string, filter Filter) ([]string, error) {
 	list := make([]string, 0)
 
-	err := NewFilterQuery(s.db, "f."+column).
+	err := NewFilterQuery(s.read(), "f."+column).
 		FilterMap(map[string][]string{
+			"f.cluster":            filter.Clusters,
 			"f.result":             filter.Status,
 			"f.source":             filter.Sources,
 			"f.category":           filter.Categories,
@@ -137,8 +172,9 @@ func (s *Store) FetchNamespacedFilter(ctx context.Context, column string, filter
 func (s *Store) FetchClusterFilter(ctx context.Context, column string, filter Filter) ([]string, error) {
 	list := make([]string, 0)
 
-	err := NewFilterQuery(s.db, "f."+column).
+	err := NewFilterQuery(s.read(), "f."+column).
 		FilterMap(map[string][]string{
+			"f.cluster":       filter.Clusters,
 			"f.source":        filter.Sources,
 			"f.category":      filter.Categories,
 			"f.policy":        filter.Policies,
@@ -155,9 +191,10 @@ func (s *Store) FetchClusterFilter(ctx context.Context, column string, filter Fi
 func (s *Store) FetchNamespacedResources(ctx context.Context, filter Filter) ([]ResourceResult, error) {
 	list := make([]ResourceResult, 0)
 
-	err := FromQuery(s.db.NewSelect().Model(&list).Distinct()).
+	err := FromQuery(s.read().NewSelect().Model(&list).Distinct()).
 		Columns("resource_name", "resource_kind", "resource_namespace").
 		FilterMap(map[string][]string{
+			"res.cluster":            filter.Clusters,
 			"res.source":             filter.Sources,
 			"res.category":           filter.Categories,
 			"res.policy":             filter.Policies,
@@ -175,9 +212,10 @@ func (s *Store) FetchNamespacedResources(ctx context.Context, filter Filter) ([]
 func (s *Store) FetchClusterResources(ctx context.Context, filter Filter) ([]ResourceResult, error) {
 	list := make([]ResourceResult, 0)
 
-	err := FromQuery(s.db.NewSelect().Model(&list).Distinct()).
+	err := FromQuery(s.read().NewSelect().Model(&list).Distinct()).
 		Columns("resource_name", "resource_kind").
 		FilterMap(map[string][]string{
+			"res.cluster":          filter.Clusters,
 			"f.source":             filter.Sources,
 			"f.category":           filter.Categories,
 			"f.policy":             filter.Policies,
@@ -195,8 +233,9 @@ func (s *Store) FetchClusterResources(ctx context.Context, filter Filter) ([]Res
 func (s *Store) FetchClusterScopedStatusCounts(ctx context.Context, filter Filter) ([]StatusCount, error) {
 	list := make([]StatusCount, 0)
 
-	err := FromQuery(s.db.NewSelect().Model(&list).ColumnExpr("SUM(f.count) as count, f.result as status")).
+	err := FromQuery(s.read().NewSelect().Model(&list).ColumnExpr("SUM(f.count) as count, f.result as status")).
 		FilterMap(map[string][]string{
+			"f.cluster":       filter.Clusters,
 			"f.source":        filter.Sources,
 			"f.category":      filter.Categories,
 			"f.policy":        filter.Policies,
@@ -216,8 +255,9 @@ func (s *Store) FetchClusterScopedStatusCounts(ctx context.Context, filter Filte
 func (s *Store) FetchNamespaceScopedStatusCounts(ctx context.Context, filter Filter) ([]StatusCount, error) {
 	list := make([]StatusCount, 0)
 
-	err := FromQuery(s.db.NewSelect().Model(&list).ColumnExpr("SUM(f.count) as count, f.result as status, f.resource_namespace")).
+	err := FromQuery(s.read().NewSelect().Model(&list).ColumnExpr("SUM(f.count) as count, f.result as status, f.resource_namespace")).
 		FilterMap(map[string][]string{
+			"f.cluster":            filter.Clusters,
 			"f.source":             filter.Sources,
 			"f.category":           filter.Categories,
 			"f.policy":             filter.Policies,
@@ -242,8 +282,9 @@ func (s *Store) FetchNamespaceScopedStatusCounts(ctx context.Context, filter Fil
 func (s *Store) FetchSources(ctx context.Context, filter Filter) ([]string, error) {
 	list := make([]string, 0)
 
-	err := NewFilterQuery(s.db, "f.source").
+	err := NewFilterQuery(s.read(), "f.source").
 		FilterMap(map[string][]string{
+			"f.cluster":       filter.Clusters,
 			"f.resource_kind": filter.Kinds,
 			"f.resource_api":  filter.ResourceAPIs,
 		}).
@@ -257,9 +298,10 @@ func (s *Store) FetchSources(ctx context.Context, filter Filter) ([]string, erro
 func (s *Store) FetchCategories(ctx context.Context, filter Filter) ([]Category, error) {
 	list := make([]Category, 0)
 
-	err := FromQuery(s.db.NewSelect().Model(&list).ColumnExpr("SUM(f.count) as count")).
+	err := FromQuery(s.read().NewSelect().Model(&list).ColumnExpr("SUM(f.count) as count")).
 		Columns("f.source", "f.category", "f.result", "f.severity").
 		FilterMap(map[string][]string{
+			"f.cluster":            filter.Clusters,
 			"f.source":             filter.Sources,
 			"f.category":           filter.Categories,
 			"f.resource_kind":      filter.Kinds,
@@ -278,7 +320,7 @@ func (s *Store) FetchCategories(ctx context.Context, filter Filter) ([]Category,
 func (s *Store) FetchResource(ctx context.Context, id string) (ResourceResult, error) {
 	result := ResourceResult{}
 
-	err := FromQuery(s.db.NewSelect().Model(&result)).
+	err := FromQuery(s.read().NewSelect().Model(&result)).
 		Columns("res.id", "resource_uid", "resource_kind", "resource_api_version", "resource_namespace", "resource_name", "res.source", "res.category").
 		SelectStatusSummaries().
 		FilterValue("res.id", id).
@@ -293,10 +335,11 @@ func (s *Store) FetchResource(ctx context.Context, id string) (ResourceResult, e
 func (s *Store) FetchResourceCategories(ctx context.Context, resource string, filter Filter) ([]ResourceCategory, error) {
 	list := make([]ResourceCategory, 0)
 
-	err := FromQuery(s.db.NewSelect().Model(&list)).
+	err := FromQuery(s.read().NewSelect().Model(&list)).
 		Columns("res.source", "res.category").
 		SelectStatusSummaries().
 		FilterMap(map[string][]string{
+			"res.cluster":  filter.Clusters,
 			"res.source":   filter.Sources,
 			"res.category": filter.Categories,
 		}).
@@ -314,11 +357,12 @@ func (s *Store) FetchProperty(ctx context.Context, property string, filter Filte
 	result := make([]ResultProperty, 0)
 
 	err := FromQuery(
-		s.db.NewSelect().
+		s.read().NewSelect().
 			Model(&result).
 			Distinct().
 			ColumnExpr(fmt.Sprintf("resource_namespace, properties->>'%s' as property", property))).
 		FilterMap(map[string][]string{
+			"pr.cluster":         filter.Clusters,
 			"category":           filter.Categories,
 			"source":             filter.Sources,
 			"policy":             filter.Policies,
@@ -336,10 +380,11 @@ func (s *Store) FetchProperty(ctx context.Context, property string, filter Filte
 func (s *Store) FetchResourceStatusCounts(ctx context.Context, id string, filter Filter) ([]ResourceStatusCount, error) {
 	result := []ResourceStatusCount{}
 
-	err := FromQuery(s.db.NewSelect().Model(&result)).
+	err := FromQuery(s.read().NewSelect().Model(&result)).
 		Columns("res.source").
 		SelectStatusSummaries().
 		FilterMap(map[string][]string{
+			"res.cluster":  filter.Clusters,
 			"res.category": filter.Categories,
 			"res.source":   filter.Sources,
 			"policy":       filter.Policies,
@@ -356,10 +401,11 @@ func (s *Store) FetchResourceStatusCounts(ctx context.Context, id string, filter
 func (s *Store) FetchResourceSeverityCounts(ctx context.Context, id string, filter Filter) ([]ResourceSeverityCount, error) {
 	result := []ResourceSeverityCount{}
 
-	err := FromQuery(s.db.NewSelect().Model(&result)).
+	err := FromQuery(s.read().NewSelect().Model(&result)).
 		Columns("res.source").
 		SelectSeveritySummaries().
 		FilterMap(map[string][]string{
+			"res.cluster":  filter.Clusters,
 			"res.category": filter.Categories,
 			"res.source":   filter.Sources,
 			"policy":       filter.Policies,
@@ -376,12 +422,13 @@ func (s *Store) FetchResourceSeverityCounts(ctx context.Context, id string, filt
 func (s *Store) FetchNamespaceResourceResults(ctx context.Context, filter Filter, pagination Pagination) ([]ResourceResult, error) {
 	results := make([]ResourceResult, 0)
 
-	err := FromQuery(s.db.NewSelect().Model(&results)).
+	err := FromQuery(s.read().NewSelect().Model(&results)).
 		Columns("res.id", "resource_uid", "resource_kind", "resource_api_version", "resource_namespace", "resource_name").
 		SelectStatusSummaries().
 		SelectSeveritySummaries().
 		Group("res.id", "resource_uid", "resource_kind", "resource_api_version", "resource_namespace", "resource_name", "resource_api").
 		FilterMap(map[string][]string{
+			"res.cluster":        filter.Clusters,
 			"source":             filter.Sources,
 			"category":           filter.Categories,
 			"resource_namespace": filter.Namespaces,
@@ -402,10 +449,11 @@ func (s *Store) FetchNamespaceResourceResults(ctx context.Context, filter Filter
 }
 
 func (s *Store) CountNamespaceResourceResults(ctx context.Context, filter Filter) (int, error) {
-	return FromQuery(s.db.NewSelect().Model((*ResourceResult)(nil))).
+	return FromQuery(s.read().NewSelect().Model((*ResourceResult)(nil))).
 		Columns("res.id").
 		Distinct().
 		FilterMap(map[string][]string{
+			"res.cluster":        filter.Clusters,
 			"source":             filter.Sources,
 			"category":           filter.Categories,
 			"resource_namespace": filter.Namespaces,
@@ -426,12 +474,13 @@ func (s *Store) CountNamespaceResourceResults(ctx context.Context, filter Filter
 func (s *Store) FetchTotalResourceResults(ctx context.Context, filter Filter, pagination Pagination) ([]ResourceResult, error) {
 	results := make([]ResourceResult, 0)
 
-	err := FromQuery(s.db.NewSelect().Model(&results)).
+	err := FromQuery(s.read().NewSelect().Model(&results)).
 		Columns("resource_namespace").
 		SelectStatusSummaries().
 		SelectSeveritySummaries().
 		Group("resource_namespace").
 		FilterMap(map[string][]string{
+			"res.cluster":   filter.Clusters,
 			"source":        filter.Sources,
 			"category":      filter.Categories,
 			"resource_kind": filter.Kinds,
@@ -464,10 +513,11 @@ func (s *Store) FetchTotalResourceResults(ctx context.Context, filter Filter, pa
 }
 
 func (s *Store) CountTotalResourceResults(ctx context.Context, filter Filter) (int, error) {
-	return FromQuery(s.db.NewSelect().Model((*ResourceResult)(nil))).
+	return FromQuery(s.read().NewSelect().Model((*ResourceResult)(nil))).
 		Columns("resource_namespace").
 		Distinct().
 		FilterMap(map[string][]string{
+			"res.cluster":   filter.Clusters,
 			"source":        filter.Sources,
 			"category":      filter.Categories,
 			"resource_kind": filter.Kinds,
@@ -484,12 +534,13 @@ func (s *Store) CountTotalResourceResults(ctx context.Context, filter Filter) (i
 func (s *Store) FetchClusterResourceResults(ctx context.Context, filter Filter, pagination Pagination) ([]ResourceResult, error) {
 	results := make([]ResourceResult, 0)
 
-	err := FromQuery(s.db.NewSelect().Model(&results)).
+	err := FromQuery(s.read().NewSelect().Model(&results)).
 		Columns("res.id", "resource_uid", "resource_kind", "resource_api_version", "resource_name", "resource_api").
 		SelectStatusSummaries().
 		SelectSeveritySummaries().
 		Group("res.id", "resource_uid", "resource_kind", "resource_api_version", "resource_name", "resource_api").
 		FilterMap(map[string][]string{
+			"res.cluster":   filter.Clusters,
 			"source":        filter.Sources,
 			"category":      filter.Categories,
 			"resource_kind": filter.Kinds,
@@ -509,10 +560,11 @@ func (s *Store) FetchClusterResourceResults(ctx context.Context, filter Filter,
 }
 
 func (s *Store) CountClusterResourceResults(ctx context.Context, filter Filter) (int, error) {
-	return FromQuery(s.db.NewSelect().Model((*ResourceResult)(nil))).
+	return FromQuery(s.read().NewSelect().Model((*ResourceResult)(nil))).
 		Columns("res.id").
 		Distinct().
 		FilterMap(map[string][]string{
+			"res.cluster":   filter.Clusters,
 			"source":        filter.Sources,
 			"category":      filter.Categories,
 			"resource_kind": filter.Kinds,
@@ -532,11 +584,12 @@ func (s *Store) CountClusterResourceResults(ctx context.Context, filter Filter)
 func (s *Store) FetchResourceResults(ctx context.Context, id string, filter Filter) ([]ResourceResult, error) {
 	results := make([]ResourceResult, 0)
 
-	err := FromQuery(s.db.NewSelect().Model(&results)).
+	err := FromQuery(s.read().NewSelect().Model(&results)).
 		Columns("res.id", "resource_uid", "resource_kind", "resource_api_version", "resource_namespace", "resource_name", "res.source", "resource_api").
 		SelectStatusSummaries().
 		FilterValue(`res.id`, id).
 		FilterMap(map[string][]string{
+			"res.cluster":   filter.Clusters,
 			"source":        filter.Sources,
 			"category":      filter.Categories,
 			"resource_kind": filter.Kinds,
@@ -556,12 +609,14 @@ func (s *Store) FetchResourceResults(ctx context.Context, id string, filter Filt
 func (s *Store) FetchResourcePolicyResults(ctx context.Context, id string, filter Filter, pagination Pagination) ([]PolicyReportResult, error) {
 	results := make([]PolicyReportResult, 0)
 
-	err := FromQuery(s.db.NewSelect().Model(&results)).
+	err := FromQuery(s.read().NewSelect().Model(&results)).
 		FilterValue(`r.resource_id`, id).
 		FilterMap(map[string][]string{
-			"source":   filter.Sources,
-			"category": filter.Categories,
+			"r.cluster": filter.Clusters,
+			"source":    filter.Sources,
+			"category":  filter.Categories,
 		}).
+		FilterBool("r.suppressed", filter.Suppressed).
 		ResultSearch(filter.Search).
 		FilterReportLabels(filter.ReportLabel).
 		Pagination(pagination).
@@ -571,12 +626,14 @@ func (s *Store) FetchResourcePolicyResults(ctx context.Context, id string, filte
 }
 
 func (s *Store) CountResourcePolicyResults(ctx context.Context, id string, filter Filter) (int, error) {
-	return FromQuery(s.db.NewSelect().Model((*PolicyReportResult)(nil))).
+	return FromQuery(s.read().NewSelect().Model((*PolicyReportResult)(nil))).
 		FilterValue(`r.resource_id`, id).
 		FilterMap(map[string][]string{
-			"source":   filter.Sources,
-			"category": filter.Categories,
+			"r.cluster": filter.Clusters,
+			"source":    filter.Sources,
+			"category":  filter.Categories,
 		}).
+		FilterBool("r.suppressed", filter.Suppressed).
 		ResultSearch(filter.Search).
 		FilterReportLabels(filter.ReportLabel).
 		GetQuery().
@@ -586,8 +643,9 @@ func (s *Store) CountResourcePolicyResults(ctx context.Context, id string, filte
 func (s *Store) FetchResults(ctx context.Context, namespaced bool, filter Filter, pagination Pagination) ([]PolicyReportResult, error) {
 	results := make([]PolicyReportResult, 0)
 
-	err := FromQuery(s.db.NewSelect().Model(&results)).
+	err := FromQuery(s.read().NewSelect().Model(&results)).
 		FilterMap(map[string][]string{
+			"r.cluster":          filter.Clusters,
 			"source":             filter.Sources,
 			"category":           filter.Categories,
 			"policy":             filter.Policies,
@@ -601,6 +659,7 @@ func (s *Store) FetchResults(ctx context.Context, namespaced bool, filter Filter
 		}).
 		Scoped(namespaced).
 		FilterValue(`r.resource_id`, filter.ResourceID).
+		FilterBool("r.suppressed", filter.Suppressed).
 		ResultSearch(filter.Search).
 		FilterReportLabels(filter.ReportLabel).
 		Exclude(filter, "r").
@@ -611,8 +670,9 @@ func (s *Store) FetchResults(ctx context.Context, namespaced bool, filter Filter
 }
 
 func (s *Store) CountResults(ctx context.Context, namespaced bool, filter Filter) (int, error) {
-	return FromQuery(s.db.NewSelect().Model((*PolicyReportResult)(nil))).
+	return FromQuery(s.read().NewSelect().Model((*PolicyReportResult)(nil))).
 		FilterMap(map[string][]string{
+			"r.cluster":          filter.Clusters,
 			"source":             filter.Sources,
 			"category":           filter.Categories,
 			"policy":             filter.Policies,
@@ -626,6 +686,7 @@ func (s *Store) CountResults(ctx context.Context, namespaced bool, filter Filter
 		}).
 		Scoped(namespaced).
 		FilterValue(`r.resource_id`, filter.ResourceID).
+		FilterBool("r.suppressed", filter.Suppressed).
 		ResultSearch(filter.Search).
 		FilterReportLabels(filter.ReportLabel).
 		Exclude(filter, "r").
@@ -636,16 +697,18 @@ func (s *Store) CountResults(ctx context.Context, namespaced bool, filter Filter
 func (s *Store) FetchResultsWithoutResource(ctx context.Context, filter Filter, pagination Pagination) ([]PolicyReportResult, error) {
 	results := make([]PolicyReportResult, 0)
 
-	err := FromQuery(s.db.NewSelect().Model(&results)).
+	err := FromQuery(s.read().NewSelect().Model(&results)).
 		FilterMap(map[string][]string{
-			"source":   filter.Sources,
-			"category": filter.Categories,
-			"policy":   filter.Policies,
-			"rule":     filter.Rules,
-			"result":   filter.Status,
-			"severity": filter.Severities,
+			"r.cluster": filter.Clusters,
+			"source":    filter.Sources,
+			"category":  filter.Categories,
+			"policy":    filter.Policies,
+			"rule":      filter.Rules,
+			"result":    filter.Status,
+			"severity":  filter.Severities,
 		}).
 		WithEmpty("resource_name").
+		FilterBool("r.suppressed", filter.Suppressed).
 		ResultSearch(filter.Search).
 		FilterReportLabels(filter.ReportLabel).
 		Exclude(filter, "r").
@@ -656,16 +719,18 @@ func (s *Store) FetchResultsWithoutResource(ctx context.Context, filter Filter,
 }
 
 func (s *Store) CountResultsWithoutResource(ctx context.Context, filter Filter) (int, error) {
-	return FromQuery(s.db.NewSelect().Model((*PolicyReportResult)(nil))).
+	return FromQuery(s.read().NewSelect().Model((*PolicyReportResult)(nil))).
 		FilterMap(map[string][]string{
-			"source":   filter.Sources,
-			"category": filter.Categories,
-			"policy":   filter.Policies,
-			"rule":     filter.Rules,
-			"result":   filter.Status,
-			"severity": filter.Severities,
+			"r.cluster": filter.Clusters,
+			"source":    filter.Sources,
+			"category":  filter.Categories,
+			"policy":    filter.Policies,
+			"rule":      filter.Rules,
+			"result":    filter.Status,
+			"severity":  filter.Severities,
 		}).
 		WithEmpty("resource_name").
+		FilterBool("r.suppressed", filter.Suppressed).
 		ResultSearch(filter.Search).
 		FilterReportLabels(filter.ReportLabel).
 		Exclude(filter, "r").
@@ -674,12 +739,13 @@ func (s *Store) CountResultsWithoutResource(ctx context.Context, filter Filter)
 }
 
 func (s *Store) UseResources(ctx context.Context, source string, filter Filter) (bool, error) {
-	return FromQuery(s.db.NewSelect().Model((*PolicyReportResult)(nil))).
+	return FromQuery(s.read().NewSelect().Model((*PolicyReportResult)(nil))).
 		FilterValue("source", source).
 		FilterMap(map[string][]string{
-			"category": filter.Categories,
-			"policy":   filter.Policies,
-			"rule":     filter.Rules,
+			"r.cluster": filter.Clusters,
+			"category":  filter.Categories,
+			"policy":    filter.Policies,
+			"rule":      filter.Rules,
 		}).
 		WithNotEmpty("resource_name").
 		ResultSearch(filter.Search).
@@ -692,11 +758,12 @@ func (s *Store) UseResources(ctx context.Context, source string, filter Filter)
 func (s *Store) FetchClusterStatusCounts(ctx context.Context, source string, filter Filter) ([]StatusCount, error) {
 	results := make([]StatusCount, 0)
 
-	err := FromQuery(s.db.
+	err := FromQuery(s.read().
 		NewSelect().
 		TableExpr("policy_report_filter as f").
 		ColumnExpr("SUM(f.count) as count, f.result as status")).
 		FilterMap(map[string][]string{
+			"f.cluster":     filter.Clusters,
 			"category":      filter.Categories,
 			"policy":        filter.Policies,
 			"resource_kind": filter.Kinds,
@@ -715,11 +782,12 @@ func (s *Store) FetchClusterStatusCounts(ctx context.Context, source string, fil
 func (s *Store) FetchClusterSeverityCounts(ctx context.Context, source string, filter Filter) ([]SeverityCount, error) {
 	results := make([]SeverityCount, 0)
 
-	err := FromQuery(s.db.
+	err := FromQuery(s.read().
 		NewSelect().
 		TableExpr("policy_report_filter as f").
 		ColumnExpr("SUM(f.count) as count, f.severity")).
 		FilterMap(map[string][]string{
+			"f.cluster":     filter.Clusters,
 			"category":      filter.Categories,
 			"policy":        filter.Policies,
 			"resource_kind": filter.Kinds,
@@ -738,11 +806,12 @@ func (s *Store) FetchClusterSeverityCounts(ctx context.Context, source string, f
 func (s *Store) FetchNamespaceStatusCounts(ctx context.Context, source string, filter Filter) ([]StatusCount, error) {
 	results := make([]StatusCount, 0)
 
-	err := FromQuery(s.db.
+	err := FromQuery(s.read().
 		NewSelect().
 		TableExpr("policy_report_filter as f").
 		ColumnExpr("f.resource_namespace, SUM(f.count) as count, f.result as status")).
 		FilterMap(map[string][]string{
+			"f.cluster":            filter.Clusters,
 			"f.category":           filter.Categories,
 			"f.resource_kind":      filter.Kinds,
 			"f.resource_api":       filter.ResourceAPIs,
@@ -764,11 +833,12 @@ func (s *Store) FetchNamespaceStatusCounts(ctx context.Context, source string, f
 func (s *Store) FetchNamespaceSeverityCounts(ctx context.Context, source string, filter Filter) ([]SeverityCount, error) {
 	results := make([]SeverityCount, 0)
 
-	err := FromQuery(s.db.
+	err := FromQuery(s.read().
 		NewSelect().
 		TableExpr("policy_report_filter as f").
 		ColumnExpr("f.resource_namespace, SUM(f.count) as count, f.severity")).
 		FilterMap(map[string][]string{
+			"f.cluster":            filter.Clusters,
 			"f.category":           filter.Categories,
 			"f.resource_kind":      filter.Kinds,
 			"f.resource_api":       filter.ResourceAPIs,
@@ -790,11 +860,12 @@ func (s *Store) FetchNamespaceSeverityCounts(ctx context.Context, source string,
 func (s *Store) FetchTotalStatusCounts(ctx context.Context, source string, filter Filter) ([]StatusCount, error) {
 	results := make([]StatusCount, 0)
 
-	err := FromQuery(s.db.
+	err := FromQuery(s.read().
 		NewSelect().
 		TableExpr("policy_report_filter as f").
 		ColumnExpr("SUM(f.count) as count, f.result AS status")).
 		FilterMap(map[string][]string{
+			"f.cluster":     filter.Clusters,
 			"category":      filter.Categories,
 			"policy":        filter.Policies,
 			"resource_kind": filter.Kinds,
@@ -812,11 +883,12 @@ func (s *Store) FetchTotalStatusCounts(ctx context.Context, source string, filte
 func (s *Store) FetchTotalSeverityCounts(ctx context.Context, source string, filter Filter) ([]SeverityCount, error) {
 	results := make([]SeverityCount, 0)
 
-	err := FromQuery(s.db.
+	err := FromQuery(s.read().
 		NewSelect().
 		TableExpr("policy_report_filter as f").
 		ColumnExpr("SUM(f.count) as count, f.severity")).
 		FilterMap(map[string][]string{
+			"f.cluster":     filter.Clusters,
 			"category":      filter.Categories,
 			"policy":        filter.Policies,
 			"resource_kind": filter.Kinds,
@@ -834,8 +906,9 @@ func (s *Store) FetchTotalSeverityCounts(ctx context.Context, source string, fil
 func (s *Store) FetchNamespaceKinds(ctx context.Context, filter Filter) ([]string, error) {
 	list := make([]string, 0)
 
-	err := NewFilterQuery(s.db, "resource_kind").
+	err := NewFilterQuery(s.read(), "resource_kind").
 		FilterMap(map[string][]string{
+			"f.cluster":            filter.Clusters,
 			"f.source":             filter.Sources,
 			"f.category":           filter.Categories,
 			"f.resource_namespace": filter.Namespaces,
@@ -851,8 +924,9 @@ func (s *Store) FetchNamespaceKinds(ctx context.Context, filter Filter) ([]strin
 func (s *Store) FetchClusterKinds(ctx context.Context, filter Filter) ([]string, error) {
 	list := make([]string, 0)
 
-	err := NewFilterQuery(s.db, "resource_kind").
+	err := NewFilterQuery(s.read(), "resource_kind").
 		FilterMap(map[string][]string{
+			"f.cluster":  filter.Clusters,
 			"f.source":   filter.Sources,
 			"f.category": filter.Categories,
 		}).
@@ -867,8 +941,9 @@ func (s *Store) FetchClusterKinds(ctx context.Context, filter Filter) ([]string,
 func (s *Store) FetchPolicies(ctx context.Context, filter Filter) ([]PolicyReportFilter, error) {
 	results := make([]PolicyReportFilter, 0)
 
-	err := FromQuery(s.db.NewSelect().Model(&results).ColumnExpr("f.severity, f.category, f.policy, f.source, f.result, SUM(f.count) as count")).
+	err := FromQuery(s.read().NewSelect().Model(&results).ColumnExpr("f.severity, f.category, f.policy, f.source, f.result, SUM(f.count) as count")).
 		FilterMap(map[string][]string{
+			"f.cluster":       filter.Clusters,
 			"f.source":        filter.Sources,
 			"f.category":      filter.Categories,
 			"f.resource_kind": filter.Kinds,
@@ -887,7 +962,7 @@ func (s *Store) FetchPolicies(ctx context.Context, filter Filter) ([]PolicyRepor
 func (s *Store) FetchFindingCounts(ctx context.Context, filter Filter) ([]StatusCount, error) {
 	results := make([]StatusCount, 0)
 
-	query := FromQuery(s.db.
+	query := FromQuery(s.read().
 		NewSelect().
 		TableExpr("policy_report_filter as f").
 		ColumnExpr("SUM(f.count) as count, f.result as status, f.source"))
@@ -902,6 +977,7 @@ func (s *Store) FetchFindingCounts(ctx context.Context, filter Filter) ([]Status
 
 	err := query.
 		FilterMap(map[string][]string{
+			"f.cluster":     filter.Clusters,
 			"source":        filter.Sources,
 			"category":      filter.Categories,
 			"resource_kind": filter.Kinds,
@@ -921,7 +997,7 @@ func (s *Store) FetchFindingCounts(ctx context.Context, filter Filter) ([]Status
 func (s *Store) FetchSeverityFindingCounts(ctx context.Context, filter Filter) ([]SeverityCount, error) {
 	results := make([]SeverityCount, 0)
 
-	query := FromQuery(s.db.
+	query := FromQuery(s.read().
 		NewSelect().
 		TableExpr("policy_report_filter as f").
 		ColumnExpr("SUM(f.count) as count, f.severity, f.source"))
@@ -936,6 +1012,7 @@ func (s *Store) FetchSeverityFindingCounts(ctx context.Context, filter Filter) (
 
 	err := query.
 		FilterMap(map[string][]string{
+			"f.cluster":     filter.Clusters,
 			"source":        filter.Sources,
 			"category":      filter.Categories,
 			"resource_kind": filter.Kinds,
@@ -957,6 +1034,22 @@ func (s *Store) FetchSeverityFindingCounts(ctx context.Context, filter Filter) (
 /////////////////////////
 
 func (s *Store) CreateSchemas(ctx context.Context) error {
+	if err := s.createReportSchema(ctx); err != nil {
+		return err
+	}
+
+	if err := s.createTrendSchema(ctx); err != nil {
+		return err
+	}
+
+	if err := s.createFindingSchema(ctx); err != nil {
+		return err
+	}
+
+	return s.createEventSchema(ctx)
+}
+
+func (s *Store) createReportSchema(ctx context.Context) error {
 	if s.db.Dialect().Name() == dialect.SQLite {
 		if _, err := s.db.Exec("PRAGMA foreign_keys = ON"); err != nil {
 			return err
@@ -968,7 +1061,7 @@ func (s *Store) CreateSchemas(ctx context.Context) error {
 		IfNotExists().
 		Model((*Config)(nil)).
 		Exec(ctx)
-	logOnError("create policy_report table", err)
+	logOnError("create policy_report_config table", err)
 
 	_, err = s.db.
 		NewCreateTable().
@@ -1004,6 +1097,76 @@ func (s *Store) CreateSchemas(ctx context.Context) error {
 	return err
 }
 
+func (s *Store) createTrendSchema(ctx context.Context) error {
+	_, err := s.db.
+		NewCreateTable().
+		IfNotExists().
+		Model((*Trend)(nil)).
+		Exec(ctx)
+	logOnError("create policy_report_trend table", err)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.db.
+		NewCreateIndex().
+		IfNotExists().
+		Model((*Trend)(nil)).
+		Index("policy_report_trend_created_idx").
+		Column("created").
+		Exec(ctx)
+	logOnError("create policy_report_trend index", err)
+
+	return err
+}
+
+func (s *Store) createFindingSchema(ctx context.Context) error {
+	_, err := s.db.
+		NewCreateTable().
+		IfNotExists().
+		Model((*Finding)(nil)).
+		Exec(ctx)
+	logOnError("create policy_report_finding table", err)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.db.
+		NewCreateIndex().
+		IfNotExists().
+		Model((*Finding)(nil)).
+		Index("policy_report_finding_report_idx").
+		Column("policy_report_id").
+		Exec(ctx)
+	logOnError("create policy_report_finding index", err)
+
+	return err
+}
+
+func (s *Store) createEventSchema(ctx context.Context) error {
+	_, err := s.db.
+		NewCreateTable().
+		IfNotExists().
+		Model((*ResultEvent)(nil)).
+		Exec(ctx)
+	logOnError("create policy_report_result_event table", err)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.db.
+		NewCreateIndex().
+		IfNotExists().
+		Model((*ResultEvent)(nil)).
+		Index("policy_report_result_event_created_idx").
+		Column("created").
+		Exec(ctx)
+	logOnError("create policy_report_result_event index", err)
+
+	return err
+}
+
+// DropSchema drops the report and config tables, history tables like trends, findings and result events are kept
 func (s *Store) DropSchema(ctx context.Context) error {
 	_, err := s.db.NewDropTable().
 		IfExists().
@@ -1011,7 +1174,11 @@ func (s *Store) DropSchema(ctx context.Context) error {
 		Exec(ctx)
 	logOnError("drop policy_report_config table", err)
 
-	_, err = s.db.NewDropTable().
+	return s.dropReportSchema(ctx)
+}
+
+func (s *Store) dropReportSchema(ctx context.Context) error {
+	_, err := s.db.NewDropTable().
 		IfExists().
 		Model((*PolicyReportFilter)(nil)).
 		Exec(ctx)
@@ -1045,47 +1212,56 @@ func (s *Store) Add(ctx context.Context, report openreports.ReportInterface) err
 		errorMetric.With(prometheus.Labels{"operation": "INSERT", "table": "policy_report", "reason": mapReason(err)}).Inc()
 	}
 
-	filters := chunkSlice(MapPolicyReportFilter(report), 50)
-	for _, list := range filters {
-		_, err = s.db.NewInsert().Ignore().Model(&list).Exec(ctx)
+	if err := insertFilters(ctx, s.db, MapPolicyReportFilter(report)); err != nil {
+		return err
+	}
+
+	if err := insertResources(ctx, s.db, MapPolicyReportResource(report)); err != nil {
+		return err
+	}
+
+	if err := insertResults(ctx, s.db, MapPolicyReportResults(report)); err != nil {
+		return err
+	}
+
+	return err
+}
+
+// Update persists only the changes of an existing report within a single transaction.
+// Results are compared by ID, filter and resource aggregates by their keys, unchanged rows are not written.
+func (s *Store) Update(ctx context.Context, report openreports.ReportInterface) error {
+	err := s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		polr := MapPolicyReport(report)
+
+		exists, err := tx.NewSelect().Model((*PolicyReport)(nil)).Where("id = ?", polr.ID).Exists(ctx)
 		if err != nil {
-			zap.L().Error("failed to bulk import policy report filter", zap.Error(err))
-			errorMetric.With(prometheus.Labels{"operation": "INSERT", "table": "policy_report_filter", "reason": mapReason(err)}).Inc()
 			return err
 		}
-	}
 
-	resources := chunkSlice(MapPolicyReportResource(report), 50)
-	for _, list := range resources {
-		_, err = s.db.NewInsert().Model(&list).Exec(ctx)
-		if err != nil {
-			zap.L().Error("failed to bulk import policy report resources", zap.Error(err))
-			errorMetric.With(prometheus.Labels{"operation": "INSERT", "table": "policy_report_resource", "reason": mapReason(err)}).Inc()
+		if !exists {
+			if _, err := tx.NewInsert().Model(polr).Exec(ctx); err != nil {
+				errorMetric.With(prometheus.Labels{"operation": "INSERT", "table": "policy_report", "reason": mapReason(err)}).Inc()
+				return err
+			}
+		} else if _, err := tx.NewUpdate().Model(polr).WherePK().Exec(ctx); err != nil {
+			errorMetric.With(prometheus.Labels{"operation": "UPDATE", "table": "policy_report", "reason": mapReason(err)}).Inc()
 			return err
 		}
-	}
 
-	results := chunkSlice(MapPolicyReportResults(report), 50)
-	for _, list := range results {
-		_, err = s.db.NewInsert().Ignore().Model(&list).Exec(ctx)
-		if err != nil {
-			zap.L().Error("failed to bulk import policy report results", zap.Error(err))
-			errorMetric.With(prometheus.Labels{"operation": "INSERT", "table": "policy_report_result", "reason": mapReason(err)}).Inc()
+		if err := updateFilters(ctx, tx, polr.ID, MapPolicyReportFilter(report)); err != nil {
 			return err
 		}
-	}
 
-	return err
-}
+		if err := updateResources(ctx, tx, polr.ID, MapPolicyReportResource(report)); err != nil {
+			return err
+		}
 
-func (s *Store) Update(ctx context.Context, report openreports.ReportInterface) error {
-	err := s.Remove(ctx, report.GetID())
+		return updateResults(ctx, tx, polr.ID, MapPolicyReportResults(report))
+	})
 	if err != nil {
-		return err
+		zap.L().Error("failed to update policy report", zap.String("id", report.GetID()), zap.Error(err))
 	}
 
-	s.Add(ctx, report)
-
 	return err
 }
 
@@ -1099,8 +1275,9 @@ func (s *Store) Remove(ctx context.Context, id string) error {
 	return err
 }
 
+// CleanUp removes all reports of the clusters handled by this instance
 func (s *Store) CleanUp(ctx context.Context) error {
-	_, err := s.db.NewDelete().Model((*PolicyReport)(nil)).Where("id is not null").Exec(ctx)
+	_, err := s.db.NewDelete().Model((*PolicyReport)(nil)).Where("cluster IN (?)", bun.List(s.clusters)).Exec(ctx)
 	if err != nil {
 		zap.L().Error("failed to remove policy reports", zap.Error(err))
 		errorMetric.With(prometheus.Labels{"operation": "DELETE", "table": "policy_report", "reason": mapReason(err)}).Inc()
@@ -1109,6 +1286,32 @@ func (s *Store) CleanUp(ctx context.Context) error {
 	return err
 }
 
+// RemoveCluster removes all reports of the given cluster
+func (s *Store) RemoveCluster(ctx context.Context, cluster string) error {
+	_, err := s.db.NewDelete().Model((*PolicyReport)(nil)).Where("cluster = ?", cluster).Exec(ctx)
+	if err != nil {
+		zap.L().Error("failed to remove cluster policy reports", zap.String("cluster", cluster), zap.Error(err))
+		errorMetric.With(prometheus.Labels{"operation": "DELETE", "table": "policy_report", "reason": mapReason(err)}).Inc()
+	}
+
+	return err
+}
+
+// FetchClusters returns the names of all clusters with reports
+func (s *Store) FetchClusters(ctx context.Context) ([]string, error) {
+	list := make([]string, 0)
+
+	err := s.read().NewSelect().
+		Model((*PolicyReport)(nil)).
+		Column("pr.cluster").
+		Distinct().
+		Where("pr.cluster != ''").
+		Order("pr.cluster ASC").
+		Scan(ctx, &list)
+
+	return list, err
+}
+
 func (s *Store) Get(ctx context.Context, id string) (openreports.ReportInterface, error) {
 	polr := &PolicyReport{}
 
@@ -1126,6 +1329,11 @@ func (s *Store) Get(ctx context.Context, id string) (openreports.ReportInterface
 		return nil, err
 	}
 
+	var annotations map[string]string
+	if polr.Cluster != "" {
+		annotations = map[string]string{openreports.ClusterAnnotation: polr.Cluster}
+	}
+
 	return &openreports.ReportAdapter{
 		Report: &v1alpha1.Report{
 			ObjectMeta: v1.ObjectMeta{
@@ -1133,6 +1341,7 @@ func (s *Store) Get(ctx context.Context, id string) (openreports.ReportInterface
 				Namespace:         polr.Namespace,
 				CreationTimestamp: v1.NewTime(time.Unix(polr.Created, 0)),
 				Labels:            polr.Labels,
+				Annotations:       annotations,
 			},
 			Summary: v1alpha1.ReportSummary{
 				Skip:  polr.Skip,
@@ -1193,51 +1402,11 @@ func (s *Store) fetchResults(ctx context.Context, id string) ([]v1alpha1.ReportR
 	return list, nil
 }
 
-func (s *Store) RequireSchemaUpgrade(ctx context.Context) bool {
-	if s.IsSQLite() {
-		return true
-	}
-
-	config := Config{}
-
-	err := s.db.NewSelect().Model(&config).Where("id = ?", 1).Scan(ctx)
-	if err != nil {
-		zap.L().Debug("failed to load config", zap.Error(err))
-		return true
-	}
-
-	return config.Version != s.version
-}
-
-func (s *Store) PersistSchemaVersion(ctx context.Context) error {
-	config := Config{
-		Version: s.version,
-	}
-
-	_, err := s.db.NewInsert().Model(&config).Exec(ctx)
-	if err != nil {
-		zap.L().Error("failed to persist database version", zap.Error(err))
-		return err
-	}
-
-	return nil
-}
-
+// PrepareDatabase applies all pending migrations and removes the reports of the last run
 func (s *Store) PrepareDatabase(ctx context.Context) error {
 	zap.L().Debug("preparing database")
-	if s.RequireSchemaUpgrade(ctx) {
-		zap.L().Debug("database schema upgrade started")
-		if err := s.DropSchema(ctx); err != nil {
-			return err
-		}
-
-		if err := s.CreateSchemas(ctx); err != nil {
-			return err
-		}
-
-		if err := s.PersistSchemaVersion(ctx); err != nil {
-			return err
-		}
+	if _, err := s.Migrate(ctx); err != nil {
+		return err
 	}
 
 	return s.CleanUp(ctx)
@@ -1249,8 +1418,9 @@ func NewStore(db *bun.DB, version string) (*Store, error) {
 	}
 
 	s := &Store{
-		db:      db,
-		version: version,
+		db:       db,
+		version:  version,
+		clusters: []string{""},
 	}
 
 	return s, nil
@@ -1265,6 +1435,21 @@ func NewSQLiteDB(dbFile string) (*bun.DB, error) {
 	return bun.NewDB(sqldb, sqlitedialect.New()), nil
 }
 
+// OpenSQLiteDB opens an existing SQLite database file without recreating it
+func OpenSQLiteDB(dbFile string) (*bun.DB, error) {
+	if _, err := os.Stat(dbFile); err != nil {
+		return nil, err
+	}
+
+	sqldb, err := sql.Open("sqlite3", dbFile+"?cache=shared")
+	if err != nil {
+		return nil, err
+	}
+	sqldb.SetMaxOpenConns(1)
+
+	return bun.NewDB(sqldb, sqlitedialect.New()), nil
+}
+
 func createSQLiteDB(dbFile string) (*sql.DB, error) {
 	os.Remove(dbFile)
 	file, err := os.Create(dbFile)
@@ -0,0 +1,147 @@
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+)
+
+const (
+	EventOpened          = "opened"
+	EventResolved        = "resolved"
+	EventSeverityChanged = "severity-changed"
+	EventSuppressed      = "suppressed"
+	EventUnsuppressed    = "unsuppressed"
+)
+
+// eventBatchSize limits the loaded audit log entries per query while streaming
+const eventBatchSize = 1000
+
+// ResultEvent is an append only audit log entry of a finding state transition
+type ResultEvent struct {
+	bun.BaseModel `bun:"table:policy_report_result_event,alias:ev"`
+
+	ID               int64 `bun:",pk,autoincrement"`
+	Created          int64
+	Type             string
+	FindingID        string
+	PolicyReportID   string `bun:"policy_report_id"`
+	Cluster          string `bun:",notnull,default:''"`
+	Namespace        string
+	Source           string
+	Policy           string
+	Rule             string
+	Severity         string
+	PreviousSeverity string
+	ResourceKind     string
+	ResourceName     string
+}
+
+// EventFilter extends the result Filter with the event specific fields
+type EventFilter struct {
+	Filter
+	Types []string
+	// From and To limit the events to the given unix time range, 0 disables the limit
+	From int64
+	To   int64
+}
+
+func newResultEvent(eventType string, f *Finding, now int64) *ResultEvent {
+	return &ResultEvent{
+		Created:        now,
+		Type:           eventType,
+		FindingID:      f.ID,
+		PolicyReportID: f.PolicyReportID,
+		Cluster:        f.Cluster,
+		Namespace:      f.Namespace,
+		Source:         f.Source,
+		Policy:         f.Policy,
+		Rule:           f.Rule,
+		Severity:       f.Severity,
+		ResourceKind:   f.ResourceKind,
+		ResourceName:   f.ResourceName,
+	}
+}
+
+func eventQuery(query *bun.SelectQuery, filter EventFilter) *QueryBuilder {
+	if filter.From > 0 {
+		query.Where("ev.created >= ?", filter.From)
+	}
+	if filter.To > 0 {
+		query.Where("ev.created <= ?", filter.To)
+	}
+
+	return FromQuery(query).
+		FilterMap(map[string][]string{
+			"ev.type":          filter.Types,
+			"ev.cluster":       filter.Clusters,
+			"ev.namespace":     filter.Namespaces,
+			"ev.source":        filter.Sources,
+			"ev.policy":        filter.Policies,
+			"ev.rule":          filter.Rules,
+			"ev.severity":      filter.Severities,
+			"ev.resource_kind": filter.Kinds,
+		})
+}
+
+// FetchResultEvents returns the audit log entries matching the filter
+func (s *Store) FetchResultEvents(ctx context.Context, filter EventFilter, pagination Pagination) ([]ResultEvent, error) {
+	results := make([]ResultEvent, 0)
+
+	err := eventQuery(s.read().NewSelect().Model(&results), filter).
+		Pagination(pagination).
+		Scan(ctx)
+
+	return results, err
+}
+
+// StreamResultEvents passes all audit log entries matching the filter in batches to fn, ordered by creation.
+// Batches are loaded with a keyset cursor, no query holds a connection while fn is called.
+func (s *Store) StreamResultEvents(ctx context.Context, filter EventFilter, direction string, fn func([]ResultEvent) error) error {
+	operator := ">"
+	if direction != "DESC" {
+		direction = "ASC"
+	} else {
+		operator = "<"
+	}
+
+	var cursor *ResultEvent
+
+	for {
+		batch := make([]ResultEvent, 0, eventBatchSize)
+
+		query := eventQuery(s.read().NewSelect().Model(&batch), filter).GetQuery()
+		if cursor != nil {
+			query.Where(fmt.Sprintf("(ev.created %[1]s ? OR (ev.created = ? AND ev.id %[1]s ?))", operator), cursor.Created, cursor.Created, cursor.ID)
+		}
+
+		err := query.
+			OrderExpr(fmt.Sprintf("ev.created %[1]s, ev.id %[1]s", direction)).
+			Limit(eventBatchSize).
+			Scan(ctx)
+		if err != nil {
+			return err
+		}
+
+		if len(batch) == 0 {
+			return nil
+		}
+
+		if err := fn(batch); err != nil {
+			return err
+		}
+
+		if len(batch) < eventBatchSize {
+			return nil
+		}
+
+		cursor = &batch[len(batch)-1]
+	}
+}
+
+func (s *Store) CountResultEvents(ctx context.Context, filter EventFilter) (int, error) {
+	return eventQuery(s.read().NewSelect().Model((*ResultEvent)(nil)), filter).
+		GetQuery().
+		Count(ctx)
+}
@@ -0,0 +1,424 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/uptrace/bun"
+
+	"github.com/kyverno/policy-reporter/pkg/crd/api/policyreport/v1alpha2"
+	"github.com/kyverno/policy-reporter/pkg/openreports"
+	"github.com/kyverno/policy-reporter/pkg/report/result"
+)
+
+// Finding tracks the lifecycle of a violating result, identified by the stable result ID
+type Finding struct {
+	bun.BaseModel `bun:"table:policy_report_finding,alias:fi"`
+
+	ID             string `bun:",pk"`
+	PolicyReportID string `bun:"policy_report_id"`
+	Cluster        string `bun:",notnull,default:''"`
+	Namespace      string
+	Source         string
+	Policy         string
+	Rule           string
+	Severity       string
+	Category       string
+	ResourceKind   string
+	ResourceName   string
+	Team           string
+	Suppressed     bool
+	// FirstSeen is the time the finding was reported the first time
+	FirstSeen int64
+	// OpenedAt is the time the current occurrence was reported the first time
+	OpenedAt   int64
+	LastSeen   int64
+	ResolvedAt int64
+	// Occurrences counts how often the finding was opened
+	Occurrences int
+}
+
+// FindingAge is the number of open findings of a group per age bucket
+type FindingAge struct {
+	Name       string
+	Total      int
+	Oldest     int64
+	AgeDay     int
+	AgeWeek    int
+	AgeMonth   int
+	AgeQuarter int
+	AgeOlder   int
+}
+
+// FindingRemediation is the mean time to remediate of the resolved findings of a group
+type FindingRemediation struct {
+	Name     string
+	Resolved int
+	MTTR     float64 `bun:"mttr"`
+}
+
+// FindingGroups maps the supported finding groups to their columns
+var FindingGroups = map[string]string{
+	"cluster":   "cluster",
+	"namespace": "namespace",
+	"policy":    "policy",
+	"source":    "source",
+	"severity":  "severity",
+	"team":      "team",
+}
+
+func isViolation(r openreports.ResultAdapter) bool {
+	return r.Result == v1alpha2.StatusFail || r.Result == v1alpha2.StatusWarn || r.Result == v1alpha2.StatusError
+}
+
+// FindingTracker maintains the findings of the violating results of each report
+type FindingTracker struct {
+	db           *bun.DB
+	teamProperty string
+	now          func() time.Time
+	audit        bool
+	retention    time.Duration
+	lastPrune    atomic.Int64
+}
+
+// WithAuditLog records each state transition of a finding as ResultEvent,
+// events older than the retention are pruned, a retention of 0 keeps all events
+func (t *FindingTracker) WithAuditLog(retention time.Duration) *FindingTracker {
+	t.audit = true
+	t.retention = retention
+
+	return t
+}
+
+func (t *FindingTracker) mapFindings(polr openreports.ReportInterface, now int64) map[string]*Finding {
+	findings := make(map[string]*Finding)
+	for _, r := range polr.GetResults() {
+		if !isViolation(r) {
+			continue
+		}
+
+		f := &Finding{
+			ID:             r.GetID(),
+			PolicyReportID: polr.GetID(),
+			Cluster:        openreports.Cluster(polr),
+			Namespace:      polr.GetNamespace(),
+			Source:         r.Source,
+			Policy:         r.Policy,
+			Rule:           r.Rule,
+			Severity:       string(r.Severity),
+			Category:       r.Category,
+			Team:           r.Properties[t.teamProperty],
+			Suppressed:     result.IsSuppressed(r),
+			FirstSeen:      now,
+			OpenedAt:       now,
+			LastSeen:       now,
+			Occurrences:    1,
+		}
+
+		if res := result.Resource(polr, r); res != nil {
+			f.ResourceKind = res.Kind
+			f.ResourceName = res.Name
+		}
+
+		findings[f.ID] = f
+	}
+
+	return findings
+}
+
+// UpdateFindings opens or refreshes the findings of all violating results of the report
+// and resolves open findings of the report which are no longer reported
+func (t *FindingTracker) UpdateFindings(ctx context.Context, polr openreports.ReportInterface) error {
+	now := t.now().Unix()
+	current := t.mapFindings(polr, now)
+
+	ids := make([]string, 0, len(current))
+	for id := range current {
+		ids = append(ids, id)
+	}
+
+	existing := make([]*Finding, 0, len(ids))
+	for _, chunk := range chunkSlice(ids, 500) {
+		list := make([]*Finding, 0, len(chunk))
+		if err := t.db.NewSelect().Model(&list).Where("id IN (?)", bun.List(chunk)).Scan(ctx); err != nil {
+			return err
+		}
+
+		existing = append(existing, list...)
+	}
+
+	opened := make([]*Finding, 0)
+	err := t.db.NewSelect().
+		Model(&opened).
+		Where("policy_report_id = ?", polr.GetID()).
+		Where("resolved_at = 0").
+		Scan(ctx)
+	if err != nil {
+		return err
+	}
+
+	resolved := make([]*Finding, 0)
+	for _, f := range opened {
+		if _, ok := current[f.ID]; !ok {
+			resolved = append(resolved, f)
+		}
+	}
+
+	err = t.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		events := make([]*ResultEvent, 0)
+		seen := make([]string, 0, len(existing))
+		created := make(map[string]*Finding, len(current))
+		for id, f := range current {
+			created[id] = f
+		}
+
+		for _, f := range existing {
+			c := created[f.ID]
+			delete(created, f.ID)
+
+			if f.ResolvedAt == 0 && f.PolicyReportID == c.PolicyReportID && f.Severity == c.Severity && f.Team == c.Team && f.Suppressed == c.Suppressed {
+				seen = append(seen, f.ID)
+				continue
+			}
+
+			if f.ResolvedAt != 0 {
+				f.OpenedAt = now
+				f.ResolvedAt = 0
+				f.Occurrences++
+				events = append(events, newResultEvent(EventOpened, c, now))
+			} else if f.Severity != c.Severity {
+				event := newResultEvent(EventSeverityChanged, c, now)
+				event.PreviousSeverity = f.Severity
+				events = append(events, event)
+			}
+
+			if f.Suppressed != c.Suppressed {
+				if c.Suppressed {
+					events = append(events, newResultEvent(EventSuppressed, c, now))
+				} else {
+					events = append(events, newResultEvent(EventUnsuppressed, c, now))
+				}
+			}
+
+			f.PolicyReportID = c.PolicyReportID
+			f.Severity = c.Severity
+			f.Category = c.Category
+			f.Team = c.Team
+			f.Suppressed = c.Suppressed
+			f.LastSeen = now
+
+			if _, err := tx.NewUpdate().Model(f).WherePK().Exec(ctx); err != nil {
+				return findingError("UPDATE", err)
+			}
+		}
+
+		for _, chunk := range chunkSlice(seen, 500) {
+			_, err := tx.NewUpdate().
+				Model((*Finding)(nil)).
+				Set("last_seen = ?", now).
+				Where("id IN (?)", bun.List(chunk)).
+				Exec(ctx)
+			if err != nil {
+				return findingError("UPDATE", err)
+			}
+		}
+
+		findings := make([]*Finding, 0, len(created))
+		for _, f := range created {
+			findings = append(findings, f)
+			events = append(events, newResultEvent(EventOpened, f, now))
+		}
+
+		for _, list := range chunkSlice(findings, 50) {
+			if _, err := tx.NewInsert().Model(&list).Exec(ctx); err != nil {
+				return findingError("INSERT", err)
+			}
+		}
+
+		if err := t.resolve(ctx, tx, resolved, now); err != nil {
+			return err
+		}
+
+		return t.record(ctx, tx, events)
+	})
+	if err != nil {
+		return err
+	}
+
+	return t.prune(ctx, now)
+}
+
+// ResolveFindings resolves all open findings of the given report
+func (t *FindingTracker) ResolveFindings(ctx context.Context, id string) error {
+	opened := make([]*Finding, 0)
+	err := t.db.NewSelect().
+		Model(&opened).
+		Where("policy_report_id = ?", id).
+		Where("resolved_at = 0").
+		Scan(ctx)
+	if err != nil {
+		return err
+	}
+
+	return t.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		return t.resolve(ctx, tx, opened, t.now().Unix())
+	})
+}
+
+// ResolveOrphanedFindings resolves all open findings of reports which no longer exist,
+// e.g. reports deleted while no instance persisted the report events
+func (t *FindingTracker) ResolveOrphanedFindings(ctx context.Context) (int, error) {
+	orphaned := make([]*Finding, 0)
+	err := t.db.NewSelect().
+		Model(&orphaned).
+		Where("fi.resolved_at = 0").
+		Where("NOT EXISTS (?)", t.db.NewSelect().Model((*PolicyReport)(nil)).ColumnExpr("1").Where("pr.id = fi.policy_report_id")).
+		Scan(ctx)
+	if err != nil {
+		return 0, findingError("SELECT", err)
+	}
+
+	if len(orphaned) == 0 {
+		return 0, nil
+	}
+
+	return len(orphaned), t.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		return t.resolve(ctx, tx, orphaned, t.now().Unix())
+	})
+}
+
+func (t *FindingTracker) resolve(ctx context.Context, tx bun.Tx, findings []*Finding, now int64) error {
+	ids := make([]string, 0, len(findings))
+	events := make([]*ResultEvent, 0, len(findings))
+	for _, f := range findings {
+		ids = append(ids, f.ID)
+		events = append(events, newResultEvent(EventResolved, f, now))
+	}
+
+	for _, chunk := range chunkSlice(ids, 500) {
+		_, err := tx.NewUpdate().
+			Model((*Finding)(nil)).
+			Set("resolved_at = ?", now).
+			Where("id IN (?)", bun.List(chunk)).
+			Exec(ctx)
+		if err != nil {
+			return findingError("UPDATE", err)
+		}
+	}
+
+	return t.record(ctx, tx, events)
+}
+
+func (t *FindingTracker) record(ctx context.Context, tx bun.Tx, events []*ResultEvent) error {
+	if !t.audit {
+		return nil
+	}
+
+	for _, list := range chunkSlice(events, 50) {
+		if _, err := tx.NewInsert().Model(&list).Exec(ctx); err != nil {
+			errorMetric.With(prometheus.Labels{"operation": "INSERT", "table": "policy_report_result_event", "reason": mapReason(err)}).Inc()
+			return err
+		}
+	}
+
+	return nil
+}
+
+// prune removes events older than the retention, at most once per hour
+func (t *FindingTracker) prune(ctx context.Context, now int64) error {
+	if !t.audit || t.retention <= 0 {
+		return nil
+	}
+
+	last := t.lastPrune.Load()
+	if now-last < int64(time.Hour.Seconds()) || !t.lastPrune.CompareAndSwap(last, now) {
+		return nil
+	}
+
+	_, err := t.db.NewDelete().
+		Model((*ResultEvent)(nil)).
+		Where("created < ?", now-int64(t.retention.Seconds())).
+		Exec(ctx)
+	if err != nil {
+		errorMetric.With(prometheus.Labels{"operation": "DELETE", "table": "policy_report_result_event", "reason": mapReason(err)}).Inc()
+	}
+
+	return err
+}
+
+func findingError(operation string, err error) error {
+	errorMetric.With(prometheus.Labels{"operation": operation, "table": "policy_report_finding", "reason": mapReason(err)}).Inc()
+
+	return err
+}
+
+func NewFindingTracker(store *Store, teamProperty string) *FindingTracker {
+	return &FindingTracker{db: store.db, teamProperty: teamProperty, now: time.Now}
+}
+
+// FetchFindingAges returns the age distribution of the open findings per group
+func (s *Store) FetchFindingAges(ctx context.Context, group string, filter Filter) ([]FindingAge, error) {
+	column, ok := FindingGroups[group]
+	if !ok {
+		return nil, fmt.Errorf("unsupported finding group: %s", group)
+	}
+
+	now := time.Now()
+	day := now.AddDate(0, 0, -1).Unix()
+	week := now.AddDate(0, 0, -7).Unix()
+	month := now.AddDate(0, 0, -30).Unix()
+	quarter := now.AddDate(0, 0, -90).Unix()
+
+	results := make([]FindingAge, 0)
+
+	err := FromQuery(s.read().NewSelect().Model((*Finding)(nil))).
+		ColumnExpr(fmt.Sprintf("fi.%s AS name, COUNT(*) AS total, MIN(fi.opened_at) AS oldest", column)).
+		ColumnExpr("SUM(CASE WHEN fi.opened_at >= ? THEN 1 ELSE 0 END) AS age_day", day).
+		ColumnExpr("SUM(CASE WHEN fi.opened_at < ? AND fi.opened_at >= ? THEN 1 ELSE 0 END) AS age_week", day, week).
+		ColumnExpr("SUM(CASE WHEN fi.opened_at < ? AND fi.opened_at >= ? THEN 1 ELSE 0 END) AS age_month", week, month).
+		ColumnExpr("SUM(CASE WHEN fi.opened_at < ? AND fi.opened_at >= ? THEN 1 ELSE 0 END) AS age_quarter", month, quarter).
+		ColumnExpr("SUM(CASE WHEN fi.opened_at < ? THEN 1 ELSE 0 END) AS age_older", quarter).
+		FilterMap(findingFilter(filter)).
+		Group("fi."+column).
+		Order("name ASC").
+		GetQuery().
+		Where("fi.resolved_at = 0").
+		Scan(ctx, &results)
+
+	return results, err
+}
+
+// FetchFindingRemediations returns the mean time to remediate in seconds per group of findings resolved since the given time
+func (s *Store) FetchFindingRemediations(ctx context.Context, group string, filter Filter, since time.Time) ([]FindingRemediation, error) {
+	column, ok := FindingGroups[group]
+	if !ok {
+		return nil, fmt.Errorf("unsupported finding group: %s", group)
+	}
+
+	results := make([]FindingRemediation, 0)
+
+	err := FromQuery(s.read().NewSelect().Model((*Finding)(nil))).
+		ColumnExpr(fmt.Sprintf("fi.%s AS name, COUNT(*) AS resolved, AVG(fi.resolved_at - fi.opened_at) AS mttr", column)).
+		FilterMap(findingFilter(filter)).
+		Group("fi."+column).
+		Order("name ASC").
+		GetQuery().
+		Where("fi.resolved_at >= ?", max(since.Unix(), 1)).
+		Scan(ctx, &results)
+
+	return results, err
+}
+
+func findingFilter(filter Filter) map[string][]string {
+	return map[string][]string{
+		"fi.cluster":   filter.Clusters,
+		"fi.namespace": filter.Namespaces,
+		"fi.source":    filter.Sources,
+		"fi.policy":    filter.Policies,
+		"fi.severity":  filter.Severities,
+		"fi.category":  filter.Categories,
+	}
+}
@@ -0,0 +1,245 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/uptrace/bun/dialect"
+	"go.uber.org/zap"
+)
+
+const migrationLock = "policy_reporter_migration"
+
+// Migration is a versioned schema change, applied migrations are tracked in the policy_report_config table
+type Migration struct {
+	Version int
+	Name    string
+	Up      func(ctx context.Context, s *Store) error
+}
+
+// Migrations are applied in order, new migrations must be appended with the next version
+var Migrations = []Migration{
+	{
+		Version: 1,
+		Name:    "recreate report schema",
+		// reports are resynchronized from the cluster, databases created before
+		// versioned migrations are replaced with the current report schema
+		Up: func(ctx context.Context, s *Store) error {
+			if err := s.dropReportSchema(ctx); err != nil {
+				return err
+			}
+
+			return s.createReportSchema(ctx)
+		},
+	},
+	{
+		Version: 2,
+		Name:    "create trend schema",
+		Up: func(ctx context.Context, s *Store) error {
+			return s.createTrendSchema(ctx)
+		},
+	},
+	{
+		Version: 3,
+		Name:    "create finding schema",
+		Up: func(ctx context.Context, s *Store) error {
+			return s.createFindingSchema(ctx)
+		},
+	},
+	{
+		Version: 4,
+		Name:    "create result event schema",
+		Up: func(ctx context.Context, s *Store) error {
+			return s.createEventSchema(ctx)
+		},
+	},
+	{
+		Version: 5,
+		Name:    "add cluster columns",
+		// existing rows belong to the local cluster, the reports are kept
+		Up: func(ctx context.Context, s *Store) error {
+			models := []any{
+				(*PolicyReport)(nil),
+				(*PolicyReportResult)(nil),
+				(*ResourceResult)(nil),
+				(*PolicyReportFilter)(nil),
+				(*Trend)(nil),
+				(*Finding)(nil),
+				(*ResultEvent)(nil),
+			}
+
+			for _, model := range models {
+				if err := s.addColumn(ctx, model, "cluster", "cluster VARCHAR(255) NOT NULL DEFAULT ''"); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	},
+	{
+		Version: 6,
+		Name:    "add replication heartbeat",
+		Up: func(ctx context.Context, s *Store) error {
+			return s.addColumn(ctx, (*Config)(nil), "heartbeat", "heartbeat BIGINT NOT NULL DEFAULT 0")
+		},
+	},
+}
+
+// SchemaVersion returns the version of the last applied migration, 0 for a new database
+func (s *Store) SchemaVersion(ctx context.Context) (int, error) {
+	config := Config{}
+
+	err := s.db.NewSelect().Model(&config).Column("migration").Where("id = ?", 1).Scan(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+
+	return config.Migration, err
+}
+
+// PendingMigrations returns all migrations not yet applied to the database
+func (s *Store) PendingMigrations(ctx context.Context) ([]Migration, error) {
+	if err := s.prepareConfig(ctx); err != nil {
+		return nil, err
+	}
+
+	current, err := s.SchemaVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pending := make([]Migration, 0)
+	for _, m := range Migrations {
+		if m.Version > current {
+			pending = append(pending, m)
+		}
+	}
+
+	return pending, nil
+}
+
+// Migrate applies all pending migrations in order and returns the applied migrations.
+// A database lock ensures that concurrent instances do not migrate at the same time.
+func (s *Store) Migrate(ctx context.Context) ([]Migration, error) {
+	unlock, err := s.lockMigrations(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to acquire migration lock: %w", err)
+	}
+	defer unlock()
+
+	pending, err := s.PendingMigrations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	applied := make([]Migration, 0, len(pending))
+	for _, m := range pending {
+		zap.L().Info("apply database migration", zap.Int("version", m.Version), zap.String("name", m.Name))
+
+		if err := m.Up(ctx, s); err != nil {
+			return applied, fmt.Errorf("migration %d (%s) failed: %w", m.Version, m.Name, err)
+		}
+
+		if err := s.persistMigration(ctx, m.Version); err != nil {
+			return applied, err
+		}
+
+		applied = append(applied, m)
+	}
+
+	return applied, s.persistMigration(ctx, 0)
+}
+
+// prepareConfig creates the config table and adds the migration column to config tables created before versioned migrations
+func (s *Store) prepareConfig(ctx context.Context) error {
+	_, err := s.db.NewCreateTable().IfNotExists().Model((*Config)(nil)).Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.db.NewSelect().Model((*Config)(nil)).Column("migration").Limit(1).Exists(ctx)
+	if err == nil {
+		return nil
+	}
+
+	zap.L().Debug("add migration column to policy_report_config table", zap.Error(err))
+
+	return s.addColumn(ctx, (*Config)(nil), "migration", "migration INTEGER NOT NULL DEFAULT 0")
+}
+
+// addColumn adds the column to the table of the model if it does not exist yet
+func (s *Store) addColumn(ctx context.Context, model any, column, expr string) error {
+	if _, err := s.db.NewSelect().Model(model).Column(column).Limit(1).Exists(ctx); err == nil {
+		return nil
+	}
+
+	_, err := s.db.NewAddColumn().Model(model).ColumnExpr(expr).Exec(ctx)
+
+	return err
+}
+
+// persistMigration stores the migration and application version, a migration of 0 keeps the current migration
+func (s *Store) persistMigration(ctx context.Context, migration int) error {
+	query := s.db.NewUpdate().Model((*Config)(nil)).Set("version = ?", s.version).Where("id = ?", 1)
+	if migration > 0 {
+		query.Set("migration = ?", migration)
+	}
+
+	res, err := query.Exec(ctx)
+	if err != nil {
+		zap.L().Error("failed to persist database migration", zap.Error(err))
+		return err
+	}
+
+	if rows, err := res.RowsAffected(); err == nil && rows > 0 {
+		return nil
+	}
+
+	_, err = s.db.NewInsert().Model(&Config{ID: 1, Version: s.version, Migration: migration}).Exec(ctx)
+	if err != nil {
+		zap.L().Error("failed to persist database migration", zap.Error(err))
+	}
+
+	return err
+}
+
+func (s *Store) lockMigrations(ctx context.Context) (func(), error) {
+	var lock, unlock string
+
+	switch s.db.Dialect().Name() {
+	case dialect.PG:
+		lock, unlock = "SELECT 1 FROM (SELECT pg_advisory_lock(hashtext(?))) AS l", "SELECT pg_advisory_unlock(hashtext(?))"
+	case dialect.MySQL:
+		lock, unlock = "SELECT GET_LOCK(?, 300)", "SELECT RELEASE_LOCK(?)"
+	default:
+		return func() {}, nil
+	}
+
+	// session locks have to be released on the same connection
+	conn, err := s.db.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var locked sql.NullInt64
+	if err := conn.QueryRowContext(ctx, lock, migrationLock).Scan(&locked); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	// GET_LOCK returns 0 if the lock could not be acquired within the timeout
+	if s.db.Dialect().Name() == dialect.MySQL && locked.Int64 != 1 {
+		conn.Close()
+		return nil, errors.New("timeout waiting for the migration lock")
+	}
+
+	return func() {
+		if _, err := conn.ExecContext(context.Background(), unlock, migrationLock); err != nil {
+			zap.L().Error("failed to release migration lock", zap.Error(err))
+		}
+		conn.Close()
+	}, nil
+}
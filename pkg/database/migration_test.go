@@ -0,0 +1,83 @@
+package database_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kyverno/policy-reporter/pkg/database"
+	"github.com/kyverno/policy-reporter/pkg/fixtures"
+	"github.com/kyverno/policy-reporter/pkg/openreports"
+	"github.com/kyverno/policy-reporter/pkg/report/result"
+)
+
+func Test_Migrate(t *testing.T) {
+	ctx := context.Background()
+
+	db, err := database.NewSQLiteDB(filepath.Join(t.TempDir(), "db_migration.db"))
+	if !assert.Nil(t, err) {
+		return
+	}
+	defer db.Close()
+
+	// config table of a database created before versioned migrations
+	_, err = db.ExecContext(ctx, `CREATE TABLE policy_report_config (id INTEGER PRIMARY KEY AUTOINCREMENT, version VARCHAR)`)
+	assert.Nil(t, err)
+	_, err = db.ExecContext(ctx, `INSERT INTO policy_report_config (version) VALUES ('0.9')`)
+	assert.Nil(t, err)
+
+	store, err := database.NewStore(db, "1.0")
+	assert.Nil(t, err)
+
+	pending, err := store.PendingMigrations(ctx)
+	assert.Nil(t, err)
+	assert.Len(t, pending, len(database.Migrations))
+
+	applied, err := store.Migrate(ctx)
+	assert.Nil(t, err)
+	assert.Len(t, applied, len(database.Migrations))
+
+	version, err := store.SchemaVersion(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, database.Migrations[len(database.Migrations)-1].Version, version)
+
+	applied, err = store.Migrate(ctx)
+	assert.Nil(t, err)
+	assert.Empty(t, applied, "applied migrations should not run again")
+
+	assert.Nil(t, store.PrepareDatabase(ctx))
+}
+
+func Test_MigrateKeepsReports(t *testing.T) {
+	ctx := context.Background()
+
+	db, err := database.NewSQLiteDB(filepath.Join(t.TempDir(), "db_migration_reports.db"))
+	if !assert.Nil(t, err) {
+		return
+	}
+	defer db.Close()
+
+	store, err := database.NewStore(db, "1.0")
+	assert.Nil(t, err)
+
+	_, err = store.Migrate(ctx)
+	assert.Nil(t, err)
+
+	polr := result.NewReconditioner(nil, nil, nil).Prepare(&openreports.ReportAdapter{Report: fixtures.DefaultPolicyReport.Report.DeepCopy()})
+	assert.Nil(t, store.Add(ctx, polr))
+
+	// reapply the migrations since the cluster columns were added
+	_, err = db.ExecContext(ctx, `UPDATE policy_report_config SET migration = 4`)
+	assert.Nil(t, err)
+
+	applied, err := store.Migrate(ctx)
+	assert.Nil(t, err)
+	assert.NotEmpty(t, applied)
+
+	stored, err := store.Get(ctx, polr.GetID())
+	if assert.Nil(t, err, "reports should survive the migration") {
+		assert.Len(t, stored.GetResults(), len(polr.GetResults()))
+	}
+}
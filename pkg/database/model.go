@@ -18,12 +18,17 @@ type Config struct {
 
 	ID      int `bun:"id,pk,autoincrement" json:"id"`
 	Version string
+	// Migration is the version of the last applied migration
+	Migration int `bun:"migration,notnull,default:0"`
+	// Heartbeat is written to the primary in unix milliseconds to measure the replication lag of a read replica
+	Heartbeat int64 `bun:"heartbeat,notnull,default:0"`
 }
 
 type PolicyReport struct {
 	bun.BaseModel `bun:"table:policy_report,alias:pr" json:"-"`
 
 	ID        string            `bun:",pk" json:"id"`
+	Cluster   string            `bun:",notnull,default:''" json:"cluster,omitempty"`
 	Type      string            `json:"type"`
 	Name      string            `json:"name"`
 	Namespace string            `json:"namespace,omitempty"`
@@ -61,6 +66,7 @@ type PolicyReportResult struct {
 
 	ID             string   `bun:",pk" json:"id"`
 	PolicyReportID string   `bun:"policy_report_id" json:"-"`
+	Cluster        string   `bun:",notnull,default:''"`
 	ResourceID     string   `bun:"resource_id"`
 	Resource       Resource `bun:"embed:resource_"`
 	Policy         string
@@ -72,6 +78,7 @@ type PolicyReportResult struct {
 	Category       string
 	Source         string
 	Properties     map[string]string `bun:",type:json"`
+	Suppressed     bool
 	Created        int64
 }
 
@@ -80,6 +87,7 @@ type ResourceResult struct {
 
 	ID             string   `bun:",pk"`
 	PolicyReportID string   `bun:"policy_report_id,pk"`
+	Cluster        string   `bun:",notnull,default:''"`
 	Resource       Resource `bun:"embed:resource_"`
 	Source         string   `bun:",pk"`
 	Category       string   `bun:"category,pk"`
@@ -100,6 +108,7 @@ type PolicyReportFilter struct {
 	bun.BaseModel `bun:"table:policy_report_filter,alias:f"`
 
 	PolicyReportID string `bun:"policy_report_id"`
+	Cluster        string `bun:",notnull,default:''"`
 	Namespace      string `bun:"resource_namespace"`
 	Kind           string `bun:"resource_kind"`
 	API            string `bun:"resource_api"`
@@ -114,6 +123,7 @@ type PolicyReportFilter struct {
 func (r *PolicyReportFilter) Hash() string {
 	h1 := fnv1a.Init64
 	h1 = fnv1a.AddString64(h1, r.PolicyReportID)
+	h1 = fnv1a.AddString64(h1, r.Cluster)
 	h1 = fnv1a.AddString64(h1, r.Namespace)
 	h1 = fnv1a.AddString64(h1, r.Source)
 	h1 = fnv1a.AddString64(h1, r.API)
@@ -128,6 +138,7 @@ func (r *PolicyReportFilter) Hash() string {
 func MapPolicyReport(r openreports.ReportInterface) *PolicyReport {
 	return &PolicyReport{
 		ID:        r.GetID(),
+		Cluster:   openreports.Cluster(r),
 		Type:      report.GetType(r),
 		Name:      r.GetName(),
 		Namespace: r.GetNamespace(),
@@ -143,6 +154,8 @@ func MapPolicyReport(r openreports.ReportInterface) *PolicyReport {
 }
 
 func MapPolicyReportResults(polr openreports.ReportInterface) []*PolicyReportResult {
+	cluster := openreports.Cluster(polr)
+
 	list := make([]*PolicyReportResult, 0, len(polr.GetResults()))
 	for _, r := range polr.GetResults() {
 		res := result.Resource(polr, r)
@@ -164,7 +177,8 @@ func MapPolicyReportResults(polr openreports.ReportInterface) []*PolicyReportRes
 		list = append(list, &PolicyReportResult{
 			ID:             r.GetID(),
 			PolicyReportID: polr.GetID(),
-			ResourceID:     resource.GetID(),
+			Cluster:        cluster,
+			ResourceID:     openreports.ClusterID(cluster, resource.GetID()),
 			Resource:       resource,
 			Policy:         r.Policy,
 			Rule:           r.Rule,
@@ -175,6 +189,7 @@ func MapPolicyReportResults(polr openreports.ReportInterface) []*PolicyReportRes
 			Severity:       string(r.Severity),
 			Category:       r.Category,
 			Properties:     r.Properties,
+			Suppressed:     result.IsSuppressed(r),
 			Created:        r.Timestamp.Seconds,
 		})
 	}
@@ -183,6 +198,8 @@ func MapPolicyReportResults(polr openreports.ReportInterface) []*PolicyReportRes
 }
 
 func MapPolicyReportFilter(polr openreports.ReportInterface) []*PolicyReportFilter {
+	cluster := openreports.Cluster(polr)
+
 	mapping := make(map[string]*PolicyReportFilter)
 	for _, res := range polr.GetResults() {
 		var api, kind string
@@ -197,6 +214,7 @@ func MapPolicyReportFilter(polr openreports.ReportInterface) []*PolicyReportFilt
 
 		value := &PolicyReportFilter{
 			PolicyReportID: polr.GetID(),
+			Cluster:        cluster,
 			Namespace:      polr.GetNamespace(),
 			Source:         res.Source,
 			Kind:           kind,
@@ -223,6 +241,8 @@ func MapPolicyReportFilter(polr openreports.ReportInterface) []*PolicyReportFilt
 }
 
 func MapPolicyReportResource(polr openreports.ReportInterface) []*ResourceResult {
+	cluster := openreports.Cluster(polr)
+
 	mapping := make(map[string]*ResourceResult)
 	for _, res := range polr.GetResults() {
 		resource := polr.GetScope()
@@ -243,13 +263,15 @@ func MapPolicyReportResource(polr openreports.ReportInterface) []*ResourceResult
 			Name:       resource.Name,
 		}
 
-		id := strings.Join([]string{r.GetID(), res.Category, polr.GetID(), res.Source}, "/")
+		resourceID := openreports.ClusterID(cluster, r.GetID())
+		id := strings.Join([]string{resourceID, res.Category, polr.GetID(), res.Source}, "/")
 
 		value, ok := mapping[id]
 		if !ok {
 			value = &ResourceResult{
-				ID:             r.GetID(),
+				ID:             resourceID,
 				PolicyReportID: polr.GetID(),
+				Cluster:        cluster,
 				Resource:       r,
 				Source:         res.Source,
 				Category:       res.Category,
@@ -291,6 +313,7 @@ func MapPolicyReportResource(polr openreports.ReportInterface) []*ResourceResult
 }
 
 type Filter struct {
+	Clusters     []string
 	Kinds        []string
 	Categories   []string
 	Namespaces   []string
@@ -306,6 +329,8 @@ type Filter struct {
 	Exclude      map[string][]string
 	Namespaced   bool
 	Search       string
+	// Suppressed filters results by the suppressed flag, nil matches all results
+	Suppressed *bool
 }
 
 type Pagination struct {
@@ -0,0 +1,133 @@
+package database
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/uptrace/bun"
+	"go.uber.org/zap"
+)
+
+var (
+	replicaLag = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "policy_reporter_database_replica_lag_seconds",
+		Help: "Replication lag of the read replica, measured by the heartbeat written to the primary",
+	})
+	replicaUp = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "policy_reporter_database_replica_up",
+		Help: "1 if queries are served by the read replica, 0 if they fall back to the primary",
+	})
+)
+
+// Replica is a read-only database connection used for the Fetch and Count queries of the Store
+type Replica struct {
+	db        *bun.DB
+	available atomic.Bool
+	lag       atomic.Int64
+}
+
+// Available returns false until the first successful health check and while the replica is unreachable
+func (r *Replica) Available() bool {
+	return r.available.Load()
+}
+
+// Lag returns the last measured replication lag
+func (r *Replica) Lag() time.Duration {
+	return time.Duration(r.lag.Load())
+}
+
+func (r *Replica) setAvailable(available bool) {
+	if r.available.Swap(available) != available {
+		zap.L().Info("read replica availability changed", zap.Bool("available", available))
+	}
+
+	if available {
+		replicaUp.Set(1)
+	} else {
+		replicaUp.Set(0)
+	}
+}
+
+// ReplicaMonitor checks the availability of the read replica and measures its replication lag.
+// The lag is the age of the last heartbeat, written to the primary and read from the replica.
+type ReplicaMonitor struct {
+	store    *Store
+	replica  *Replica
+	interval time.Duration
+	active   atomic.Bool
+}
+
+// Start writing heartbeats to the primary, with leader election only the leader should write
+func (m *ReplicaMonitor) Start() {
+	m.active.Store(true)
+}
+
+// Stop writing heartbeats, the replica is still checked for availability
+func (m *ReplicaMonitor) Stop() {
+	m.active.Store(false)
+}
+
+// Run checks the replica immediately and after each interval until the context is canceled
+func (m *ReplicaMonitor) Run(ctx context.Context) error {
+	m.Check(ctx, time.Now())
+
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case now := <-ticker.C:
+			m.Check(ctx, now)
+		}
+	}
+}
+
+// Check writes the heartbeat if the monitor is active, updates the availability and the lag of the replica
+func (m *ReplicaMonitor) Check(ctx context.Context, now time.Time) {
+	ctx, cancel := context.WithTimeout(ctx, m.interval)
+	defer cancel()
+
+	if m.active.Load() {
+		_, err := m.store.db.NewUpdate().Model((*Config)(nil)).Set("heartbeat = ?", now.UnixMilli()).Where("id = ?", 1).Exec(ctx)
+		logOnError("write replication heartbeat", err)
+	}
+
+	if err := m.replica.db.PingContext(ctx); err != nil {
+		zap.L().Warn("read replica unavailable, fall back to primary", zap.Error(err))
+		m.replica.setAvailable(false)
+		return
+	}
+	m.replica.setAvailable(true)
+
+	var heartbeat int64
+	err := m.replica.db.NewSelect().Model((*Config)(nil)).Column("heartbeat").Where("id = ?", 1).Scan(ctx, &heartbeat)
+	if err != nil {
+		zap.L().Debug("failed to read replication heartbeat", zap.Error(err))
+		return
+	}
+	if heartbeat == 0 {
+		return
+	}
+
+	lag := max(now.Sub(time.UnixMilli(heartbeat)), 0)
+	m.replica.lag.Store(int64(lag))
+	replicaLag.Set(lag.Seconds())
+}
+
+// NewReplicaMonitor returns nil if no replica is configured on the store
+func NewReplicaMonitor(store *Store, interval time.Duration) *ReplicaMonitor {
+	if store.replica == nil {
+		return nil
+	}
+
+	return &ReplicaMonitor{
+		store:    store,
+		replica:  store.replica,
+		interval: interval,
+	}
+}
@@ -0,0 +1,118 @@
+package database_test
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/sqlitedialect"
+
+	"github.com/kyverno/policy-reporter/pkg/database"
+	"github.com/kyverno/policy-reporter/pkg/fixtures"
+	"github.com/kyverno/policy-reporter/pkg/openreports"
+	"github.com/kyverno/policy-reporter/pkg/report/result"
+)
+
+// openReplica opens an additional connection to an existing sqlite database file
+func openReplica(t *testing.T, file string) *bun.DB {
+	sqldb, err := sql.Open("sqlite3", file+"?cache=shared")
+	if !assert.Nil(t, err) {
+		t.FailNow()
+	}
+
+	return bun.NewDB(sqldb, sqlitedialect.New())
+}
+
+func Test_Replica(t *testing.T) {
+	ctx := context.Background()
+
+	file := filepath.Join(t.TempDir(), "primary.db")
+	db, err := database.NewSQLiteDB(file)
+	if !assert.Nil(t, err) {
+		return
+	}
+	defer db.Close()
+
+	store := seedStore(t, db)
+
+	t.Run("queries use the available replica", func(t *testing.T) {
+		replicaDB, err := database.NewSQLiteDB(filepath.Join(t.TempDir(), "replica.db"))
+		if !assert.Nil(t, err) {
+			return
+		}
+		defer replicaDB.Close()
+
+		replicaStore, err := database.NewStore(replicaDB, "1.0")
+		assert.Nil(t, err)
+		assert.Nil(t, replicaStore.PrepareDatabase(ctx))
+
+		replica := store.SetReplica(replicaDB)
+		defer store.SetReplica(nil)
+
+		count, err := store.CountPolicyReports(ctx, database.Filter{})
+		assert.Nil(t, err)
+		assert.Equal(t, 3, count, "unchecked replica should not be used")
+
+		database.NewReplicaMonitor(store, time.Second).Check(ctx, time.Now())
+		assert.True(t, replica.Available())
+
+		count, err = store.CountPolicyReports(ctx, database.Filter{})
+		assert.Nil(t, err)
+		assert.Equal(t, 0, count, "queries should use the empty replica")
+
+		report := fixtures.DefaultPolicyReport.Report.DeepCopy()
+		report.Name = "replica-report"
+
+		polr := result.NewReconditioner(nil, nil, nil).Prepare(&openreports.ReportAdapter{Report: report})
+		assert.Nil(t, store.Add(ctx, polr))
+		defer store.Remove(ctx, polr.GetID())
+
+		count, err = replicaStore.CountPolicyReports(ctx, database.Filter{})
+		assert.Nil(t, err)
+		assert.Equal(t, 0, count, "writes should use the primary")
+	})
+
+	t.Run("replication lag", func(t *testing.T) {
+		replica := store.SetReplica(openReplica(t, file))
+		defer store.SetReplica(nil)
+
+		monitor := database.NewReplicaMonitor(store, time.Second)
+		// heartbeats are stored in milliseconds
+		now := time.UnixMilli(time.Now().UnixMilli())
+
+		monitor.Start()
+		monitor.Check(ctx, now)
+		assert.True(t, replica.Available())
+		assert.Equal(t, time.Duration(0), replica.Lag())
+
+		monitor.Stop()
+		monitor.Check(ctx, now.Add(5*time.Second))
+		assert.Equal(t, 5*time.Second, replica.Lag(), "heartbeat should not be written by an inactive monitor")
+	})
+
+	t.Run("fall back to the primary", func(t *testing.T) {
+		replicaDB := openReplica(t, file)
+		replica := store.SetReplica(replicaDB)
+		defer store.SetReplica(nil)
+
+		monitor := database.NewReplicaMonitor(store, time.Second)
+		monitor.Check(ctx, time.Now())
+		assert.True(t, replica.Available())
+
+		replicaDB.Close()
+		monitor.Check(ctx, time.Now())
+		assert.False(t, replica.Available())
+
+		count, err := store.CountPolicyReports(ctx, database.Filter{})
+		assert.Nil(t, err)
+		assert.Equal(t, 3, count)
+	})
+
+	t.Run("without replica", func(t *testing.T) {
+		assert.Nil(t, database.NewReplicaMonitor(store, time.Second))
+	})
+}
@@ -0,0 +1,468 @@
+package database_test
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/stretchr/testify/assert"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/mysqldialect"
+	"github.com/uptrace/bun/dialect/pgdialect"
+	"github.com/uptrace/bun/driver/pgdriver"
+
+	"github.com/kyverno/policy-reporter/pkg/database"
+	"github.com/kyverno/policy-reporter/pkg/fixtures"
+	"github.com/kyverno/policy-reporter/pkg/openreports"
+	"github.com/kyverno/policy-reporter/pkg/report/result"
+)
+
+// dialects the compatibility suite runs against, SQLite is the reference for all other dialects.
+// External databases are configured with a DSN environment variable, the suite drops and recreates all tables.
+// A dialect is only supported by the Store once it passes this suite.
+var dialects = []struct {
+	name string
+	open func(t *testing.T) *bun.DB
+}{
+	{
+		name: "sqlite",
+		open: func(t *testing.T) *bun.DB {
+			db, err := database.NewSQLiteDB(filepath.Join(t.TempDir(), "compatibility.db"))
+			if err != nil {
+				t.Fatalf("failed to open sqlite database: %s", err)
+			}
+
+			return db
+		},
+	},
+	{
+		name: "postgres",
+		open: func(t *testing.T) *bun.DB {
+			dsn := os.Getenv("POLICY_REPORTER_TEST_POSTGRES_DSN")
+			if dsn == "" {
+				t.Skip("POLICY_REPORTER_TEST_POSTGRES_DSN not set")
+			}
+
+			return bun.NewDB(sql.OpenDB(pgdriver.NewConnector(pgdriver.WithDSN(dsn))), pgdialect.New())
+		},
+	},
+	{
+		name: "mysql",
+		open: func(t *testing.T) *bun.DB {
+			dsn := os.Getenv("POLICY_REPORTER_TEST_MYSQL_DSN")
+			if dsn == "" {
+				t.Skip("POLICY_REPORTER_TEST_MYSQL_DSN not set")
+			}
+
+			sqldb, err := sql.Open("mysql", dsn)
+			if err != nil {
+				t.Fatalf("failed to open mysql database: %s", err)
+			}
+
+			return bun.NewDB(sqldb, mysqldialect.New())
+		},
+	},
+}
+
+var (
+	resourceOrder = database.Pagination{SortBy: []string{"resource_namespace", "resource_name", "resource_uid"}, Direction: "ASC"}
+	resultOrder   = database.Pagination{SortBy: []string{"resource_namespace", "resource_name", "resource_uid", "policy", "rule", "message"}, Direction: "ASC"}
+	firstPage     = database.Pagination{Page: 1, Offset: 2, SortBy: resultOrder.SortBy, Direction: "ASC"}
+	trendTime     = time.Unix(1700000000, 0)
+)
+
+// compatibilityCase calls a single Store method, ordered results are compared including their order
+type compatibilityCase struct {
+	name    string
+	ordered bool
+	run     func(ctx context.Context, s *database.Store) (any, error)
+}
+
+func newCompatibilityCases(resourceID string) []compatibilityCase {
+	filter := database.Filter{}
+
+	return []compatibilityCase{
+		{name: "FetchPolicyReports", ordered: true, run: func(ctx context.Context, s *database.Store) (any, error) {
+			return s.FetchPolicyReports(ctx, filter, database.Pagination{SortBy: []string{"namespace", "name"}, Direction: "ASC"})
+		}},
+		{name: "FetchPolicyReportsByLabel", run: func(ctx context.Context, s *database.Store) (any, error) {
+			return s.FetchPolicyReports(ctx, database.Filter{ReportLabel: map[string]string{"app": "kyverno"}}, database.Pagination{SortBy: []string{"name"}, Direction: "ASC"})
+		}},
+		{name: "CountPolicyReports", run: func(ctx context.Context, s *database.Store) (any, error) {
+			return s.CountPolicyReports(ctx, filter)
+		}},
+		{name: "FetchClusterPolicyReports", ordered: true, run: func(ctx context.Context, s *database.Store) (any, error) {
+			return s.FetchClusterPolicyReports(ctx, filter, database.Pagination{SortBy: []string{"name"}, Direction: "ASC"})
+		}},
+		{name: "CountClusterPolicyReports", run: func(ctx context.Context, s *database.Store) (any, error) {
+			return s.CountClusterPolicyReports(ctx, filter)
+		}},
+		{name: "FetchRuleStatusCounts", run: func(ctx context.Context, s *database.Store) (any, error) {
+			return s.FetchRuleStatusCounts(ctx, "required-limit", "resource-limit-required")
+		}},
+		{name: "FetchNamespaces", run: func(ctx context.Context, s *database.Store) (any, error) {
+			return s.FetchNamespaces(ctx, filter)
+		}},
+		{name: "FetchNamespacedFilter", run: func(ctx context.Context, s *database.Store) (any, error) {
+			return s.FetchNamespacedFilter(ctx, "policy", filter)
+		}},
+		{name: "FetchClusterFilter", run: func(ctx context.Context, s *database.Store) (any, error) {
+			return s.FetchClusterFilter(ctx, "policy", filter)
+		}},
+		{name: "FetchNamespacedResources", run: func(ctx context.Context, s *database.Store) (any, error) {
+			return s.FetchNamespacedResources(ctx, filter)
+		}},
+		{name: "FetchClusterResources", run: func(ctx context.Context, s *database.Store) (any, error) {
+			return s.FetchClusterResources(ctx, filter)
+		}},
+		{name: "FetchClusterScopedStatusCounts", run: func(ctx context.Context, s *database.Store) (any, error) {
+			return s.FetchClusterScopedStatusCounts(ctx, filter)
+		}},
+		{name: "FetchNamespaceScopedStatusCounts", run: func(ctx context.Context, s *database.Store) (any, error) {
+			return s.FetchNamespaceScopedStatusCounts(ctx, filter)
+		}},
+		{name: "FetchSources", run: func(ctx context.Context, s *database.Store) (any, error) {
+			return s.FetchSources(ctx, filter)
+		}},
+		{name: "FetchCategories", run: func(ctx context.Context, s *database.Store) (any, error) {
+			return s.FetchCategories(ctx, filter)
+		}},
+		{name: "FetchResource", run: func(ctx context.Context, s *database.Store) (any, error) {
+			return s.FetchResource(ctx, resourceID)
+		}},
+		{name: "FetchResourceCategories", run: func(ctx context.Context, s *database.Store) (any, error) {
+			return s.FetchResourceCategories(ctx, resourceID, filter)
+		}},
+		{name: "FetchProperty", run: func(ctx context.Context, s *database.Store) (any, error) {
+			return s.FetchProperty(ctx, "version", filter)
+		}},
+		{name: "FetchResourceStatusCounts", run: func(ctx context.Context, s *database.Store) (any, error) {
+			return s.FetchResourceStatusCounts(ctx, resourceID, filter)
+		}},
+		{name: "FetchResourceSeverityCounts", run: func(ctx context.Context, s *database.Store) (any, error) {
+			return s.FetchResourceSeverityCounts(ctx, resourceID, filter)
+		}},
+		{name: "FetchNamespaceResourceResults", ordered: true, run: func(ctx context.Context, s *database.Store) (any, error) {
+			return s.FetchNamespaceResourceResults(ctx, filter, resourceOrder)
+		}},
+		{name: "CountNamespaceResourceResults", run: func(ctx context.Context, s *database.Store) (any, error) {
+			return s.CountNamespaceResourceResults(ctx, filter)
+		}},
+		{name: "FetchTotalResourceResults", ordered: true, run: func(ctx context.Context, s *database.Store) (any, error) {
+			return s.FetchTotalResourceResults(ctx, filter, resourceOrder)
+		}},
+		{name: "CountTotalResourceResults", run: func(ctx context.Context, s *database.Store) (any, error) {
+			return s.CountTotalResourceResults(ctx, filter)
+		}},
+		{name: "FetchClusterResourceResults", ordered: true, run: func(ctx context.Context, s *database.Store) (any, error) {
+			return s.FetchClusterResourceResults(ctx, filter, database.Pagination{SortBy: []string{"resource_name", "resource_uid"}, Direction: "ASC"})
+		}},
+		{name: "CountClusterResourceResults", run: func(ctx context.Context, s *database.Store) (any, error) {
+			return s.CountClusterResourceResults(ctx, filter)
+		}},
+		{name: "FetchResourceResults", run: func(ctx context.Context, s *database.Store) (any, error) {
+			return s.FetchResourceResults(ctx, resourceID, filter)
+		}},
+		{name: "FetchResourcePolicyResults", ordered: true, run: func(ctx context.Context, s *database.Store) (any, error) {
+			return s.FetchResourcePolicyResults(ctx, resourceID, filter, resultOrder)
+		}},
+		{name: "CountResourcePolicyResults", run: func(ctx context.Context, s *database.Store) (any, error) {
+			return s.CountResourcePolicyResults(ctx, resourceID, filter)
+		}},
+		{name: "FetchNamespacedResults", ordered: true, run: func(ctx context.Context, s *database.Store) (any, error) {
+			return s.FetchResults(ctx, true, filter, resultOrder)
+		}},
+		{name: "FetchNamespacedResultsPage", ordered: true, run: func(ctx context.Context, s *database.Store) (any, error) {
+			return s.FetchResults(ctx, true, filter, firstPage)
+		}},
+		{name: "FetchNamespacedResultsBySearch", ordered: true, run: func(ctx context.Context, s *database.Store) (any, error) {
+			return s.FetchResults(ctx, true, database.Filter{Search: "nginx"}, resultOrder)
+		}},
+		{name: "CountNamespacedResults", run: func(ctx context.Context, s *database.Store) (any, error) {
+			return s.CountResults(ctx, true, filter)
+		}},
+		{name: "FetchClusterResults", ordered: true, run: func(ctx context.Context, s *database.Store) (any, error) {
+			return s.FetchResults(ctx, false, filter, resultOrder)
+		}},
+		{name: "CountClusterResults", run: func(ctx context.Context, s *database.Store) (any, error) {
+			return s.CountResults(ctx, false, filter)
+		}},
+		{name: "FetchResultsWithoutResource", ordered: true, run: func(ctx context.Context, s *database.Store) (any, error) {
+			return s.FetchResultsWithoutResource(ctx, filter, resultOrder)
+		}},
+		{name: "CountResultsWithoutResource", run: func(ctx context.Context, s *database.Store) (any, error) {
+			return s.CountResultsWithoutResource(ctx, filter)
+		}},
+		{name: "UseResources", run: func(ctx context.Context, s *database.Store) (any, error) {
+			return s.UseResources(ctx, "test", filter)
+		}},
+		{name: "FetchClusterStatusCounts", run: func(ctx context.Context, s *database.Store) (any, error) {
+			return s.FetchClusterStatusCounts(ctx, "Kyverno", filter)
+		}},
+		{name: "FetchClusterSeverityCounts", run: func(ctx context.Context, s *database.Store) (any, error) {
+			return s.FetchClusterSeverityCounts(ctx, "Kyverno", filter)
+		}},
+		{name: "FetchNamespaceStatusCounts", run: func(ctx context.Context, s *database.Store) (any, error) {
+			return s.FetchNamespaceStatusCounts(ctx, "test", filter)
+		}},
+		{name: "FetchNamespaceSeverityCounts", run: func(ctx context.Context, s *database.Store) (any, error) {
+			return s.FetchNamespaceSeverityCounts(ctx, "test", filter)
+		}},
+		{name: "FetchTotalStatusCounts", run: func(ctx context.Context, s *database.Store) (any, error) {
+			return s.FetchTotalStatusCounts(ctx, "Kyverno", filter)
+		}},
+		{name: "FetchTotalSeverityCounts", run: func(ctx context.Context, s *database.Store) (any, error) {
+			return s.FetchTotalSeverityCounts(ctx, "Kyverno", filter)
+		}},
+		{name: "FetchNamespaceKinds", run: func(ctx context.Context, s *database.Store) (any, error) {
+			return s.FetchNamespaceKinds(ctx, filter)
+		}},
+		{name: "FetchClusterKinds", run: func(ctx context.Context, s *database.Store) (any, error) {
+			return s.FetchClusterKinds(ctx, filter)
+		}},
+		{name: "FetchPolicies", run: func(ctx context.Context, s *database.Store) (any, error) {
+			return s.FetchPolicies(ctx, filter)
+		}},
+		{name: "FetchNamespacedFindingCounts", run: func(ctx context.Context, s *database.Store) (any, error) {
+			return s.FetchFindingCounts(ctx, database.Filter{Namespaced: true})
+		}},
+		{name: "FetchClusterFindingCounts", run: func(ctx context.Context, s *database.Store) (any, error) {
+			return s.FetchFindingCounts(ctx, filter)
+		}},
+		{name: "FetchSeverityFindingCounts", run: func(ctx context.Context, s *database.Store) (any, error) {
+			return s.FetchSeverityFindingCounts(ctx, database.Filter{Namespaced: true})
+		}},
+		{name: "FetchClusters", ordered: true, run: func(ctx context.Context, s *database.Store) (any, error) {
+			return s.FetchClusters(ctx)
+		}},
+		{name: "FetchClusterTaggedResults", ordered: true, run: func(ctx context.Context, s *database.Store) (any, error) {
+			return s.FetchResults(ctx, true, database.Filter{Clusters: []string{"cluster-a"}}, resultOrder)
+		}},
+		{name: "FetchTrends", run: func(ctx context.Context, s *database.Store) (any, error) {
+			return s.FetchTrends(ctx, "status", filter, trendTime.Add(-time.Hour), trendTime.Add(time.Hour))
+		}},
+		{name: "FetchFindingAges", run: func(ctx context.Context, s *database.Store) (any, error) {
+			return s.FetchFindingAges(ctx, "namespace", filter)
+		}},
+		{name: "FetchFindingRemediations", run: func(ctx context.Context, s *database.Store) (any, error) {
+			return s.FetchFindingRemediations(ctx, "policy", filter, time.Unix(0, 0))
+		}},
+		{name: "FetchResultEvents", run: func(ctx context.Context, s *database.Store) (any, error) {
+			events, err := s.FetchResultEvents(ctx, database.EventFilter{}, database.Pagination{SortBy: []string{"created", "id"}, Direction: "ASC"})
+
+			// IDs and creation times depend on the test run
+			for i := range events {
+				events[i].ID = 0
+				events[i].Created = 0
+			}
+
+			return events, err
+		}},
+		{name: "CountResultEvents", run: func(ctx context.Context, s *database.Store) (any, error) {
+			return s.CountResultEvents(ctx, database.EventFilter{Types: []string{database.EventOpened}})
+		}},
+	}
+}
+
+func seedStore(t *testing.T, db *bun.DB) *database.Store {
+	ctx := context.Background()
+
+	store, err := database.NewStore(db, "1.0")
+	if !assert.Nil(t, err) {
+		t.FailNow()
+	}
+
+	// external databases are reused between runs
+	for _, model := range []any{(*database.Trend)(nil), (*database.Finding)(nil), (*database.ResultEvent)(nil)} {
+		_, err := db.NewDropTable().IfExists().Model(model).Exec(ctx)
+		assert.Nil(t, err)
+	}
+	assert.Nil(t, store.DropSchema(ctx))
+	assert.Nil(t, store.PrepareDatabase(ctx))
+
+	reconditioner := result.NewReconditioner(nil, nil, nil)
+
+	labeled := fixtures.KyvernoPolicyReport.DeepCopy()
+	labeled.Labels = map[string]string{"app": "kyverno"}
+
+	reports := []openreports.ReportInterface{
+		reconditioner.Prepare(&openreports.ReportAdapter{Report: fixtures.DefaultPolicyReport.Report.DeepCopy()}),
+		reconditioner.Prepare(&openreports.ReportAdapter{Report: labeled}),
+		reconditioner.Prepare(&openreports.ClusterReportAdapter{ClusterReport: fixtures.KyvernoClusterPolicyReport.DeepCopy()}),
+		reconditioner.WithCluster("cluster-a").Prepare(&openreports.ReportAdapter{Report: fixtures.KyvernoPolicyReport.DeepCopy()}),
+	}
+
+	findings := database.NewFindingTracker(store, "team").WithAuditLog(0)
+	for _, r := range reports {
+		assert.Nil(t, store.Add(ctx, r))
+		assert.Nil(t, findings.UpdateFindings(ctx, r))
+	}
+
+	assert.Nil(t, store.CreateTrendSnapshot(ctx, trendTime))
+	assert.Nil(t, findings.ResolveFindings(ctx, reports[2].GetID()))
+
+	return store
+}
+
+// normalize returns a comparable JSON representation, results of unordered queries are sorted
+func normalize(t *testing.T, value any, ordered bool) string {
+	content, err := json.Marshal(value)
+	assert.Nil(t, err)
+
+	list := make([]json.RawMessage, 0)
+	if ordered || json.Unmarshal(content, &list) != nil {
+		return string(content)
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		return string(list[i]) < string(list[j])
+	})
+
+	content, err = json.Marshal(list)
+	assert.Nil(t, err)
+
+	return string(content)
+}
+
+func Test_StoreCompatibility(t *testing.T) {
+	ctx := context.Background()
+
+	polr := result.NewReconditioner(nil, nil, nil).Prepare(&openreports.ReportAdapter{Report: fixtures.DefaultPolicyReport.Report.DeepCopy()})
+	resourceID := database.MapPolicyReportResults(polr)[0].ResourceID
+
+	cases := newCompatibilityCases(resourceID)
+	reference := make(map[string]string, len(cases))
+
+	for _, dialect := range dialects {
+		t.Run(dialect.name, func(t *testing.T) {
+			db := dialect.open(t)
+			defer db.Close()
+
+			store := seedStore(t, db)
+
+			for _, c := range cases {
+				t.Run(c.name, func(t *testing.T) {
+					value, err := c.run(ctx, store)
+					if !assert.Nil(t, err) {
+						return
+					}
+
+					content := normalize(t, value, c.ordered)
+					if expected, ok := reference[c.name]; ok {
+						assert.JSONEq(t, expected, content, "result differs from the sqlite reference")
+						return
+					}
+
+					reference[c.name] = content
+				})
+			}
+
+			t.Run("Get", func(t *testing.T) {
+				report, err := store.Get(ctx, polr.GetID())
+				if assert.Nil(t, err) {
+					assert.Len(t, report.GetResults(), len(polr.GetResults()))
+				}
+			})
+		})
+	}
+
+	// the reference itself is checked for the aggregates which are most likely to differ between dialects
+	assert.JSONEq(t, `[{"Source":"","Status":"fail","Count":3,"Namespace":"test"}]`, reference["FetchNamespaceStatusCounts"])
+	assert.JSONEq(t, `["cluster-a"]`, reference["FetchClusters"])
+	assert.Equal(t, "3", reference["CountPolicyReports"])
+}
+
+func Test_StreamResultEvents(t *testing.T) {
+	ctx := context.Background()
+
+	db, err := database.NewSQLiteDB(filepath.Join(t.TempDir(), "stream.db"))
+	if !assert.Nil(t, err) {
+		return
+	}
+	defer db.Close()
+
+	store := seedStore(t, db)
+
+	// events with the same timestamp across several batches, the ID resolves the order
+	events := make([]database.ResultEvent, 0, 2500)
+	for i := range 2500 {
+		events = append(events, database.ResultEvent{Created: int64(i / 1000), Type: database.EventOpened, Namespace: "stream"})
+	}
+	_, err = db.NewInsert().Model(&events).Exec(ctx)
+	if !assert.Nil(t, err) {
+		return
+	}
+
+	filter := database.EventFilter{Filter: database.Filter{Namespaces: []string{"stream"}}}
+
+	for _, direction := range []string{"ASC", "DESC"} {
+		t.Run(direction, func(t *testing.T) {
+			expected, err := store.FetchResultEvents(ctx, filter, database.Pagination{SortBy: []string{"created", "id"}, Direction: direction})
+			assert.Nil(t, err)
+
+			streamed := make([]database.ResultEvent, 0, len(expected))
+			batches := 0
+
+			err = store.StreamResultEvents(ctx, filter, direction, func(batch []database.ResultEvent) error {
+				streamed = append(streamed, batch...)
+				batches++
+				return nil
+			})
+
+			assert.Nil(t, err)
+			assert.Equal(t, 3, batches)
+			assert.Len(t, streamed, 2500)
+			assert.Equal(t, expected, streamed)
+		})
+	}
+}
+
+func Test_ResolveOrphanedFindings(t *testing.T) {
+	ctx := context.Background()
+
+	db, err := database.NewSQLiteDB(filepath.Join(t.TempDir(), "orphaned.db"))
+	if !assert.Nil(t, err) {
+		return
+	}
+	defer db.Close()
+
+	store := seedStore(t, db)
+	findings := database.NewFindingTracker(store, "team").WithAuditLog(0)
+
+	openFindings := func(id string) int {
+		count, err := db.NewSelect().Model((*database.Finding)(nil)).Where("policy_report_id = ?", id).Where("resolved_at = 0").Count(ctx)
+		assert.Nil(t, err)
+
+		return count
+	}
+
+	deleted := (&openreports.ReportAdapter{Report: fixtures.DefaultPolicyReport.Report}).GetID()
+	kept := (&openreports.ReportAdapter{Report: fixtures.KyvernoPolicyReport}).GetID()
+
+	opened := openFindings(deleted)
+	if !assert.NotZero(t, opened) || !assert.NotZero(t, openFindings(kept)) {
+		return
+	}
+
+	resolved, err := findings.ResolveOrphanedFindings(ctx)
+	assert.Nil(t, err)
+	assert.Zero(t, resolved, "findings of existing reports should be kept")
+
+	assert.Nil(t, store.Remove(ctx, deleted))
+
+	resolved, err = findings.ResolveOrphanedFindings(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, opened, resolved)
+	assert.Zero(t, openFindings(deleted))
+	assert.NotZero(t, openFindings(kept))
+
+	events, err := store.CountResultEvents(ctx, database.EventFilter{Types: []string{database.EventResolved}})
+	assert.Nil(t, err)
+	assert.GreaterOrEqual(t, events, opened)
+}
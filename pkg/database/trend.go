@@ -0,0 +1,179 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/uptrace/bun"
+	"go.uber.org/zap"
+)
+
+// Trend is a snapshot of the aggregated result count of a source, namespace, policy, status and severity
+type Trend struct {
+	bun.BaseModel `bun:"table:policy_report_trend,alias:t"`
+
+	Created   int64
+	Cluster   string `bun:",notnull,default:''"`
+	Source    string
+	Namespace string
+	Policy    string
+	Result    string
+	Severity  string
+	Count     int
+}
+
+// TrendCount is the summed count of a series at a snapshot time
+type TrendCount struct {
+	Created int64
+	Name    string
+	Count   int
+}
+
+// TrendGroups maps the supported series groups to their columns
+var TrendGroups = map[string]string{
+	"cluster":   "cluster",
+	"source":    "source",
+	"namespace": "namespace",
+	"policy":    "policy",
+	"status":    "result",
+	"severity":  "severity",
+}
+
+// CreateTrendSnapshot records the current aggregated result counts for the given snapshot time,
+// an existing snapshot of the same time is replaced
+func (s *Store) CreateTrendSnapshot(ctx context.Context, created time.Time) error {
+	trends := make([]*Trend, 0)
+
+	err := s.db.NewSelect().
+		Model((*PolicyReportFilter)(nil)).
+		ColumnExpr("f.cluster, f.source, f.resource_namespace AS namespace, f.policy, f.result, f.severity, SUM(f.count) AS count").
+		Group("f.cluster", "f.source", "f.resource_namespace", "f.policy", "f.result", "f.severity").
+		Scan(ctx, &trends)
+	if err != nil {
+		return err
+	}
+
+	for _, t := range trends {
+		t.Created = created.Unix()
+	}
+
+	return s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		_, err := tx.NewDelete().Model((*Trend)(nil)).Where("created = ?", created.Unix()).Exec(ctx)
+		if err != nil {
+			errorMetric.With(prometheus.Labels{"operation": "DELETE", "table": "policy_report_trend", "reason": mapReason(err)}).Inc()
+			return err
+		}
+
+		for _, list := range chunkSlice(trends, 50) {
+			_, err = tx.NewInsert().Model(&list).Exec(ctx)
+			if err != nil {
+				errorMetric.With(prometheus.Labels{"operation": "INSERT", "table": "policy_report_trend", "reason": mapReason(err)}).Inc()
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// CleanUpTrends removes all snapshots created before the given time
+func (s *Store) CleanUpTrends(ctx context.Context, before time.Time) error {
+	_, err := s.db.NewDelete().Model((*Trend)(nil)).Where("created < ?", before.Unix()).Exec(ctx)
+	if err != nil {
+		errorMetric.With(prometheus.Labels{"operation": "DELETE", "table": "policy_report_trend", "reason": mapReason(err)}).Inc()
+	}
+
+	return err
+}
+
+// FetchTrends returns the summed counts per snapshot time and group between from and to
+func (s *Store) FetchTrends(ctx context.Context, group string, filter Filter, from, to time.Time) ([]TrendCount, error) {
+	column, ok := TrendGroups[group]
+	if !ok {
+		return nil, fmt.Errorf("unsupported trend group: %s", group)
+	}
+
+	results := make([]TrendCount, 0)
+
+	err := FromQuery(s.read().NewSelect().Model((*Trend)(nil))).
+		ColumnExpr(fmt.Sprintf("t.created, t.%s AS name, SUM(t.count) AS count", column)).
+		FilterMap(map[string][]string{
+			"t.cluster":   filter.Clusters,
+			"t.source":    filter.Sources,
+			"t.namespace": filter.Namespaces,
+			"t.policy":    filter.Policies,
+			"t.result":    filter.Status,
+			"t.severity":  filter.Severities,
+		}).
+		Group("t.created", "t."+column).
+		Order("t.created ASC", "name ASC").
+		GetQuery().
+		Where("t.created BETWEEN ? AND ?", from.Unix(), to.Unix()).
+		Scan(ctx, &results)
+
+	return results, err
+}
+
+// TrendRecorder creates periodic trend snapshots and removes snapshots older than the retention
+type TrendRecorder struct {
+	store      *Store
+	resolution time.Duration
+	retention  time.Duration
+	active     atomic.Bool
+}
+
+// Start recording snapshots, with leader election only the leader should record
+func (r *TrendRecorder) Start() {
+	r.active.Store(true)
+}
+
+// Stop recording snapshots
+func (r *TrendRecorder) Stop() {
+	r.active.Store(false)
+}
+
+// Run records a snapshot after each resolution interval until the context is canceled
+func (r *TrendRecorder) Run(ctx context.Context) error {
+	ticker := time.NewTicker(r.resolution)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case now := <-ticker.C:
+			r.Record(ctx, now)
+		}
+	}
+}
+
+// Record a snapshot for the interval of the given time if the recorder is active
+func (r *TrendRecorder) Record(ctx context.Context, now time.Time) {
+	if !r.active.Load() {
+		return
+	}
+
+	if err := r.store.CreateTrendSnapshot(ctx, now.Truncate(r.resolution)); err != nil {
+		zap.L().Error("failed to create trend snapshot", zap.Error(err))
+		return
+	}
+
+	if r.retention <= 0 {
+		return
+	}
+
+	if err := r.store.CleanUpTrends(ctx, now.Add(-r.retention)); err != nil {
+		zap.L().Error("failed to clean up trend snapshots", zap.Error(err))
+	}
+}
+
+func NewTrendRecorder(store *Store, resolution, retention time.Duration) *TrendRecorder {
+	return &TrendRecorder{
+		store:      store,
+		resolution: resolution,
+		retention:  retention,
+	}
+}
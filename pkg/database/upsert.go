@@ -0,0 +1,214 @@
+package database
+
+import (
+	"context"
+	"maps"
+	"reflect"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/uptrace/bun"
+	"go.uber.org/zap"
+)
+
+// filterCondition matches the filter row with the aggregation key of PolicyReportFilter.Hash
+const filterCondition = "policy_report_id = ? AND cluster = ? AND resource_namespace = ? AND source = ? AND resource_api = ? AND category = ? AND policy = ? AND severity = ? AND result = ?"
+
+func (r *PolicyReportFilter) keyArgs() []any {
+	return []any{r.PolicyReportID, r.Cluster, r.Namespace, r.Source, r.API, r.Category, r.Policy, r.Severity, r.Result}
+}
+
+func (r *ResourceResult) key() string {
+	return strings.Join([]string{r.ID, r.Category, r.PolicyReportID, r.Source}, "/")
+}
+
+// equal compares all persisted values, nil and empty properties are equal
+func (r *PolicyReportResult) equal(o *PolicyReportResult) bool {
+	if !maps.Equal(r.Properties, o.Properties) {
+		return false
+	}
+
+	a, b := *r, *o
+	a.Properties, b.Properties = nil, nil
+
+	return reflect.DeepEqual(a, b)
+}
+
+func insertFilters(ctx context.Context, db bun.IDB, filters []*PolicyReportFilter) error {
+	for _, list := range chunkSlice(filters, 50) {
+		_, err := db.NewInsert().Ignore().Model(&list).Exec(ctx)
+		if err != nil {
+			zap.L().Error("failed to bulk import policy report filter", zap.Error(err))
+			errorMetric.With(prometheus.Labels{"operation": "INSERT", "table": "policy_report_filter", "reason": mapReason(err)}).Inc()
+			return err
+		}
+	}
+
+	return nil
+}
+
+func insertResources(ctx context.Context, db bun.IDB, resources []*ResourceResult) error {
+	for _, list := range chunkSlice(resources, 50) {
+		_, err := db.NewInsert().Model(&list).Exec(ctx)
+		if err != nil {
+			zap.L().Error("failed to bulk import policy report resources", zap.Error(err))
+			errorMetric.With(prometheus.Labels{"operation": "INSERT", "table": "policy_report_resource", "reason": mapReason(err)}).Inc()
+			return err
+		}
+	}
+
+	return nil
+}
+
+func insertResults(ctx context.Context, db bun.IDB, results []*PolicyReportResult) error {
+	for _, list := range chunkSlice(results, 50) {
+		_, err := db.NewInsert().Ignore().Model(&list).Exec(ctx)
+		if err != nil {
+			zap.L().Error("failed to bulk import policy report results", zap.Error(err))
+			errorMetric.With(prometheus.Labels{"operation": "INSERT", "table": "policy_report_result", "reason": mapReason(err)}).Inc()
+			return err
+		}
+	}
+
+	return nil
+}
+
+// updateFilters replaces the stored filter aggregates of the report with the given aggregates, only changed rows are written
+func updateFilters(ctx context.Context, tx bun.Tx, id string, filters []*PolicyReportFilter) error {
+	current := make([]*PolicyReportFilter, 0)
+	if err := tx.NewSelect().Model(&current).Where("policy_report_id = ?", id).Scan(ctx); err != nil {
+		return err
+	}
+
+	existing := make(map[string]*PolicyReportFilter, len(current))
+	for _, f := range current {
+		existing[f.Hash()] = f
+	}
+
+	added := make([]*PolicyReportFilter, 0)
+	for _, f := range filters {
+		prev, ok := existing[f.Hash()]
+		if !ok {
+			added = append(added, f)
+			continue
+		}
+		delete(existing, f.Hash())
+
+		if prev.Count == f.Count && prev.Kind == f.Kind {
+			continue
+		}
+
+		_, err := tx.NewUpdate().
+			Model((*PolicyReportFilter)(nil)).
+			Set("count = ?", f.Count).
+			Set("resource_kind = ?", f.Kind).
+			Where(filterCondition, f.keyArgs()...).
+			Exec(ctx)
+		if err != nil {
+			errorMetric.With(prometheus.Labels{"operation": "UPDATE", "table": "policy_report_filter", "reason": mapReason(err)}).Inc()
+			return err
+		}
+	}
+
+	for _, f := range existing {
+		_, err := tx.NewDelete().Model((*PolicyReportFilter)(nil)).Where(filterCondition, f.keyArgs()...).Exec(ctx)
+		if err != nil {
+			errorMetric.With(prometheus.Labels{"operation": "DELETE", "table": "policy_report_filter", "reason": mapReason(err)}).Inc()
+			return err
+		}
+	}
+
+	return insertFilters(ctx, tx, added)
+}
+
+// updateResources replaces the stored resource aggregates of the report with the given aggregates, only changed rows are written
+func updateResources(ctx context.Context, tx bun.Tx, id string, resources []*ResourceResult) error {
+	current := make([]*ResourceResult, 0)
+	if err := tx.NewSelect().Model(&current).Where("policy_report_id = ?", id).Scan(ctx); err != nil {
+		return err
+	}
+
+	existing := make(map[string]*ResourceResult, len(current))
+	for _, r := range current {
+		existing[r.key()] = r
+	}
+
+	added := make([]*ResourceResult, 0)
+	for _, r := range resources {
+		prev, ok := existing[r.key()]
+		if !ok {
+			added = append(added, r)
+			continue
+		}
+		delete(existing, r.key())
+
+		if *prev == *r {
+			continue
+		}
+
+		if _, err := tx.NewUpdate().Model(r).WherePK().Exec(ctx); err != nil {
+			errorMetric.With(prometheus.Labels{"operation": "UPDATE", "table": "policy_report_resource", "reason": mapReason(err)}).Inc()
+			return err
+		}
+	}
+
+	for _, r := range existing {
+		if _, err := tx.NewDelete().Model(r).WherePK().Exec(ctx); err != nil {
+			errorMetric.With(prometheus.Labels{"operation": "DELETE", "table": "policy_report_resource", "reason": mapReason(err)}).Inc()
+			return err
+		}
+	}
+
+	return insertResources(ctx, tx, added)
+}
+
+// updateResults replaces the stored results of the report with the given results, compared by result ID
+func updateResults(ctx context.Context, tx bun.Tx, id string, results []*PolicyReportResult) error {
+	current := make([]*PolicyReportResult, 0)
+	if err := tx.NewSelect().Model(&current).Where("policy_report_id = ?", id).Scan(ctx); err != nil {
+		return err
+	}
+
+	existing := make(map[string]*PolicyReportResult, len(current))
+	for _, r := range current {
+		existing[r.ID] = r
+	}
+
+	added := make([]*PolicyReportResult, 0)
+	for _, r := range results {
+		prev, ok := existing[r.ID]
+		if !ok {
+			added = append(added, r)
+			continue
+		}
+		delete(existing, r.ID)
+
+		if prev.equal(r) {
+			continue
+		}
+
+		if _, err := tx.NewUpdate().Model(r).WherePK().Exec(ctx); err != nil {
+			errorMetric.With(prometheus.Labels{"operation": "UPDATE", "table": "policy_report_result", "reason": mapReason(err)}).Inc()
+			return err
+		}
+	}
+
+	removed := make([]string, 0, len(existing))
+	for id := range existing {
+		removed = append(removed, id)
+	}
+
+	for _, ids := range chunkSlice(removed, 50) {
+		_, err := tx.NewDelete().
+			Model((*PolicyReportResult)(nil)).
+			Where("policy_report_id = ?", id).
+			Where("id IN (?)", bun.List(ids)).
+			Exec(ctx)
+		if err != nil {
+			errorMetric.With(prometheus.Labels{"operation": "DELETE", "table": "policy_report_result", "reason": mapReason(err)}).Inc()
+			return err
+		}
+	}
+
+	return insertResults(ctx, tx, added)
+}
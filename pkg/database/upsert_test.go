@@ -0,0 +1,175 @@
+package database_test
+
+import (
+	"context"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/openreports/reports-api/apis/openreports.io/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/uptrace/bun"
+
+	"github.com/kyverno/policy-reporter/pkg/database"
+	"github.com/kyverno/policy-reporter/pkg/fixtures"
+	"github.com/kyverno/policy-reporter/pkg/openreports"
+	"github.com/kyverno/policy-reporter/pkg/report/result"
+)
+
+// writeCounter counts the executed write queries per operation and table
+type writeCounter struct {
+	mu     sync.Mutex
+	writes map[string]int
+}
+
+func (c *writeCounter) BeforeQuery(ctx context.Context, _ *bun.QueryEvent) context.Context {
+	return ctx
+}
+
+func (c *writeCounter) AfterQuery(_ context.Context, event *bun.QueryEvent) {
+	op := event.Operation()
+	if event.IQuery == nil || (op != "INSERT" && op != "UPDATE" && op != "DELETE") {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.writes[op+" "+event.IQuery.GetTableName()]++
+}
+
+func (c *writeCounter) Reset() map[string]int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	writes := c.writes
+	c.writes = make(map[string]int)
+
+	return writes
+}
+
+func Test_StoreUpdate(t *testing.T) {
+	ctx := context.Background()
+
+	open := func(name string) *bun.DB {
+		db, err := database.NewSQLiteDB(filepath.Join(t.TempDir(), name))
+		if !assert.Nil(t, err) {
+			t.FailNow()
+		}
+
+		return db
+	}
+
+	db := open("update.db")
+	defer db.Close()
+
+	referenceDB := open("reference.db")
+	defer referenceDB.Close()
+
+	store := seedStore(t, db)
+	reference := seedStore(t, referenceDB)
+
+	counter := &writeCounter{writes: make(map[string]int)}
+	db.AddQueryHook(counter)
+
+	reconditioner := result.NewReconditioner(nil, nil, nil)
+
+	// same report as the labeled Kyverno report of the seed, modified by each step
+	report := fixtures.KyvernoPolicyReport.DeepCopy()
+	report.Labels = map[string]string{"app": "kyverno"}
+
+	nginx3 := report.Results[1].DeepCopy()
+	nginx3.Subjects[0].Name = "nginx3"
+	nginx3.Subjects[0].UID = "dfd57c50-f30c-4729-b63f-b1954d8988d3"
+
+	steps := []struct {
+		name   string
+		modify func(r *v1alpha1.Report)
+		writes map[string]int
+	}{
+		{
+			name:   "unchanged",
+			modify: func(r *v1alpha1.Report) {},
+			writes: map[string]int{"UPDATE policy_report": 1},
+		},
+		{
+			name:   "changed timestamp",
+			modify: func(r *v1alpha1.Report) { r.Results[1].Timestamp.Seconds++ },
+			writes: map[string]int{"UPDATE policy_report": 1, "UPDATE policy_report_result": 1},
+		},
+		{
+			name:   "added result",
+			modify: func(r *v1alpha1.Report) { r.Results = append(r.Results, *nginx3) },
+			writes: map[string]int{"UPDATE policy_report": 1, "UPDATE policy_report_filter": 1, "INSERT policy_report_resource": 1, "INSERT policy_report_result": 1},
+		},
+		{
+			name:   "removed result",
+			modify: func(r *v1alpha1.Report) { r.Results = r.Results[:2] },
+			writes: map[string]int{"UPDATE policy_report": 1, "UPDATE policy_report_filter": 1, "DELETE policy_report_resource": 1, "DELETE policy_report_result": 1},
+		},
+		{
+			name: "changed status",
+			modify: func(r *v1alpha1.Report) {
+				r.Results[0].Result = openreports.StatusFail
+				r.Summary.Pass, r.Summary.Fail = 0, 1
+			},
+			// the result ID includes the status
+			writes: map[string]int{
+				"UPDATE policy_report":          1,
+				"DELETE policy_report_filter":   1,
+				"INSERT policy_report_filter":   1,
+				"UPDATE policy_report_resource": 1,
+				"DELETE policy_report_result":   1,
+				"INSERT policy_report_result":   1,
+			},
+		},
+	}
+
+	cases := newCompatibilityCases("")
+
+	for _, step := range steps {
+		t.Run(step.name, func(t *testing.T) {
+			step.modify(report)
+
+			polr := reconditioner.Prepare(&openreports.ReportAdapter{Report: report.DeepCopy()})
+
+			counter.Reset()
+			assert.Nil(t, store.Update(ctx, polr))
+			writes := counter.Reset()
+
+			if step.writes != nil {
+				assert.Equal(t, step.writes, writes, "only changed rows should be written")
+			}
+
+			assert.Nil(t, reference.Remove(ctx, polr.GetID()))
+			assert.Nil(t, reference.Add(ctx, polr))
+
+			for _, c := range cases {
+				expected, err := c.run(ctx, reference)
+				assert.Nil(t, err)
+
+				value, err := c.run(ctx, store)
+				assert.Nil(t, err)
+
+				assert.JSONEq(t, normalize(t, expected, false), normalize(t, value, false), c.name)
+			}
+		})
+	}
+
+	t.Run("new report", func(t *testing.T) {
+		report := fixtures.KyvernoPolicyReport.DeepCopy()
+		report.Name = "new-report"
+		// result IDs are unique across reports
+		for i := range report.Results {
+			report.Results[i].Description = "new"
+		}
+
+		polr := reconditioner.Prepare(&openreports.ReportAdapter{Report: report})
+		assert.Nil(t, store.Update(ctx, polr))
+
+		stored, err := store.Get(ctx, polr.GetID())
+		if assert.Nil(t, err) {
+			assert.Len(t, stored.GetResults(), len(polr.GetResults()))
+		}
+	})
+}
@@ -4,20 +4,24 @@ import (
 	"context"
 	"sync"
 
+	reportsv1alpha1 "github.com/openreports/reports-api/apis/openreports.io/v1alpha1"
 	"github.com/openreports/reports-api/pkg/client/clientset/versioned/typed/openreports.io/v1alpha1"
 	"go.uber.org/zap"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
-	"github.com/kyverno/policy-reporter/pkg/crd/client/clientset/versioned/typed/policyreport/v1alpha2"
+	"github.com/kyverno/policy-reporter/pkg/crd/api/policyreport/v1alpha2"
+	wgpolicyv1alpha2 "github.com/kyverno/policy-reporter/pkg/crd/client/clientset/versioned/typed/policyreport/v1alpha2"
 	"github.com/kyverno/policy-reporter/pkg/email"
 	"github.com/kyverno/policy-reporter/pkg/openreports"
+	"github.com/kyverno/policy-reporter/pkg/report/result"
 )
 
 type Generator struct {
 	openreportsClient v1alpha1.OpenreportsV1alpha1Interface
-	wgpolicyClient    v1alpha2.Wgpolicyk8sV1alpha2Interface
+	wgpolicyClient    wgpolicyv1alpha2.Wgpolicyk8sV1alpha2Interface
 	filter            email.Filter
 	clusterReports    bool
+	suppressor        *result.Suppressor
 }
 
 func (o *Generator) GenerateData(ctx context.Context) ([]Source, error) {
@@ -71,7 +75,7 @@ func (o *Generator) GenerateData(ctx context.Context) ([]Source, error) {
 				}
 				mx.Unlock()
 
-				s.AddClusterSummary(report)
+				s.addClusterSummary(o.summarize(report))
 
 				zap.L().Info("Processed ClusterPolicyReport", zap.String("name", report.GetName()))
 			}(rep)
@@ -121,7 +125,8 @@ func (o *Generator) GenerateData(ctx context.Context) ([]Source, error) {
 			}
 			mx.Unlock()
 
-			s.AddNamespacedSummary(report.GetNamespace(), report.GetSummary())
+			sum, suppressed := o.summarize(report)
+			s.addNamespacedSummary(report.GetNamespace(), sum, suppressed)
 			zap.L().Info("Processed Report", zap.String("name", report.GetName()))
 		}(rep)
 	}
@@ -136,8 +141,36 @@ func (o *Generator) GenerateData(ctx context.Context) ([]Source, error) {
 	return list, nil
 }
 
-func NewGenerator(orclient v1alpha1.OpenreportsV1alpha1Interface, wgpolicyclient v1alpha2.Wgpolicyk8sV1alpha2Interface, filter email.Filter, clusterReports bool) *Generator {
-	return &Generator{orclient, wgpolicyclient, filter, clusterReports}
+// summarize the report, suppressed results are counted separately instead of their status
+func (o *Generator) summarize(report openreports.ReportInterface) (reportsv1alpha1.ReportSummary, int) {
+	sum := report.GetSummary()
+	if o.suppressor == nil {
+		return sum, 0
+	}
+
+	suppressed := 0
+	for _, res := range report.GetResults() {
+		if !result.IsSuppressed(o.suppressor.Enrich(report, res)) {
+			continue
+		}
+
+		suppressed++
+
+		switch res.Result {
+		case v1alpha2.StatusFail:
+			sum.Fail = max(sum.Fail-1, 0)
+		case v1alpha2.StatusWarn:
+			sum.Warn = max(sum.Warn-1, 0)
+		case v1alpha2.StatusError:
+			sum.Error = max(sum.Error-1, 0)
+		}
+	}
+
+	return sum, suppressed
+}
+
+func NewGenerator(orclient v1alpha1.OpenreportsV1alpha1Interface, wgpolicyclient wgpolicyv1alpha2.Wgpolicyk8sV1alpha2Interface, filter email.Filter, clusterReports bool, suppressor *result.Suppressor) *Generator {
+	return &Generator{orclient, wgpolicyclient, filter, clusterReports, suppressor}
 }
 
 func FilterSources(sources []Source, filter email.Filter, clusterReports bool) []Source {
@@ -4,11 +4,14 @@ import (
 	"context"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/kyverno/policy-reporter/pkg/email"
 	"github.com/kyverno/policy-reporter/pkg/email/summary"
 	"github.com/kyverno/policy-reporter/pkg/fixtures"
+	"github.com/kyverno/policy-reporter/pkg/report/result"
 	"github.com/kyverno/policy-reporter/pkg/validate"
 )
 
@@ -21,7 +24,7 @@ func Test_GenerateDataWithSingleSource(t *testing.T) {
 	_, _ = pClient.Create(ctx, fixtures.DefaultPolicyReport.Report, v1.CreateOptions{})
 	_, _ = cClient.Create(ctx, fixtures.ClusterPolicyReport.ClusterReport, v1.CreateOptions{})
 
-	generator := summary.NewGenerator(client, nil, filter, true)
+	generator := summary.NewGenerator(client, nil, filter, true, nil)
 
 	data, err := generator.GenerateData(ctx)
 	if err != nil {
@@ -44,6 +47,32 @@ func Test_GenerateDataWithSingleSource(t *testing.T) {
 	}
 }
 
+type resourceClient struct {
+	annotations map[string]string
+}
+
+func (c *resourceClient) Metadata(ref *corev1.ObjectReference) (*v1.PartialObjectMetadata, error) {
+	return &v1.PartialObjectMetadata{ObjectMeta: v1.ObjectMeta{Name: ref.Name, Annotations: c.annotations}}, nil
+}
+
+func Test_GenerateDataWithSuppressedResults(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	client, pClient, _ := NewFakeClient()
+
+	_, _ = pClient.Create(ctx, fixtures.DefaultPolicyReport.Report, v1.CreateOptions{})
+
+	suppressor := result.NewSuppressor(&resourceClient{annotations: map[string]string{result.SuppressAnnotation: "required-label"}})
+
+	data, err := summary.NewGenerator(client, nil, filter, false, suppressor).GenerateData(ctx)
+	assert.Nil(t, err)
+	assert.Len(t, data, 1)
+
+	assert.Equal(t, 1, data[0].NamespaceScopeSummary["test"].Fail)
+	assert.Equal(t, 2, data[0].NamespaceScopeSummary["test"].Suppressed)
+}
+
 func Test_GenerateDataWithMultipleSource(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
@@ -58,7 +87,7 @@ func Test_GenerateDataWithMultipleSource(t *testing.T) {
 	_, _ = cClient.Create(ctx, fixtures.EmptyClusterPolicyReport, v1.CreateOptions{})
 	_, _ = cClient.Create(ctx, fixtures.KyvernoClusterPolicyReport, v1.CreateOptions{})
 
-	generator := summary.NewGenerator(client, nil, filter, true)
+	generator := summary.NewGenerator(client, nil, filter, true, nil)
 
 	data, err := generator.GenerateData(ctx)
 	if err != nil {
@@ -84,7 +113,7 @@ func Test_GenerateDataWithSourceFilter(t *testing.T) {
 	_, _ = cClient.Create(ctx, fixtures.EmptyClusterPolicyReport, v1.CreateOptions{})
 	_, _ = cClient.Create(ctx, fixtures.KyvernoClusterPolicyReport, v1.CreateOptions{})
 
-	generator := summary.NewGenerator(client, nil, email.NewFilter(nil, validate.RuleSets{}, validate.RuleSets{Include: []string{"test"}}), true)
+	generator := summary.NewGenerator(client, nil, email.NewFilter(nil, validate.RuleSets{}, validate.RuleSets{Include: []string{"test"}}), true, nil)
 
 	data, err := generator.GenerateData(ctx)
 	if err != nil {
@@ -110,7 +139,7 @@ func Test_FilterSourcesBySource(t *testing.T) {
 	_, _ = cClient.Create(ctx, fixtures.EmptyClusterPolicyReport, v1.CreateOptions{})
 	_, _ = cClient.Create(ctx, fixtures.KyvernoClusterPolicyReport, v1.CreateOptions{})
 
-	generator := summary.NewGenerator(client, nil, filter, true)
+	generator := summary.NewGenerator(client, nil, filter, true, nil)
 
 	data, err := generator.GenerateData(ctx)
 	if err != nil {
@@ -137,7 +166,7 @@ func Test_FilterSourcesByNamespace(t *testing.T) {
 	_, _ = cClient.Create(ctx, fixtures.EmptyClusterPolicyReport, v1.CreateOptions{})
 	_, _ = cClient.Create(ctx, fixtures.KyvernoClusterPolicyReport, v1.CreateOptions{})
 
-	generator := summary.NewGenerator(client, nil, filter, true)
+	generator := summary.NewGenerator(client, nil, filter, true, nil)
 
 	data, err := generator.GenerateData(ctx)
 	if err != nil {
@@ -169,7 +198,7 @@ func Test_RemoveEmptySource(t *testing.T) {
 	_, _ = cClient.Create(ctx, fixtures.EmptyClusterPolicyReport, v1.CreateOptions{})
 	_, _ = cClient.Create(ctx, fixtures.KyvernoClusterPolicyReport, v1.CreateOptions{})
 
-	generator := summary.NewGenerator(client, nil, filter, true)
+	generator := summary.NewGenerator(client, nil, filter, true, nil)
 
 	data, err := generator.GenerateData(ctx)
 	if err != nil {
@@ -9,11 +9,12 @@ import (
 )
 
 type Summary struct {
-	Skip  int
-	Pass  int
-	Warn  int
-	Fail  int
-	Error int
+	Skip       int
+	Pass       int
+	Warn       int
+	Fail       int
+	Error      int
+	Suppressed int
 }
 
 type Source struct {
@@ -26,14 +27,26 @@ type Source struct {
 }
 
 func (s *Source) AddClusterSummary(rep openreports.ReportInterface) {
-	s.ClusterScopeSummary.Skip += rep.GetSummary().Skip
-	s.ClusterScopeSummary.Pass += rep.GetSummary().Pass
-	s.ClusterScopeSummary.Warn += rep.GetSummary().Warn
-	s.ClusterScopeSummary.Fail += rep.GetSummary().Fail
-	s.ClusterScopeSummary.Error += rep.GetSummary().Error
+	s.addClusterSummary(rep.GetSummary(), 0)
+}
+
+func (s *Source) addClusterSummary(sum reportsv1alpha1.ReportSummary, suppressed int) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	s.ClusterScopeSummary.Skip += sum.Skip
+	s.ClusterScopeSummary.Pass += sum.Pass
+	s.ClusterScopeSummary.Warn += sum.Warn
+	s.ClusterScopeSummary.Fail += sum.Fail
+	s.ClusterScopeSummary.Error += sum.Error
+	s.ClusterScopeSummary.Suppressed += suppressed
 }
 
 func (s *Source) AddNamespacedSummary(ns string, sum reportsv1alpha1.ReportSummary) {
+	s.addNamespacedSummary(ns, sum, 0)
+}
+
+func (s *Source) addNamespacedSummary(ns string, sum reportsv1alpha1.ReportSummary, suppressed int) {
 	s.mx.Lock()
 	defer s.mx.Unlock()
 	if d, ok := s.NamespaceScopeSummary[ns]; ok {
@@ -42,13 +55,15 @@ func (s *Source) AddNamespacedSummary(ns string, sum reportsv1alpha1.ReportSumma
 		d.Warn += sum.Warn
 		d.Fail += sum.Fail
 		d.Error += sum.Error
+		d.Suppressed += suppressed
 	} else {
 		s.NamespaceScopeSummary[ns] = &Summary{
-			Skip:  sum.Skip,
-			Pass:  sum.Pass,
-			Fail:  sum.Fail,
-			Warn:  sum.Warn,
-			Error: sum.Error,
+			Skip:       sum.Skip,
+			Pass:       sum.Pass,
+			Fail:       sum.Fail,
+			Warn:       sum.Warn,
+			Error:      sum.Error,
+			Suppressed: suppressed,
 		}
 	}
 }
@@ -25,7 +25,7 @@ func Test_CreateReport(t *testing.T) {
 	_, _ = cClient.Create(ctx, fixtures.EmptyClusterPolicyReport, v1.CreateOptions{})
 	_, _ = cClient.Create(ctx, fixtures.KyvernoClusterPolicyReport, v1.CreateOptions{})
 
-	generator := summary.NewGenerator(client, nil, filter, true)
+	generator := summary.NewGenerator(client, nil, filter, true, nil)
 	data, err := generator.GenerateData(ctx)
 	if err != nil {
 		t.Fatalf("unexpected error: %s", err)
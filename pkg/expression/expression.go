@@ -0,0 +1,240 @@
+package expression
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	"github.com/openreports/reports-api/apis/openreports.io/v1alpha1"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/kyverno/policy-reporter/pkg/kubernetes/namespaces"
+	"github.com/kyverno/policy-reporter/pkg/openreports"
+)
+
+// Variables available in filter expressions
+const (
+	ResultVariable          = "result"
+	ReportVariable          = "report"
+	ResourceVariable        = "resource"
+	NamespaceLabelsVariable = "namespaceLabels"
+)
+
+// Program is a compiled CEL filter expression
+type Program struct {
+	expression      string
+	program         cel.Program
+	namespaceLabels bool
+	client          namespaces.Client
+}
+
+// Expression returns the source of the compiled program
+func (p *Program) Expression() string {
+	return p.expression
+}
+
+// MatchResult evaluates the expression for a single result of the given report
+func (p *Program) MatchResult(report openreports.ReportInterface, result openreports.ResultAdapter) bool {
+	resource := result.GetResource()
+	if resource == nil {
+		resource = report.GetScope()
+	}
+
+	return p.match(map[string]any{
+		ResultVariable:          resultValues(result),
+		ReportVariable:          reportValues(report),
+		ResourceVariable:        resourceValues(resource),
+		NamespaceLabelsVariable: p.labels(resourceNamespace(resource, report)),
+	})
+}
+
+// MatchReport evaluates a report expression, the resource is the scope of the report
+func (p *Program) MatchReport(report openreports.ReportInterface) bool {
+	return p.match(map[string]any{
+		ReportVariable:          reportValues(report),
+		ResourceVariable:        resourceValues(report.GetScope()),
+		NamespaceLabelsVariable: p.labels(report.GetNamespace()),
+	})
+}
+
+// match treats evaluation errors like missing map keys as no match
+func (p *Program) match(vars map[string]any) bool {
+	out, _, err := p.program.Eval(vars)
+	if err != nil {
+		zap.L().Debug("failed to evaluate filter expression", zap.String("expression", p.expression), zap.Error(err))
+		return false
+	}
+
+	value, ok := out.Value().(bool)
+
+	return ok && value
+}
+
+// labels are only resolved if the expression references them
+func (p *Program) labels(namespace string) map[string]string {
+	if !p.namespaceLabels || p.client == nil || namespace == "" {
+		return map[string]string{}
+	}
+
+	labels, err := p.client.Labels(context.Background(), namespace)
+	if err != nil {
+		zap.L().Error("failed to resolve namespace labels", zap.String("namespace", namespace), zap.Error(err))
+		return map[string]string{}
+	}
+
+	if labels == nil {
+		return map[string]string{}
+	}
+
+	return labels
+}
+
+// Compile a result expression with access to the result, report, resource and namespaceLabels variables
+func Compile(expression string, client namespaces.Client) (*Program, error) {
+	return compile(expression, client, ResultVariable, ReportVariable, ResourceVariable, NamespaceLabelsVariable)
+}
+
+// CompileReport compiles a report expression with access to the report, resource and namespaceLabels variables
+func CompileReport(expression string, client namespaces.Client) (*Program, error) {
+	return compile(expression, client, ReportVariable, ResourceVariable, NamespaceLabelsVariable)
+}
+
+// Validate a result expression, an empty expression is valid
+func Validate(expression string) error {
+	if expression == "" {
+		return nil
+	}
+
+	_, err := Compile(expression, nil)
+
+	return err
+}
+
+func compile(expression string, client namespaces.Client, variables ...string) (*Program, error) {
+	options := []cel.EnvOption{
+		cel.OptionalTypes(),
+		cel.Function("severity",
+			cel.Overload("severity_string", []*cel.Type{cel.StringType}, cel.IntType, cel.UnaryBinding(severityLevel)),
+		),
+	}
+
+	for _, v := range variables {
+		if v == NamespaceLabelsVariable {
+			options = append(options, cel.Variable(v, cel.MapType(cel.StringType, cel.StringType)))
+			continue
+		}
+
+		options = append(options, cel.Variable(v, cel.MapType(cel.StringType, cel.DynType)))
+	}
+
+	env, err := cel.NewEnv(options...)
+	if err != nil {
+		return nil, err
+	}
+
+	ast, issues := env.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, fmt.Errorf("invalid cel expression: %w", issues.Err())
+	}
+
+	if ast.OutputType() != cel.BoolType {
+		return nil, fmt.Errorf("invalid cel expression: must return a bool, got %s", ast.OutputType())
+	}
+
+	program, err := env.Program(ast)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cel expression: %w", err)
+	}
+
+	namespaceLabels := false
+	for _, reference := range ast.NativeRep().ReferenceMap() {
+		if reference.Name == NamespaceLabelsVariable {
+			namespaceLabels = true
+		}
+	}
+
+	return &Program{
+		expression:      expression,
+		program:         program,
+		namespaceLabels: namespaceLabels,
+		client:          client,
+	}, nil
+}
+
+// severityLevel maps severities to comparable integers: severity(result.severity) >= severity('high')
+func severityLevel(value ref.Val) ref.Val {
+	severity, ok := value.(types.String)
+	if !ok {
+		return types.MaybeNoSuchOverloadErr(value)
+	}
+
+	level, ok := openreports.SeverityLevel[v1alpha1.ResultSeverity(severity)]
+	if !ok {
+		return types.Int(-1)
+	}
+
+	return types.Int(level)
+}
+
+func resultValues(result openreports.ResultAdapter) map[string]any {
+	properties := result.Properties
+	if properties == nil {
+		properties = map[string]string{}
+	}
+
+	return map[string]any{
+		"id":         result.GetID(),
+		"policy":     result.Policy,
+		"rule":       result.Rule,
+		"message":    result.Description,
+		"status":     string(result.Result),
+		"severity":   string(result.Severity),
+		"category":   result.Category,
+		"source":     result.Source,
+		"scored":     result.Scored,
+		"properties": properties,
+	}
+}
+
+func reportValues(report openreports.ReportInterface) map[string]any {
+	return map[string]any{
+		"name":        report.GetName(),
+		"namespace":   report.GetNamespace(),
+		"source":      report.GetSource(),
+		"labels":      stringMap(report.GetLabels()),
+		"annotations": stringMap(report.GetAnnotations()),
+	}
+}
+
+func resourceValues(resource *corev1.ObjectReference) map[string]any {
+	if resource == nil {
+		resource = &corev1.ObjectReference{}
+	}
+
+	return map[string]any{
+		"apiVersion": resource.APIVersion,
+		"kind":       resource.Kind,
+		"name":       resource.Name,
+		"namespace":  resource.Namespace,
+		"uid":        string(resource.UID),
+	}
+}
+
+func resourceNamespace(resource *corev1.ObjectReference, report openreports.ReportInterface) string {
+	if resource != nil && resource.Namespace != "" {
+		return resource.Namespace
+	}
+
+	return report.GetNamespace()
+}
+
+func stringMap(m map[string]string) map[string]string {
+	if m == nil {
+		return map[string]string{}
+	}
+
+	return m
+}
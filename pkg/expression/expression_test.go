@@ -0,0 +1,132 @@
+package expression_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kyverno/policy-reporter/pkg/expression"
+	"github.com/kyverno/policy-reporter/pkg/fixtures"
+)
+
+type nsClient struct {
+	labels map[string]map[string]string
+	calls  int
+}
+
+func (c *nsClient) List(_ context.Context, _ map[string]string) ([]string, error) {
+	return nil, nil
+}
+
+func (c *nsClient) Labels(_ context.Context, name string) (map[string]string, error) {
+	c.calls++
+
+	labels, ok := c.labels[name]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+
+	return labels, nil
+}
+
+func (c *nsClient) Annotations(_ context.Context, _ string) (map[string]string, error) {
+	return nil, nil
+}
+
+func Test_Compile(t *testing.T) {
+	t.Parallel()
+	t.Run("valid expression", func(t *testing.T) {
+		t.Parallel()
+		program, err := expression.Compile("severity(result.severity) >= severity('high')", nil)
+
+		assert.Nil(t, err)
+		assert.Equal(t, "severity(result.severity) >= severity('high')", program.Expression())
+	})
+	t.Run("syntax error", func(t *testing.T) {
+		t.Parallel()
+		_, err := expression.Compile("result.severity ==", nil)
+
+		assert.ErrorContains(t, err, "invalid cel expression")
+	})
+	t.Run("undeclared variable", func(t *testing.T) {
+		t.Parallel()
+		_, err := expression.Compile("pod.kind == 'Job'", nil)
+
+		assert.NotNil(t, err)
+	})
+	t.Run("non bool expression", func(t *testing.T) {
+		t.Parallel()
+		_, err := expression.Compile("result.policy", nil)
+
+		assert.ErrorContains(t, err, "must return a bool")
+	})
+	t.Run("result is not available in report expressions", func(t *testing.T) {
+		t.Parallel()
+		_, err := expression.CompileReport("result.status == 'fail'", nil)
+
+		assert.NotNil(t, err)
+	})
+	t.Run("validate empty expression", func(t *testing.T) {
+		t.Parallel()
+		assert.Nil(t, expression.Validate(""))
+		assert.NotNil(t, expression.Validate("result.severity =="))
+	})
+}
+
+func Test_MatchResult(t *testing.T) {
+	t.Parallel()
+	t.Run("match result, report and resource variables", func(t *testing.T) {
+		t.Parallel()
+		program, err := expression.Compile("result.status == 'fail' && report.namespace == 'test' && resource.kind == 'Deployment'", nil)
+		assert.Nil(t, err)
+
+		assert.True(t, program.MatchResult(fixtures.DefaultPolicyReport, fixtures.FailResult))
+		assert.False(t, program.MatchResult(fixtures.DefaultPolicyReport, fixtures.PassResult))
+	})
+	t.Run("compare severities", func(t *testing.T) {
+		t.Parallel()
+		program, err := expression.Compile("severity(result.severity) >= severity('high')", nil)
+		assert.Nil(t, err)
+
+		assert.True(t, program.MatchResult(fixtures.DefaultPolicyReport, fixtures.FailResult))
+		assert.False(t, program.MatchResult(fixtures.DefaultPolicyReport, fixtures.PassNamespaceResult))
+	})
+	t.Run("resolve namespace labels", func(t *testing.T) {
+		t.Parallel()
+		client := &nsClient{labels: map[string]map[string]string{"test": {"team": "payments"}}}
+
+		program, err := expression.Compile("namespaceLabels.?team.orValue('') == 'payments' && resource.kind != 'Job'", client)
+		assert.Nil(t, err)
+
+		assert.True(t, program.MatchResult(fixtures.DefaultPolicyReport, fixtures.FailResult))
+		assert.Equal(t, 1, client.calls)
+	})
+	t.Run("skip namespace lookup if not referenced", func(t *testing.T) {
+		t.Parallel()
+		client := &nsClient{}
+
+		program, err := expression.Compile("result.policy != ''", client)
+		assert.Nil(t, err)
+
+		assert.True(t, program.MatchResult(fixtures.DefaultPolicyReport, fixtures.FailResult))
+		assert.Equal(t, 0, client.calls)
+	})
+	t.Run("evaluation errors do not match", func(t *testing.T) {
+		t.Parallel()
+		program, err := expression.Compile("namespaceLabels.team == 'payments'", &nsClient{})
+		assert.Nil(t, err)
+
+		assert.False(t, program.MatchResult(fixtures.DefaultPolicyReport, fixtures.FailResult))
+	})
+}
+
+func Test_MatchReport(t *testing.T) {
+	t.Parallel()
+	program, err := expression.CompileReport("report.namespace == 'test' && report.name.startsWith('policy')", nil)
+	assert.Nil(t, err)
+
+	assert.True(t, program.MatchReport(fixtures.DefaultPolicyReport))
+	assert.False(t, program.MatchReport(fixtures.ClusterPolicyReport))
+}
@@ -22,4 +22,15 @@ type Filter struct {
 	Sources ValueFilter `mapstructure:"sources" json:"sources"`
 	// +optional
 	ReportLabels ValueFilter `mapstructure:"reportLabels" json:"reportLabels"`
+	// +optional
+	Kinds ValueFilter `mapstructure:"kinds" json:"kinds"`
+	// Labels of the affected resource in the format "key:value", the value supports wildcards
+	// +optional
+	ResourceLabels ValueFilter `mapstructure:"resourceLabels" json:"resourceLabels"`
+	// Annotations of the affected resource in the format "key:value", the value supports wildcards
+	// +optional
+	ResourceAnnotations ValueFilter `mapstructure:"resourceAnnotations" json:"resourceAnnotations"`
+	// CEL expression evaluated for each result with the result, report, resource and namespaceLabels variables
+	// +optional
+	CEL string `mapstructure:"cel" json:"cel"`
 }
@@ -30,6 +30,9 @@ func (in *Filter) DeepCopyInto(out *Filter) {
 	in.Policies.DeepCopyInto(&out.Policies)
 	in.Sources.DeepCopyInto(&out.Sources)
 	in.ReportLabels.DeepCopyInto(&out.ReportLabels)
+	in.Kinds.DeepCopyInto(&out.Kinds)
+	in.ResourceLabels.DeepCopyInto(&out.ResourceLabels)
+	in.ResourceAnnotations.DeepCopyInto(&out.ResourceAnnotations)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Filter.
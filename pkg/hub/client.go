@@ -0,0 +1,83 @@
+package hub
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"go.uber.org/zap"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/kyverno/policy-reporter/pkg/report"
+	phttp "github.com/kyverno/policy-reporter/pkg/target/http"
+)
+
+// Client pushes the reports of an agent to the ingest API of the hub
+type Client struct {
+	url     string
+	token   string
+	cluster string
+	client  *http.Client
+}
+
+// Send pushes the lifecycle event to the hub, failed requests are retried with backoff
+func (c *Client) Send(ctx context.Context, event report.LifecycleEvent) error {
+	return c.push(ctx, NewEvent(c.cluster, event))
+}
+
+// Reset removes all reports of the agent cluster from the hub
+func (c *Client) Reset(ctx context.Context) error {
+	return c.push(ctx, Event{Type: Reset, Cluster: c.cluster})
+}
+
+func (c *Client) push(ctx context.Context, event Event) error {
+	return retry.OnError(retry.DefaultBackoff, func(err error) bool {
+		_, permanent := err.(*statusError)
+		return !permanent && ctx.Err() == nil
+	}, func() error {
+		req, err := phttp.CreateJSONRequest(http.MethodPost, c.url, event)
+		if err != nil {
+			return err
+		}
+
+		req = req.WithContext(ctx)
+		req.Header.Set("Authorization", "Bearer "+c.token)
+
+		resp, err := c.client.Do(req)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+
+		switch {
+		case resp.StatusCode >= 500:
+			return fmt.Errorf("hub responded with status %d", resp.StatusCode)
+		case resp.StatusCode >= 400:
+			return &statusError{code: resp.StatusCode}
+		}
+
+		return nil
+	})
+}
+
+// statusError is a client error response of the hub, these requests are not retried
+type statusError struct {
+	code int
+}
+
+func (e *statusError) Error() string {
+	return fmt.Sprintf("hub rejected event with status %d", e.code)
+}
+
+// NewClient creates a new agent client for the hub, host is the base URL of the hub API
+func NewClient(host, token, cluster string, client *http.Client) *Client {
+	zap.L().Debug("create hub client", zap.String("host", host), zap.String("cluster", cluster))
+
+	return &Client{
+		url:     strings.TrimSuffix(host, "/") + "/hub/ingest",
+		token:   token,
+		cluster: cluster,
+		client:  client,
+	}
+}
@@ -0,0 +1,44 @@
+package hub_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kyverno/policy-reporter/pkg/fixtures"
+	"github.com/kyverno/policy-reporter/pkg/hub"
+	"github.com/kyverno/policy-reporter/pkg/openreports"
+	"github.com/kyverno/policy-reporter/pkg/report"
+)
+
+func Test_Client(t *testing.T) {
+	events := []report.LifecycleEvent{}
+	store := &clusterStore{}
+
+	server := httptest.NewServer(http.HandlerFunc(newServer(&events, store).Serve))
+	defer server.Close()
+
+	polr := &openreports.ClusterReportAdapter{ClusterReport: fixtures.KyvernoClusterPolicyReport.DeepCopy()}
+	openreports.SetCluster(polr, "cluster-a")
+
+	t.Run("send events", func(t *testing.T) {
+		client := hub.NewClient(server.URL+"/", "token-a", "cluster-a", server.Client())
+
+		assert.Nil(t, client.Reset(context.Background()))
+		assert.Nil(t, client.Send(context.Background(), report.LifecycleEvent{Type: report.Deleted, PolicyReport: polr}))
+
+		assert.Equal(t, []string{"cluster-a"}, store.removed)
+		if assert.Len(t, events, 1) {
+			assert.Equal(t, report.Deleted, events[0].Type)
+			assert.Equal(t, polr.GetID(), events[0].PolicyReport.GetID())
+		}
+	})
+	t.Run("rejected events are not retried", func(t *testing.T) {
+		client := hub.NewClient(server.URL, "token-b", "cluster-a", server.Client())
+
+		assert.NotNil(t, client.Reset(context.Background()))
+	})
+}
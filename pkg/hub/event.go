@@ -0,0 +1,76 @@
+package hub
+
+import (
+	"errors"
+
+	"github.com/openreports/reports-api/apis/openreports.io/v1alpha1"
+
+	"github.com/kyverno/policy-reporter/pkg/openreports"
+	"github.com/kyverno/policy-reporter/pkg/report"
+)
+
+// Reset is sent by an agent on startup, the hub removes all reports of the cluster before the agent resynchronizes
+const Reset = "reset"
+
+// Event is the payload an agent pushes to the ingest API of the hub
+type Event struct {
+	Type          string                      `json:"type"`
+	Cluster       string                      `json:"cluster"`
+	Report        *v1alpha1.Report            `json:"report,omitempty"`
+	ClusterReport *v1alpha1.ClusterReport     `json:"clusterReport,omitempty"`
+	Results       []openreports.ResultAdapter `json:"results,omitempty"`
+}
+
+// NewEvent maps a lifecycle event of an agent, the results are sent with their already generated IDs
+func NewEvent(cluster string, event report.LifecycleEvent) Event {
+	e := Event{
+		Type:    event.Type.String(),
+		Cluster: cluster,
+		Results: event.PolicyReport.GetResults(),
+	}
+
+	switch r := event.PolicyReport.(type) {
+	case *openreports.ReportAdapter:
+		e.Report = r.Report
+	case *openreports.ClusterReportAdapter:
+		e.ClusterReport = r.ClusterReport
+	}
+
+	return e
+}
+
+// LifecycleEvent maps the event back to a lifecycle event of the given cluster.
+// Result IDs are primary keys of the hub database, they are scoped to the cluster to not overwrite results of other clusters.
+func (e Event) LifecycleEvent() (report.LifecycleEvent, error) {
+	event := report.LifecycleEvent{}
+
+	switch e.Type {
+	case report.Added.String():
+		event.Type = report.Added
+	case report.Updated.String():
+		event.Type = report.Updated
+	case report.Deleted.String():
+		event.Type = report.Deleted
+	default:
+		return event, errors.New("unknown event type: " + e.Type)
+	}
+
+	results := make([]openreports.ResultAdapter, 0, len(e.Results))
+	for _, r := range e.Results {
+		r.ID = openreports.ClusterID(e.Cluster, r.ID)
+		results = append(results, r)
+	}
+
+	switch {
+	case e.Report != nil:
+		event.PolicyReport = &openreports.ReportAdapter{Report: e.Report, Results: results}
+	case e.ClusterReport != nil:
+		event.PolicyReport = &openreports.ClusterReportAdapter{ClusterReport: e.ClusterReport, Results: results}
+	default:
+		return event, errors.New("event without report")
+	}
+
+	openreports.SetCluster(event.PolicyReport, e.Cluster)
+
+	return event, nil
+}
@@ -0,0 +1,113 @@
+package hub
+
+import (
+	"context"
+	"crypto/subtle"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/kyverno/policy-reporter/pkg/api"
+	"github.com/kyverno/policy-reporter/pkg/report"
+)
+
+// ClusterStore removes all reports of a cluster
+type ClusterStore interface {
+	RemoveCluster(ctx context.Context, cluster string) error
+}
+
+// Token authenticates the agent of a single cluster
+type Token struct {
+	Cluster string
+	Token   string
+}
+
+// Handler is the ingest API of the hub, agents push their report lifecycle events with a cluster scoped bearer token.
+// Events are published to all listeners of the hub, they are only accepted while the persisting listener is registered.
+type Handler struct {
+	tokens    []Token
+	publisher report.EventPublisher
+	listener  string
+	store     ClusterStore
+}
+
+func (h *Handler) Register(engine *gin.RouterGroup) error {
+	engine.POST("ingest", h.Ingest)
+
+	return nil
+}
+
+func (h *Handler) Ingest(ctx *gin.Context) {
+	cluster, ok := h.authenticate(ctx.GetHeader("Authorization"))
+	if !ok {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+
+	event := Event{}
+	if err := ctx.ShouldBindJSON(&event); err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	if event.Cluster != cluster {
+		zap.L().Warn("hub event rejected, cluster does not match token", zap.String("cluster", event.Cluster), zap.String("token", cluster))
+		ctx.AbortWithStatus(http.StatusForbidden)
+		return
+	}
+
+	if event.Type == Reset {
+		if err := h.store.RemoveCluster(ctx, cluster); err != nil {
+			api.SendResponse(ctx, nil, "failed to reset cluster", err)
+			return
+		}
+
+		ctx.Status(http.StatusOK)
+		return
+	}
+
+	lifecycle, err := event.LifecycleEvent()
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	// only the leader persists reports, agents retry server errors
+	if _, ok := h.publisher.GetListener()[h.listener]; !ok {
+		ctx.AbortWithStatus(http.StatusServiceUnavailable)
+		return
+	}
+
+	h.publisher.Publish(lifecycle)
+
+	ctx.Status(http.StatusOK)
+}
+
+func (h *Handler) authenticate(header string) (string, bool) {
+	token, ok := strings.CutPrefix(header, "Bearer ")
+	if !ok || token == "" {
+		return "", false
+	}
+
+	for _, t := range h.tokens {
+		if subtle.ConstantTimeCompare([]byte(t.Token), []byte(token)) == 1 {
+			return t.Cluster, true
+		}
+	}
+
+	return "", false
+}
+
+// NewHandler creates the ingest API, events are accepted while the given listener is registered at the publisher
+func NewHandler(tokens []Token, publisher report.EventPublisher, listener string, store ClusterStore) *Handler {
+	return &Handler{tokens: tokens, publisher: publisher, listener: listener, store: store}
+}
+
+// WithIngest registers the ingest API, requests are authenticated by the cluster tokens instead of the server middleware
+func WithIngest(tokens []Token, publisher report.EventPublisher, listener string, store ClusterStore) api.ServerOption {
+	return func(s *api.Server) error {
+		return s.RegisterPublic("hub", NewHandler(tokens, publisher, listener, store))
+	}
+}
@@ -0,0 +1,149 @@
+package hub_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kyverno/policy-reporter/pkg/api"
+	"github.com/kyverno/policy-reporter/pkg/fixtures"
+	"github.com/kyverno/policy-reporter/pkg/hub"
+	"github.com/kyverno/policy-reporter/pkg/openreports"
+	"github.com/kyverno/policy-reporter/pkg/report"
+)
+
+type clusterStore struct {
+	removed []string
+}
+
+func (s *clusterStore) RemoveCluster(_ context.Context, cluster string) error {
+	s.removed = append(s.removed, cluster)
+	return nil
+}
+
+var tokens = []hub.Token{{Cluster: "cluster-a", Token: "token-a"}, {Cluster: "cluster-b", Token: "token-b"}}
+
+func newServer(events *[]report.LifecycleEvent, store hub.ClusterStore) *api.Server {
+	gin.SetMode(gin.ReleaseMode)
+
+	publisher := report.NewEventPublisher()
+	publisher.RegisterListener("store", func(_ context.Context, e report.LifecycleEvent) {
+		*events = append(*events, e)
+	})
+
+	return api.NewServer(gin.New(), hub.WithIngest(tokens, publisher, "store", store))
+}
+
+func newRequest(token string, event hub.Event) *http.Request {
+	body := new(bytes.Buffer)
+	json.NewEncoder(body).Encode(event)
+
+	req, _ := http.NewRequest("POST", "/hub/ingest", body)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	return req
+}
+
+func Test_Ingest(t *testing.T) {
+	polr := &openreports.ReportAdapter{Report: fixtures.KyvernoPolicyReport.DeepCopy()}
+	openreports.SetCluster(polr, "cluster-a")
+
+	event := hub.NewEvent("cluster-a", report.LifecycleEvent{Type: report.Added, PolicyReport: polr})
+
+	t.Run("missing token", func(t *testing.T) {
+		events := []report.LifecycleEvent{}
+		w := httptest.NewRecorder()
+
+		newServer(&events, &clusterStore{}).Serve(w, newRequest("", event))
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		assert.Empty(t, events)
+	})
+	t.Run("unknown token", func(t *testing.T) {
+		events := []report.LifecycleEvent{}
+		w := httptest.NewRecorder()
+
+		newServer(&events, &clusterStore{}).Serve(w, newRequest("invalid", event))
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		assert.Empty(t, events)
+	})
+	t.Run("token of another cluster", func(t *testing.T) {
+		events := []report.LifecycleEvent{}
+		w := httptest.NewRecorder()
+
+		newServer(&events, &clusterStore{}).Serve(w, newRequest("token-b", event))
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+		assert.Empty(t, events)
+	})
+	t.Run("invalid event", func(t *testing.T) {
+		events := []report.LifecycleEvent{}
+		w := httptest.NewRecorder()
+
+		newServer(&events, &clusterStore{}).Serve(w, newRequest("token-a", hub.Event{Type: "add", Cluster: "cluster-a"}))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Empty(t, events)
+	})
+	t.Run("add report", func(t *testing.T) {
+		events := []report.LifecycleEvent{}
+		w := httptest.NewRecorder()
+
+		newServer(&events, &clusterStore{}).Serve(w, newRequest("token-a", event))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		if assert.Len(t, events, 1) {
+			assert.Equal(t, report.Added, events[0].Type)
+			assert.Equal(t, polr.GetID(), events[0].PolicyReport.GetID())
+			assert.Equal(t, "cluster-a", openreports.Cluster(events[0].PolicyReport))
+			assert.Equal(t, len(polr.GetResults()), len(events[0].PolicyReport.GetResults()))
+		}
+	})
+	t.Run("scope result IDs to the token cluster", func(t *testing.T) {
+		events := []report.LifecycleEvent{}
+		w := httptest.NewRecorder()
+
+		// cluster-b sends the result IDs of cluster-a
+		forged := hub.NewEvent("cluster-b", report.LifecycleEvent{Type: report.Added, PolicyReport: polr})
+
+		server := newServer(&events, &clusterStore{})
+		server.Serve(w, newRequest("token-a", event))
+		server.Serve(httptest.NewRecorder(), newRequest("token-b", forged))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		if assert.Len(t, events, 2) {
+			for i, result := range events[0].PolicyReport.GetResults() {
+				assert.Equal(t, openreports.ClusterID("cluster-a", polr.GetResults()[i].GetID()), result.GetID())
+				assert.NotEqual(t, result.GetID(), events[1].PolicyReport.GetResults()[i].GetID())
+			}
+			assert.NotEqual(t, events[0].PolicyReport.GetID(), events[1].PolicyReport.GetID())
+		}
+	})
+	t.Run("no persisting listener", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		api.NewServer(gin.New(), hub.WithIngest(tokens, report.NewEventPublisher(), "store", &clusterStore{})).Serve(w, newRequest("token-a", event))
+
+		assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	})
+	t.Run("reset cluster", func(t *testing.T) {
+		events := []report.LifecycleEvent{}
+		store := &clusterStore{}
+		w := httptest.NewRecorder()
+
+		newServer(&events, store).Serve(w, newRequest("token-b", hub.Event{Type: hub.Reset, Cluster: "cluster-b"}))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, []string{"cluster-b"}, store.removed)
+		assert.Empty(t, events)
+	})
+}
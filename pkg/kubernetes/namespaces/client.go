@@ -3,6 +3,7 @@ package namespaces
 import (
 	"context"
 	"strings"
+	"time"
 
 	"go.uber.org/zap"
 	corev1 "k8s.io/api/core/v1"
@@ -18,11 +19,14 @@ import (
 
 type Client interface {
 	List(context.Context, map[string]string) ([]string, error)
+	Labels(context.Context, string) (map[string]string, error)
+	Annotations(context.Context, string) (map[string]string, error)
 }
 
 type k8sClient struct {
-	client v1.NamespaceInterface
-	cache  *gocache.Cache[string, []string]
+	client   v1.NamespaceInterface
+	cache    *gocache.Cache[string, []string]
+	metadata *gocache.Cache[string, metav1.ObjectMeta]
 }
 
 func (c *k8sClient) List(ctx context.Context, selector map[string]string) ([]string, error) {
@@ -75,9 +79,52 @@ func (c *k8sClient) List(ctx context.Context, selector map[string]string) ([]str
 	return list, nil
 }
 
+// Labels of the given namespace, cached for the same duration as the resolved selectors
+func (c *k8sClient) Labels(ctx context.Context, name string) (map[string]string, error) {
+	meta, err := c.get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return meta.Labels, nil
+}
+
+// Annotations of the given namespace, cached for the same duration as the resolved selectors
+func (c *k8sClient) Annotations(ctx context.Context, name string) (map[string]string, error) {
+	meta, err := c.get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return meta.Annotations, nil
+}
+
+func (c *k8sClient) get(ctx context.Context, name string) (metav1.ObjectMeta, error) {
+	if cached, ok := c.metadata.Get(name); ok {
+		return cached, nil
+	}
+
+	meta, err := retry.Retry(func() (metav1.ObjectMeta, error) {
+		ns, err := c.client.Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return metav1.ObjectMeta{}, err
+		}
+
+		return metav1.ObjectMeta{Labels: ns.Labels, Annotations: ns.Annotations}, nil
+	})
+	if err != nil {
+		return meta, err
+	}
+
+	c.metadata.Set(name, meta)
+
+	return meta, nil
+}
+
 func NewClient(secretClient v1.NamespaceInterface, cache *gocache.Cache[string, []string]) Client {
 	return &k8sClient{
-		client: secretClient,
-		cache:  cache,
+		client:   secretClient,
+		cache:    cache,
+		metadata: gocache.New[string, metav1.ObjectMeta](15*time.Second, 5*time.Second),
 	}
 }
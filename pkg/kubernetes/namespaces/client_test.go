@@ -25,6 +25,9 @@ func newFakeClient() v1.NamespaceInterface {
 					"name":  "default",
 					"exist": "yes",
 				},
+				Annotations: map[string]string{
+					"owner-email": "team-a@example.com",
+				},
 			},
 		},
 		&corev1.Namespace{
@@ -132,4 +135,30 @@ func TestClient(t *testing.T) {
 		assert.NotNil(t, err)
 		assert.Equal(t, "error", err.Error())
 	})
+	t.Run("read namespace labels", func(t *testing.T) {
+		t.Parallel()
+		client := namespaces.NewClient(newFakeClient(), gocache.New[string, []string](gocache.DefaultExpiration, gocache.DefaultExpiration))
+
+		labels, err := client.Labels(context.Background(), "default")
+
+		assert.Nil(t, err)
+		assert.Equal(t, "team-a", labels["team"])
+
+		_, err = client.Labels(context.Background(), "unknown")
+
+		assert.NotNil(t, err)
+	})
+	t.Run("read namespace annotations", func(t *testing.T) {
+		t.Parallel()
+		client := namespaces.NewClient(newFakeClient(), gocache.New[string, []string](gocache.DefaultExpiration, gocache.DefaultExpiration))
+
+		annotations, err := client.Annotations(context.Background(), "default")
+
+		assert.Nil(t, err)
+		assert.Equal(t, "team-a@example.com", annotations["owner-email"])
+
+		_, err = client.Annotations(context.Background(), "unknown")
+
+		assert.NotNil(t, err)
+	})
 }
@@ -37,6 +37,10 @@ func (k *openreportsClient) HasSynced() bool {
 	return k.synced
 }
 
+func (k *openreportsClient) Idle() bool {
+	return k.synced && k.queue.Idle()
+}
+
 func (k *openreportsClient) Stop() {
 	close(k.stopChan)
 }
@@ -42,8 +42,8 @@ func Test_PolicyReportWatcher(t *testing.T) {
 		kubernetes.NewDebouncer(0, publisher),
 		workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[string]()),
 		restClient.OpenreportsV1alpha1(),
-		report.NewSourceFilter(nil, nil, nil, nil, []report.SourceValidation{}),
-		result.NewReconditioner(nil),
+		report.NewSourceFilter(nil, nil, nil, nil, nil, []report.SourceValidation{}),
+		result.NewReconditioner(nil, nil, nil),
 	)
 
 	kclient, rclient, _ := NewFakeMetaClient()
@@ -96,8 +96,8 @@ func Test_ClusterPolicyReportWatcher(t *testing.T) {
 		kubernetes.NewDebouncer(0, publisher),
 		workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[string]()),
 		restClient.OpenreportsV1alpha1(),
-		report.NewSourceFilter(nil, nil, nil, nil, []report.SourceValidation{}),
-		result.NewReconditioner(nil),
+		report.NewSourceFilter(nil, nil, nil, nil, nil, []report.SourceValidation{}),
+		result.NewReconditioner(nil, nil, nil),
 	)
 
 	kclient, _, rclient := NewFakeMetaClient()
@@ -139,8 +139,8 @@ func Test_HasSynced(t *testing.T) {
 		kubernetes.NewDebouncer(0, report.NewEventPublisher()),
 		workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[string]()),
 		restClient.OpenreportsV1alpha1(),
-		report.NewSourceFilter(nil, nil, nil, nil, []report.SourceValidation{}),
-		result.NewReconditioner(nil),
+		report.NewSourceFilter(nil, nil, nil, nil, nil, []report.SourceValidation{}),
+		result.NewReconditioner(nil, nil, nil),
 	)
 
 	kclient, _, _ := NewFakeMetaClient()
@@ -3,6 +3,7 @@ package orclient
 import (
 	"context"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	reportsv1alpha1 "github.com/openreports/reports-api/apis/openreports.io/v1alpha1"
@@ -30,6 +31,7 @@ type ORQueue struct {
 	lock          *sync.Mutex
 	cache         sets.Set[string]
 	filter        *report.SourceFilter
+	active        atomic.Int32
 }
 
 func (q *ORQueue) Add(obj *v1.PartialObjectMetadata) error {
@@ -49,9 +51,19 @@ func (q *ORQueue) Run(workers int, stopCh chan struct{}) {
 		go wait.Until(q.runWorker, time.Second, stopCh)
 	}
 
+	// reports with expired or changed suppressions are processed again
+	if suppressor := q.reconditioner.Suppressor(); suppressor != nil {
+		go suppressor.Run(result.SuppressionInterval, q.queue.Add, stopCh)
+	}
+
 	<-stopCh
 }
 
+// Idle reports whether no report is queued or processed
+func (q *ORQueue) Idle() bool {
+	return q.queue.Len() == 0 && q.active.Load() == 0
+}
+
 func (q *ORQueue) runWorker() {
 	for q.processNextItem() {
 	}
@@ -64,6 +76,9 @@ func (q *ORQueue) processNextItem() bool {
 	}
 	defer q.queue.Done(key)
 
+	q.active.Add(1)
+	defer q.active.Add(-1)
+
 	namespace, name, err := cache.SplitMetaNamespaceKey(key)
 	if err != nil {
 		q.queue.Forget(key)
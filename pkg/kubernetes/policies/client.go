@@ -0,0 +1,68 @@
+package policies
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/metadata"
+	gocache "zgo.at/zcache/v2"
+
+	"github.com/kyverno/policy-reporter/pkg/kubernetes/retry"
+)
+
+var (
+	ClusterPolicies = schema.GroupVersionResource{Group: "kyverno.io", Version: "v1", Resource: "clusterpolicies"}
+	Policies        = schema.GroupVersionResource{Group: "kyverno.io", Version: "v1", Resource: "policies"}
+)
+
+type Client interface {
+	// Annotations of the given policy, an empty namespace resolves a cluster scoped policy
+	Annotations(ctx context.Context, name, namespace string) (map[string]string, error)
+}
+
+type k8sClient struct {
+	client metadata.Interface
+	cache  *gocache.Cache[string, map[string]string]
+}
+
+func (c *k8sClient) Annotations(ctx context.Context, name, namespace string) (map[string]string, error) {
+	key := namespace + "/" + name
+	if cached, ok := c.cache.Get(key); ok {
+		return cached, nil
+	}
+
+	annotations, err := retry.Retry(func() (map[string]string, error) {
+		var resource metadata.ResourceInterface = c.client.Resource(ClusterPolicies)
+		if namespace != "" {
+			resource = c.client.Resource(Policies).Namespace(namespace)
+		}
+
+		policy, err := resource.Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+
+		return policy.Annotations, nil
+	})
+	if errors.IsNotFound(err) || errors.IsForbidden(err) {
+		// cache missing policies and missing permissions to avoid a request per result
+		c.cache.Set(key, map[string]string{})
+
+		return nil, err
+	} else if err != nil {
+		return nil, err
+	}
+
+	c.cache.Set(key, annotations)
+
+	return annotations, nil
+}
+
+func NewClient(client metadata.Interface, cache *gocache.Cache[string, map[string]string]) Client {
+	return &k8sClient{
+		client: client,
+		cache:  cache,
+	}
+}
@@ -0,0 +1,95 @@
+package policies_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/metadata/fake"
+	gocache "zgo.at/zcache/v2"
+
+	"github.com/kyverno/policy-reporter/pkg/kubernetes/policies"
+)
+
+func newFakeClient() *fake.FakeMetadataClient {
+	schema := fake.NewTestScheme()
+	metav1.AddMetaToScheme(schema)
+
+	client := fake.NewSimpleMetadataClient(schema)
+
+	client.Resource(policies.ClusterPolicies).(fake.MetadataClient).CreateFake(&metav1.PartialObjectMetadata{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "require-labels",
+			Annotations: map[string]string{"policy-reporter.kyverno.io/related-requirements": "CIS 5.1"},
+		},
+	}, metav1.CreateOptions{})
+
+	client.Resource(policies.Policies).Namespace("test").(fake.MetadataClient).CreateFake(&metav1.PartialObjectMetadata{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "disallow-latest",
+			Namespace:   "test",
+			Annotations: map[string]string{"policies.kyverno.io/category": "Best Practices"},
+		},
+	}, metav1.CreateOptions{})
+
+	return client
+}
+
+func TestClient(t *testing.T) {
+	t.Parallel()
+	t.Run("cluster policy annotations", func(t *testing.T) {
+		t.Parallel()
+		client := policies.NewClient(newFakeClient(), gocache.New[string, map[string]string](gocache.DefaultExpiration, gocache.DefaultExpiration))
+
+		annotations, err := client.Annotations(context.Background(), "require-labels", "")
+
+		assert.Nil(t, err)
+		assert.Equal(t, "CIS 5.1", annotations["policy-reporter.kyverno.io/related-requirements"])
+	})
+	t.Run("namespaced policy annotations", func(t *testing.T) {
+		t.Parallel()
+		client := policies.NewClient(newFakeClient(), gocache.New[string, map[string]string](gocache.DefaultExpiration, gocache.DefaultExpiration))
+
+		annotations, err := client.Annotations(context.Background(), "disallow-latest", "test")
+
+		assert.Nil(t, err)
+		assert.Equal(t, "Best Practices", annotations["policies.kyverno.io/category"])
+	})
+	t.Run("cached annotations", func(t *testing.T) {
+		t.Parallel()
+		cache := gocache.New[string, map[string]string](gocache.DefaultExpiration, gocache.DefaultExpiration)
+		cache.Set("/cached", map[string]string{"key": "value"})
+
+		client := policies.NewClient(newFakeClient(), cache)
+
+		annotations, err := client.Annotations(context.Background(), "cached", "")
+
+		assert.Nil(t, err)
+		assert.Equal(t, "value", annotations["key"])
+	})
+	t.Run("missing policy", func(t *testing.T) {
+		t.Parallel()
+		client := policies.NewClient(newFakeClient(), gocache.New[string, map[string]string](gocache.DefaultExpiration, gocache.DefaultExpiration))
+
+		_, err := client.Annotations(context.Background(), "missing", "")
+
+		assert.NotNil(t, err)
+	})
+	t.Run("cache missing policy", func(t *testing.T) {
+		t.Parallel()
+		cache := gocache.New[string, map[string]string](gocache.DefaultExpiration, gocache.DefaultExpiration)
+		client := policies.NewClient(newFakeClient(), cache)
+
+		_, err := client.Annotations(context.Background(), "missing", "")
+		assert.NotNil(t, err)
+
+		cached, ok := cache.Get("/missing")
+		assert.True(t, ok)
+		assert.Empty(t, cached)
+
+		annotations, err := client.Annotations(context.Background(), "missing", "")
+		assert.Nil(t, err)
+		assert.Empty(t, annotations)
+	})
+}
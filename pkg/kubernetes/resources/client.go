@@ -0,0 +1,194 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/metadata"
+	"k8s.io/client-go/metadata/metadatainformer"
+	"k8s.io/client-go/tools/cache"
+)
+
+// Client resolves the metadata of resources referenced by report results
+type Client interface {
+	Metadata(ref *corev1.ObjectReference) (*metav1.PartialObjectMetadata, error)
+}
+
+type resource struct {
+	informer cache.SharedIndexInformer
+	lister   cache.GenericLister
+
+	mx    sync.Mutex
+	err   error
+	retry time.Time
+}
+
+// fail remembers the error, lookups fail fast until the backoff expired
+func (r *resource) fail(err error, backoff time.Duration) {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	r.err = err
+	r.retry = time.Now().Add(backoff)
+}
+
+func (r *resource) failure() error {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	if time.Now().Before(r.retry) {
+		return r.err
+	}
+
+	return nil
+}
+
+type k8sClient struct {
+	factory   metadatainformer.SharedInformerFactory
+	mapper    meta.RESTMapper
+	resources map[schema.GroupVersionResource]*resource
+	mx        *sync.Mutex
+	stop      <-chan struct{}
+	timeout   time.Duration
+	backoff   time.Duration
+}
+
+// Metadata of the referenced resource, informers are started on the first lookup of each resource type
+func (c *k8sClient) Metadata(ref *corev1.ObjectReference) (*metav1.PartialObjectMetadata, error) {
+	mapping, err := c.mapping(ref)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.resource(mapping.Resource)
+	if err != nil {
+		return nil, err
+	}
+
+	var obj any
+	if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+		obj, err = res.lister.ByNamespace(ref.Namespace).Get(ref.Name)
+	} else {
+		obj, err = res.lister.Get(ref.Name)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	partial, ok := obj.(*metav1.PartialObjectMetadata)
+	if !ok {
+		return nil, fmt.Errorf("unexpected object type %T", obj)
+	}
+
+	return partial, nil
+}
+
+func (c *k8sClient) mapping(ref *corev1.ObjectReference) (*meta.RESTMapping, error) {
+	gv, err := schema.ParseGroupVersion(ref.APIVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	mapping, err := c.mapper.RESTMapping(schema.GroupKind{Group: gv.Group, Kind: ref.Kind}, gv.Version)
+	if err == nil || !meta.IsNoMatchError(err) {
+		return mapping, err
+	}
+
+	// resource types installed after the discovery was cached require a reset
+	resettable, ok := c.mapper.(meta.ResettableRESTMapper)
+	if !ok {
+		return nil, err
+	}
+
+	resettable.Reset()
+
+	return c.mapper.RESTMapping(schema.GroupKind{Group: gv.Group, Kind: ref.Kind}, gv.Version)
+}
+
+func (c *k8sClient) resource(gvr schema.GroupVersionResource) (*resource, error) {
+	res := c.informer(gvr)
+	if res.informer.HasSynced() {
+		return res, nil
+	}
+
+	if err := res.failure(); err != nil {
+		return nil, err
+	}
+
+	// waits outside of the client lock, list errors like missing permissions end the wait early
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+
+	err := wait.PollUntilContextCancel(ctx, 100*time.Millisecond, true, func(context.Context) (bool, error) {
+		if res.informer.HasSynced() {
+			return true, nil
+		}
+
+		return false, res.failure()
+	})
+	if err == nil {
+		return res, nil
+	}
+
+	if wait.Interrupted(err) {
+		err = fmt.Errorf("failed to sync metadata informer for %s", gvr.String())
+		res.fail(err, c.backoff)
+	}
+
+	return nil, err
+}
+
+// informer returns the resource of the given type, the informer is started on the first call
+func (c *k8sClient) informer(gvr schema.GroupVersionResource) *resource {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	if res, ok := c.resources[gvr]; ok {
+		return res
+	}
+
+	informer := c.factory.ForResource(gvr)
+
+	res := &resource{informer: informer.Informer(), lister: informer.Lister()}
+	c.resources[gvr] = res
+
+	err := res.informer.SetWatchErrorHandlerWithContext(func(ctx context.Context, r *cache.Reflector, err error) {
+		cache.DefaultWatchErrorHandler(ctx, r, err)
+
+		if !res.informer.HasSynced() {
+			zap.L().Warn("failed to list resources for metadata lookups", zap.String("resource", gvr.String()), zap.Error(err))
+			res.fail(fmt.Errorf("failed to list %s: %w", gvr.String(), err), c.backoff)
+		}
+	})
+	if err != nil {
+		zap.L().Error("failed to set watch error handler", zap.String("resource", gvr.String()), zap.Error(err))
+	}
+
+	c.factory.Start(c.stop)
+
+	zap.L().Info("started metadata informer", zap.String("resource", gvr.String()))
+
+	return res
+}
+
+// NewClient creates a metadata client backed by shared metadata informers,
+// the informers run until the stop channel is closed
+func NewClient(client metadata.Interface, mapper meta.RESTMapper, stop <-chan struct{}) Client {
+	return &k8sClient{
+		factory:   metadatainformer.NewSharedInformerFactory(client, 15*time.Minute),
+		mapper:    mapper,
+		resources: make(map[schema.GroupVersionResource]*resource),
+		mx:        new(sync.Mutex),
+		stop:      stop,
+		timeout:   30 * time.Second,
+		backoff:   time.Minute,
+	}
+}
@@ -0,0 +1,119 @@
+package resources_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/metadata/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/kyverno/policy-reporter/pkg/kubernetes/resources"
+)
+
+var (
+	deployments = schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
+	namespaces  = schema.GroupVersionResource{Version: "v1", Resource: "namespaces"}
+)
+
+func newFakeClient() *fake.FakeMetadataClient {
+	s := fake.NewTestScheme()
+	metav1.AddMetaToScheme(s)
+
+	client := fake.NewSimpleMetadataClient(s)
+
+	client.Resource(deployments).Namespace("test").(fake.MetadataClient).CreateFake(&metav1.PartialObjectMetadata{
+		TypeMeta: metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "nginx",
+			Namespace:   "test",
+			Labels:      map[string]string{"app": "nginx"},
+			Annotations: map[string]string{"policy-reporter.io/ignore": "true"},
+		},
+	}, metav1.CreateOptions{})
+
+	client.Resource(namespaces).(fake.MetadataClient).CreateFake(&metav1.PartialObjectMetadata{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Namespace"},
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Labels: map[string]string{"team": "payments"}},
+	}, metav1.CreateOptions{})
+
+	return client
+}
+
+func newMapper() meta.RESTMapper {
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}, meta.RESTScopeNamespace)
+	mapper.Add(schema.GroupVersionKind{Version: "v1", Kind: "Namespace"}, meta.RESTScopeRoot)
+	mapper.Add(schema.GroupVersionKind{Version: "v1", Kind: "Secret"}, meta.RESTScopeNamespace)
+
+	return mapper
+}
+
+func Test_Client(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	client := resources.NewClient(newFakeClient(), newMapper(), ctx.Done())
+
+	t.Run("namespaced resource", func(t *testing.T) {
+		obj, err := client.Metadata(&corev1.ObjectReference{APIVersion: "apps/v1", Kind: "Deployment", Name: "nginx", Namespace: "test"})
+
+		assert.Nil(t, err)
+		assert.Equal(t, "nginx", obj.Labels["app"])
+		assert.Equal(t, "true", obj.Annotations["policy-reporter.io/ignore"])
+	})
+	t.Run("cluster scoped resource", func(t *testing.T) {
+		obj, err := client.Metadata(&corev1.ObjectReference{APIVersion: "v1", Kind: "Namespace", Name: "test"})
+
+		assert.Nil(t, err)
+		assert.Equal(t, "payments", obj.Labels["team"])
+	})
+	t.Run("not existing resource", func(t *testing.T) {
+		_, err := client.Metadata(&corev1.ObjectReference{APIVersion: "apps/v1", Kind: "Deployment", Name: "redis", Namespace: "test"})
+
+		assert.NotNil(t, err)
+	})
+	t.Run("unknown kind", func(t *testing.T) {
+		_, err := client.Metadata(&corev1.ObjectReference{APIVersion: "example.com/v1", Kind: "Unknown", Name: "test"})
+
+		assert.True(t, meta.IsNoMatchError(err))
+	})
+}
+
+func Test_ClientForbidden(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	fakeClient := newFakeClient()
+	fakeClient.PrependReactor("list", "secrets", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, kerr.NewForbidden(schema.GroupResource{Resource: "secrets"}, "", nil)
+	})
+
+	client := resources.NewClient(fakeClient, newMapper(), ctx.Done())
+	ref := &corev1.ObjectReference{APIVersion: "v1", Kind: "Secret", Name: "token", Namespace: "test"}
+
+	start := time.Now()
+	_, err := client.Metadata(ref)
+
+	assert.True(t, kerr.IsForbidden(err))
+	assert.Less(t, time.Since(start), 10*time.Second, "missing permissions should end the sync wait")
+
+	start = time.Now()
+	_, err = client.Metadata(ref)
+
+	assert.True(t, kerr.IsForbidden(err))
+	assert.Less(t, time.Since(start), 50*time.Millisecond, "failed resources should fail fast during the backoff")
+
+	obj, err := client.Metadata(&corev1.ObjectReference{APIVersion: "v1", Kind: "Namespace", Name: "test"})
+	assert.Nil(t, err, "other resources should not be affected")
+	assert.Equal(t, "test", obj.Name)
+}
@@ -27,6 +27,7 @@ type Values struct {
 	Credentials     string `json:"credentials,omitempty"`
 	Database        string `json:"database,omitempty"`
 	DSN             string `json:"dsn,omitempty"`
+	Kubeconfig      string `json:"kubeconfig,omitempty"`
 	TypelessAPI     bool   `json:"typelessApi,omitempty"`
 }
 
@@ -84,6 +85,10 @@ func (c *k8sClient) Get(ctx context.Context, name string) (Values, error) {
 		values.DSN = string(dsn)
 	}
 
+	if kubeconfig, ok := secret.Data["kubeconfig"]; ok {
+		values.Kubeconfig = string(kubeconfig)
+	}
+
 	if accessKeyID, ok := secret.Data["accessKeyId"]; ok {
 		values.AccessKeyID = string(accessKeyID)
 	}
@@ -34,6 +34,7 @@ func newFakeClient() v1.SecretInterface {
 			"accountId":       []byte("accountId"),
 			"database":        []byte("database"),
 			"dsn":             []byte("dsn"),
+			"kubeconfig":      []byte("kubeconfig"),
 			"typelessApi":     []byte("false"),
 		},
 	}).CoreV1().Secrets("default")
@@ -98,6 +99,10 @@ func Test_Client(t *testing.T) {
 			t.Errorf("Unexpected DSN: %s", values.DSN)
 		}
 
+		if values.Kubeconfig != "kubeconfig" {
+			t.Errorf("Unexpected Kubeconfig: %s", values.Kubeconfig)
+		}
+
 		if values.TypelessAPI {
 			t.Errorf("Unexpected TypelessAPI: %t", values.TypelessAPI)
 		}
@@ -55,7 +55,7 @@ func Test_SecretInformer(t *testing.T) {
 
 		client, secret := NewFakeMetaClient()
 
-		informer := secrets.NewInformer(client, factory.NewFactory(secrets.NewClient(newFakeClient()), target.NewResultFilterFactory(nil)), "default")
+		informer := secrets.NewInformer(client, factory.NewFactory(secrets.NewClient(newFakeClient()), target.NewResultFilterFactory(nil, nil)), "default")
 
 		err := informer.Sync(collection, stop)
 		assert.Nil(t, err)
@@ -37,6 +37,10 @@ func (k *wgpolicyReportClient) HasSynced() bool {
 	return k.synced
 }
 
+func (k *wgpolicyReportClient) Idle() bool {
+	return k.synced && k.queue.Idle()
+}
+
 func (k *wgpolicyReportClient) Stop() {
 	close(k.stopChan)
 }
@@ -3,6 +3,7 @@ package wgpolicyclient
 import (
 	"context"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	reportsv1alpha1 "github.com/openreports/reports-api/apis/openreports.io/v1alpha1"
@@ -31,6 +32,7 @@ type WGPolicyQueue struct {
 	lock          *sync.Mutex
 	cache         sets.Set[string]
 	filter        *report.SourceFilter
+	active        atomic.Int32
 }
 
 func (q *WGPolicyQueue) Add(obj *v1.PartialObjectMetadata) error {
@@ -50,9 +52,19 @@ func (q *WGPolicyQueue) Run(workers int, stopCh chan struct{}) {
 		go wait.Until(q.runWorker, time.Second, stopCh)
 	}
 
+	// reports with expired or changed suppressions are processed again
+	if suppressor := q.reconditioner.Suppressor(); suppressor != nil {
+		go suppressor.Run(result.SuppressionInterval, q.queue.Add, stopCh)
+	}
+
 	<-stopCh
 }
 
+// Idle reports whether no report is queued or processed
+func (q *WGPolicyQueue) Idle() bool {
+	return q.queue.Len() == 0 && q.active.Load() == 0
+}
+
 func (q *WGPolicyQueue) runWorker() {
 	for q.processNextItem() {
 	}
@@ -65,6 +77,9 @@ func (q *WGPolicyQueue) processNextItem() bool {
 	}
 	defer q.queue.Done(key)
 
+	q.active.Add(1)
+	defer q.active.Add(-1)
+
 	namespace, name, err := cache.SplitMetaNamespaceKey(key)
 	if err != nil {
 		q.queue.Forget(key)
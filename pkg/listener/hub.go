@@ -0,0 +1,24 @@
+package listener
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/kyverno/policy-reporter/pkg/report"
+)
+
+const Hub = "hub_listener"
+
+// HubClient pushes report lifecycle events to a hub instance
+type HubClient interface {
+	Send(ctx context.Context, event report.LifecycleEvent) error
+}
+
+func NewHubListener(client HubClient) report.PolicyReportListener {
+	return func(ctx context.Context, event report.LifecycleEvent) {
+		if err := client.Send(ctx, event); err != nil {
+			zap.L().Error("failed to push policy report to hub", zap.String("name", event.PolicyReport.GetName()), zap.Error(err))
+		}
+	}
+}
@@ -25,7 +25,7 @@ type Cache struct {
 func (c *Cache) AddReport(polr openreports.ReportInterface) {
 	labels := map[string]*CacheItem{}
 	for _, res := range polr.GetResults() {
-		if !c.filter.Validate(res) {
+		if !c.filter.ValidateWithReport(polr, res) {
 			continue
 		}
 
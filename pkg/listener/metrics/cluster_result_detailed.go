@@ -26,7 +26,7 @@ func CreateDetailedClusterResultMetricListener(filter *report.ResultFilter, gaug
 		switch event.Type {
 		case report.Added:
 			for _, result := range newReport.GetResults() {
-				if !filter.Validate(result) {
+				if !filter.ValidateWithReport(newReport, result) {
 					continue
 				}
 
@@ -41,7 +41,7 @@ func CreateDetailedClusterResultMetricListener(filter *report.ResultFilter, gaug
 			}
 
 			for _, result := range newReport.GetResults() {
-				if !filter.Validate(result) {
+				if !filter.ValidateWithReport(newReport, result) {
 					continue
 				}
 
@@ -20,6 +20,9 @@ const (
 )
 
 var LabelGeneratorMapping = map[string]LabelCallback{
+	"cluster": func(m map[string]string, pr openreports.ReportInterface, _ openreports.ResultAdapter) {
+		m["cluster"] = openreports.Cluster(pr)
+	},
 	"namespace": func(m map[string]string, pr openreports.ReportInterface, _ openreports.ResultAdapter) {
 		m["namespace"] = pr.GetNamespace()
 	},
@@ -12,6 +12,10 @@ func Test_LabelMappings(t *testing.T) {
 	results := map[string]string{}
 	res := fixtures.FailPodResult.GetResource()
 
+	metrics.LabelGeneratorMapping["cluster"](results, preport, fixtures.FailPodResult)
+	if val, ok := results["cluster"]; !ok || val != "" {
+		t.Errorf("expected empty cluster label for untagged report: %s", val)
+	}
 	metrics.LabelGeneratorMapping["namespace"](results, preport, fixtures.FailPodResult)
 	if val, ok := results["namespace"]; !ok && val != preport.Namespace {
 		t.Errorf("expected result for namespace label not found: %s", val)
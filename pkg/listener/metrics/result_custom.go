@@ -36,7 +36,7 @@ func CreateCustomResultMetricsListener(
 		switch event.Type {
 		case report.Added:
 			for _, result := range newReport.GetResults() {
-				if !filter.Validate(result) {
+				if !filter.ValidateWithReport(newReport, result) {
 					continue
 				}
 
@@ -51,7 +51,7 @@ func CreateCustomResultMetricsListener(
 			}
 
 			for _, result := range newReport.GetResults() {
-				if !filter.Validate(result) {
+				if !filter.ValidateWithReport(newReport, result) {
 					continue
 				}
 
@@ -26,7 +26,7 @@ func CreateDetailedResultMetricListener(filter *report.ResultFilter, gauge *prom
 		switch event.Type {
 		case report.Added:
 			for _, result := range newReport.GetResults() {
-				if !filter.Validate(result) {
+				if !filter.ValidateWithReport(newReport, result) {
 					continue
 				}
 
@@ -41,7 +41,7 @@ func CreateDetailedResultMetricListener(filter *report.ResultFilter, gauge *prom
 			}
 
 			for _, result := range newReport.GetResults() {
-				if !filter.Validate(result) {
+				if !filter.ValidateWithReport(newReport, result) {
 					continue
 				}
 
@@ -6,18 +6,24 @@ import (
 	"github.com/kyverno/policy-reporter/pkg/helper"
 	"github.com/kyverno/policy-reporter/pkg/openreports"
 	"github.com/kyverno/policy-reporter/pkg/report"
+	"github.com/kyverno/policy-reporter/pkg/routing"
+	"github.com/kyverno/policy-reporter/pkg/silence"
 	"github.com/kyverno/policy-reporter/pkg/target"
 )
 
 const SendScopeResults = "send_scope_results_listener"
 
-func NewSendScopeResultsListener(targets *target.Collection) report.ScopeResultsListener {
+func NewSendScopeResultsListener(targets *target.Collection, router *routing.Router, silences *silence.Store) report.ScopeResultsListener {
 	return func(rep openreports.ReportInterface, r []openreports.ResultAdapter, e bool) {
 		clients := targets.BatchSendClients()
 		if len(clients) == 0 {
 			return
 		}
 
+		destinations := helper.Map(r, func(result openreports.ResultAdapter) routing.Destinations {
+			return router.Route(rep, result)
+		})
+
 		wg := &sync.WaitGroup{}
 		wg.Add(len(clients))
 
@@ -25,9 +31,12 @@ func NewSendScopeResultsListener(targets *target.Collection) report.ScopeResults
 			go func(target target.Client, re openreports.ReportInterface, results []openreports.ResultAdapter, preExisted bool) {
 				defer wg.Done()
 
-				filtered := helper.Filter(results, func(result openreports.ResultAdapter) bool {
-					return target.Validate(re, result)
-				})
+				filtered := make([]openreports.ResultAdapter, 0, len(results))
+				for i, result := range results {
+					if destinations[i].Allows(target.Name()) && target.Validate(re, result) && !silences.Silenced(re, result, target.Name()) {
+						filtered = append(filtered, result)
+					}
+				}
 
 				if len(filtered) == 0 || preExisted && target.SkipExistingOnStartup() {
 					return
@@ -8,7 +8,9 @@ import (
 	"github.com/kyverno/policy-reporter/pkg/fixtures"
 	"github.com/kyverno/policy-reporter/pkg/listener"
 	"github.com/kyverno/policy-reporter/pkg/openreports"
+	"github.com/kyverno/policy-reporter/pkg/silence"
 	"github.com/kyverno/policy-reporter/pkg/target"
+	"github.com/kyverno/policy-reporter/pkg/validate"
 )
 
 func Test_ScopeResultsListener(t *testing.T) {
@@ -16,7 +18,7 @@ func Test_ScopeResultsListener(t *testing.T) {
 	t.Run("Send Results", func(t *testing.T) {
 		t.Parallel()
 		c := &client{validated: true, batchSend: true}
-		slistener := listener.NewSendScopeResultsListener(target.NewCollection(&target.Target{Client: c}))
+		slistener := listener.NewSendScopeResultsListener(target.NewCollection(&target.Target{Client: c}), nil, nil)
 		slistener(preport1, []openreports.ResultAdapter{fixtures.FailResult}, false)
 
 		assert.True(t, c.Called, "Expected Send to be called")
@@ -24,7 +26,7 @@ func Test_ScopeResultsListener(t *testing.T) {
 	t.Run("Don't Send Result when validation fails", func(t *testing.T) {
 		t.Parallel()
 		c := &client{validated: false, batchSend: true}
-		slistener := listener.NewSendScopeResultsListener(target.NewCollection(&target.Target{Client: c}))
+		slistener := listener.NewSendScopeResultsListener(target.NewCollection(&target.Target{Client: c}), nil, nil)
 		slistener(preport1, []openreports.ResultAdapter{fixtures.FailResult}, false)
 
 		assert.False(t, c.Called, "Expected Send not to be called")
@@ -32,11 +34,22 @@ func Test_ScopeResultsListener(t *testing.T) {
 	t.Run("Don't Send pre existing Result when skipExistingOnStartup is true", func(t *testing.T) {
 		t.Parallel()
 		c := &client{skipExistingOnStartup: true, batchSend: true}
-		slistener := listener.NewSendScopeResultsListener(target.NewCollection(&target.Target{Client: c}))
+		slistener := listener.NewSendScopeResultsListener(target.NewCollection(&target.Target{Client: c}), nil, nil)
 		slistener(preport1, []openreports.ResultAdapter{fixtures.FailResult}, true)
 
 		if c.Called {
 			t.Error("Expected Send not to be called")
 		}
 	})
+	t.Run("Don't Send silenced Results", func(t *testing.T) {
+		t.Parallel()
+		silences := silence.NewStore()
+		silences.Add(silence.Silence{Name: "maintenance", Matchers: silence.Matchers{Policies: validate.RuleSets{Include: []string{"require-*"}}}})
+
+		c := &client{validated: true, batchSend: true}
+		slistener := listener.NewSendScopeResultsListener(target.NewCollection(&target.Target{Client: c}), nil, silences)
+		slistener(preport1, []openreports.ResultAdapter{fixtures.FailResult}, false)
+
+		assert.False(t, c.Called, "Expected Send not to be called")
+	})
 }
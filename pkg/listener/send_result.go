@@ -7,18 +7,22 @@ import (
 
 	"github.com/kyverno/policy-reporter/pkg/openreports"
 	"github.com/kyverno/policy-reporter/pkg/report"
+	"github.com/kyverno/policy-reporter/pkg/routing"
+	"github.com/kyverno/policy-reporter/pkg/silence"
 	"github.com/kyverno/policy-reporter/pkg/target"
 )
 
 const SendResults = "send_results_listener"
 
-func NewSendResultListener(targets *target.Collection) report.PolicyReportResultListener {
+func NewSendResultListener(targets *target.Collection, router *routing.Router, silences *silence.Store) report.PolicyReportResultListener {
 	return func(rep openreports.ReportInterface, r openreports.ResultAdapter, e bool) {
 		clients := targets.SingleSendClients()
 		if len(clients) == 0 {
 			return
 		}
 
+		destinations := router.Route(rep, r)
+
 		wg := &sync.WaitGroup{}
 		wg.Add(len(clients))
 
@@ -30,7 +34,7 @@ func NewSendResultListener(targets *target.Collection) report.PolicyReportResult
 					result.Subjects = []corev1.ObjectReference{*re.GetScope()}
 				}
 
-				if (preExisted && target.SkipExistingOnStartup()) || !target.Validate(re, result) {
+				if (preExisted && target.SkipExistingOnStartup()) || !destinations.Allows(target.Name()) || !target.Validate(re, result) || silences.Silenced(re, result, target.Name()) {
 					return
 				}
 
@@ -9,6 +9,8 @@ import (
 	"github.com/kyverno/policy-reporter/pkg/fixtures"
 	"github.com/kyverno/policy-reporter/pkg/listener"
 	"github.com/kyverno/policy-reporter/pkg/openreports"
+	"github.com/kyverno/policy-reporter/pkg/routing"
+	"github.com/kyverno/policy-reporter/pkg/silence"
 	"github.com/kyverno/policy-reporter/pkg/target"
 )
 
@@ -75,7 +77,7 @@ func Test_SendResultListener(t *testing.T) {
 	t.Run("Send Result", func(t *testing.T) {
 		t.Parallel()
 		c := &client{validated: true}
-		slistener := listener.NewSendResultListener(target.NewCollection(&target.Target{Client: c}))
+		slistener := listener.NewSendResultListener(target.NewCollection(&target.Target{Client: c}), nil, nil)
 		slistener(preport1, fixtures.FailResult, false)
 
 		assert.True(t, c.Called, "Expected Send to be called")
@@ -83,7 +85,7 @@ func Test_SendResultListener(t *testing.T) {
 	t.Run("Don't Send Result when validation fails", func(t *testing.T) {
 		t.Parallel()
 		c := &client{validated: false}
-		slistener := listener.NewSendResultListener(target.NewCollection(&target.Target{Client: c}))
+		slistener := listener.NewSendResultListener(target.NewCollection(&target.Target{Client: c}), nil, nil)
 		slistener(preport1, fixtures.FailResult, false)
 
 		assert.False(t, c.Called, "Expected Send not to be called")
@@ -91,9 +93,33 @@ func Test_SendResultListener(t *testing.T) {
 	t.Run("Don't Send pre existing Result when skipExistingOnStartup is true", func(t *testing.T) {
 		t.Parallel()
 		c := &client{skipExistingOnStartup: true}
-		slistener := listener.NewSendResultListener(target.NewCollection(&target.Target{Client: c}))
+		slistener := listener.NewSendResultListener(target.NewCollection(&target.Target{Client: c}), nil, nil)
 		slistener(preport1, fixtures.FailResult, true)
 
+		assert.False(t, c.Called, "Expected Send not to be called")
+	})
+	t.Run("Don't Send Result routed to another receiver", func(t *testing.T) {
+		t.Parallel()
+		router, _ := routing.NewRouter(&routing.Route{Receiver: "other"}, []routing.Receiver{
+			{Name: "other", Targets: []string{"other"}},
+			{Name: "test", Targets: []string{"test"}},
+		}, nil)
+
+		c := &client{validated: true}
+		slistener := listener.NewSendResultListener(target.NewCollection(&target.Target{Client: c}), router, nil)
+		slistener(preport1, fixtures.FailResult, false)
+
+		assert.False(t, c.Called, "Expected Send not to be called")
+	})
+	t.Run("Don't Send silenced Result", func(t *testing.T) {
+		t.Parallel()
+		silences := silence.NewStore()
+		silences.Add(silence.Silence{Name: "maintenance", Matchers: silence.Matchers{Targets: []string{"test"}}})
+
+		c := &client{validated: true}
+		slistener := listener.NewSendResultListener(target.NewCollection(&target.Target{Client: c}), nil, silences)
+		slistener(preport1, fixtures.FailResult, false)
+
 		assert.False(t, c.Called, "Expected Send not to be called")
 	})
 }
@@ -12,7 +12,7 @@ import (
 
 const Store = "store_listener"
 
-func NewStoreListener(store report.PolicyReportStore) report.PolicyReportListener {
+func NewStoreListener(store report.PolicyReportStore, findings report.FindingStore) report.PolicyReportListener {
 	return func(ctx context.Context, event report.LifecycleEvent) {
 		err := retry.OnError(retry.DefaultRetry, func(err error) bool {
 			return !errors.Is(err, context.DeadlineExceeded)
@@ -25,6 +25,20 @@ func NewStoreListener(store report.PolicyReportStore) report.PolicyReportListene
 		})
 
 		logOnError(event.Type.String(), event.PolicyReport.GetName(), err)
+
+		if findings == nil {
+			return
+		}
+
+		if event.Type == report.Deleted {
+			err = findings.ResolveFindings(ctx, event.PolicyReport.GetID())
+		} else {
+			err = findings.UpdateFindings(ctx, event.PolicyReport)
+		}
+
+		if err != nil {
+			zap.L().Error("failed to track policy report findings", zap.String("name", event.PolicyReport.GetName()), zap.Error(err))
+		}
 	}
 }
 
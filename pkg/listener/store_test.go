@@ -4,7 +4,10 @@ import (
 	"context"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+
 	"github.com/kyverno/policy-reporter/pkg/listener"
+	"github.com/kyverno/policy-reporter/pkg/openreports"
 	"github.com/kyverno/policy-reporter/pkg/report"
 )
 
@@ -16,7 +19,7 @@ func Test_StoreListener(t *testing.T) {
 
 	t.Run("Save New Report", func(t *testing.T) {
 		t.Parallel()
-		slistener := listener.NewStoreListener(store)
+		slistener := listener.NewStoreListener(store, nil)
 		slistener(ctx, report.LifecycleEvent{Type: report.Added, PolicyReport: preport1})
 
 		if _, err := store.Get(ctx, preport1.GetID()); err != nil {
@@ -25,7 +28,7 @@ func Test_StoreListener(t *testing.T) {
 	})
 	t.Run("Update Modified Report", func(t *testing.T) {
 		t.Parallel()
-		slistener := listener.NewStoreListener(store)
+		slistener := listener.NewStoreListener(store, nil)
 		slistener(ctx, report.LifecycleEvent{Type: report.Updated, PolicyReport: preport2})
 
 		if preport, err := store.Get(ctx, preport2.GetID()); err != nil && len(preport.GetResults()) == 2 {
@@ -34,7 +37,7 @@ func Test_StoreListener(t *testing.T) {
 	})
 	t.Run("Remove Deleted Report", func(t *testing.T) {
 		t.Parallel()
-		slistener := listener.NewStoreListener(store)
+		slistener := listener.NewStoreListener(store, nil)
 		slistener(ctx, report.LifecycleEvent{Type: report.Deleted, PolicyReport: preport2})
 
 		if _, err := store.Get(ctx, preport2.GetID()); err == nil {
@@ -42,3 +45,30 @@ func Test_StoreListener(t *testing.T) {
 		}
 	})
 }
+
+type findingStore struct {
+	updated  []string
+	resolved []string
+}
+
+func (s *findingStore) UpdateFindings(_ context.Context, r openreports.ReportInterface) error {
+	s.updated = append(s.updated, r.GetID())
+	return nil
+}
+
+func (s *findingStore) ResolveFindings(_ context.Context, id string) error {
+	s.resolved = append(s.resolved, id)
+	return nil
+}
+
+func Test_StoreListenerFindings(t *testing.T) {
+	t.Parallel()
+	findings := &findingStore{}
+
+	slistener := listener.NewStoreListener(report.NewPolicyReportStore(), findings)
+	slistener(ctx, report.LifecycleEvent{Type: report.Added, PolicyReport: preport1})
+	slistener(ctx, report.LifecycleEvent{Type: report.Deleted, PolicyReport: preport1})
+
+	assert.Equal(t, []string{preport1.GetID()}, findings.updated)
+	assert.Equal(t, []string{preport1.GetID()}, findings.resolved)
+}
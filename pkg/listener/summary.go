@@ -0,0 +1,12 @@
+package listener
+
+import (
+	"github.com/kyverno/policy-reporter/pkg/report"
+	"github.com/kyverno/policy-reporter/pkg/summary"
+)
+
+const Summary = "summary_listener"
+
+func NewSummaryListener(manager *summary.Manager) report.PolicyReportListener {
+	return manager.Listen
+}
@@ -7,12 +7,15 @@ import (
 	"github.com/kyverno/policy-reporter/pkg/helper"
 	"github.com/kyverno/policy-reporter/pkg/openreports"
 	"github.com/kyverno/policy-reporter/pkg/report"
+	"github.com/kyverno/policy-reporter/pkg/silence"
 	"github.com/kyverno/policy-reporter/pkg/target"
 )
 
 const SendSyncResults = "send_sync_results_listener"
 
-func NewSendSyncResultsListener(targets *target.Collection) report.SyncResultsListener {
+// NewSendSyncResultsListener sends all valid results of a report to the sync targets, silenced results are removed
+// from the synchronized state of the target.
+func NewSendSyncResultsListener(targets *target.Collection, silences *silence.Store) report.SyncResultsListener {
 	ready := make(chan bool)
 	ok := false
 	go func() {
@@ -40,7 +43,7 @@ func NewSendSyncResultsListener(targets *target.Collection) report.SyncResultsLi
 				defer wg.Done()
 
 				filtered := helper.Filter(re.GetResults(), func(result openreports.ResultAdapter) bool {
-					return target.Validate(re, result)
+					return target.Validate(re, result) && !silences.Silenced(re, result, target.Name())
 				})
 
 				target.BatchSend(re, filtered)
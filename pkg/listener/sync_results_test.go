@@ -0,0 +1,44 @@
+package listener_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kyverno/policy-reporter/pkg/listener"
+	"github.com/kyverno/policy-reporter/pkg/openreports"
+	"github.com/kyverno/policy-reporter/pkg/silence"
+	"github.com/kyverno/policy-reporter/pkg/target"
+)
+
+type syncClient struct {
+	client
+	results []openreports.ResultAdapter
+}
+
+func (c *syncClient) BatchSend(_ openreports.ReportInterface, results []openreports.ResultAdapter) {
+	c.results = results
+}
+
+func Test_SendSyncResultsListener(t *testing.T) {
+	t.Parallel()
+	t.Run("Send valid Results", func(t *testing.T) {
+		t.Parallel()
+		c := &syncClient{client: client{validated: true, cleanup: true}}
+		slistener := listener.NewSendSyncResultsListener(target.NewCollection(&target.Target{Client: c}), nil)
+		slistener(preport1)
+
+		assert.Len(t, c.results, len(preport1.GetResults()))
+	})
+	t.Run("Don't Send silenced Results", func(t *testing.T) {
+		t.Parallel()
+		silences := silence.NewStore()
+		silences.Add(silence.Silence{Name: "maintenance", Matchers: silence.Matchers{Targets: []string{"test"}}})
+
+		c := &syncClient{client: client{validated: true, cleanup: true}}
+		slistener := listener.NewSendSyncResultsListener(target.NewCollection(&target.Target{Client: c}), silences)
+		slistener(preport1)
+
+		assert.Empty(t, c.results)
+	})
+}
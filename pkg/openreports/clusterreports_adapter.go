@@ -94,7 +94,7 @@ func (r *ClusterReportAdapter) GetID() string {
 	h1 := fnv1a.Init64
 	h1 = fnv1a.AddString64(h1, r.GetName())
 
-	return strconv.FormatUint(h1, 10)
+	return ClusterID(Cluster(r), strconv.FormatUint(h1, 10))
 }
 
 func (r *ClusterReportAdapter) GetKey() string {
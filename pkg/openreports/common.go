@@ -1,11 +1,46 @@
 package openreports
 
 import (
+	"strconv"
+
 	"github.com/openreports/reports-api/apis/openreports.io/v1alpha1"
+	"github.com/segmentio/fasthash/fnv1a"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// ClusterAnnotation tags reports with the name of the cluster they were reported in
+const ClusterAnnotation = "policy-reporter.kyverno.io/cluster"
+
+// Cluster returns the cluster name of the report, an empty string for reports of an untagged cluster
+func Cluster(r metav1.Object) string {
+	return r.GetAnnotations()[ClusterAnnotation]
+}
+
+// SetCluster tags the report with the given cluster name
+func SetCluster(r metav1.Object, cluster string) {
+	annotations := make(map[string]string, len(r.GetAnnotations())+1)
+	for k, v := range r.GetAnnotations() {
+		annotations[k] = v
+	}
+	annotations[ClusterAnnotation] = cluster
+
+	r.SetAnnotations(annotations)
+}
+
+// ClusterID scopes the given ID to the cluster, IDs of an untagged cluster are unchanged
+func ClusterID(cluster, id string) string {
+	if cluster == "" {
+		return id
+	}
+
+	h1 := fnv1a.Init64
+	h1 = fnv1a.AddString64(h1, cluster)
+	h1 = fnv1a.AddString64(h1, id)
+
+	return strconv.FormatUint(h1, 10)
+}
+
 // Status specifies state of a policy result
 const (
 	StatusPass  = "pass"
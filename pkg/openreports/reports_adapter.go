@@ -94,7 +94,7 @@ func (r *ReportAdapter) GetID() string {
 	h1 = fnv1a.AddString64(h1, r.GetName())
 	h1 = fnv1a.AddString64(h1, r.GetNamespace())
 
-	return strconv.FormatUint(h1, 10)
+	return ClusterID(Cluster(r), strconv.FormatUint(h1, 10))
 }
 
 func (r *ReportAdapter) GetKey() string {
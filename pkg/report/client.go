@@ -26,6 +26,8 @@ type PolicyReportClient interface {
 	Sync(stopper chan struct{}) error
 	// HasSynced the configured PolicyReport
 	HasSynced() bool
+	// Idle reports whether the informer has synced and all queued reports are processed
+	Idle() bool
 	// Stop the client
 	Stop()
 }
@@ -0,0 +1,45 @@
+package report
+
+import (
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/kyverno/policy-reporter/pkg/kubernetes/resources"
+	"github.com/kyverno/policy-reporter/pkg/openreports"
+	"github.com/kyverno/policy-reporter/pkg/validate"
+)
+
+// ResultResource returns the resource of the result with the report scope as fallback
+func ResultResource(report openreports.ReportInterface, result openreports.ResultAdapter) *corev1.ObjectReference {
+	if res := result.GetResource(); res != nil {
+		return res
+	}
+
+	return report.GetScope()
+}
+
+// ValidateResourceMetadata validates labels and annotations of the referenced resource,
+// resources which could not be resolved are validated with empty labels and annotations
+func ValidateResourceMetadata(client resources.Client, resource *corev1.ObjectReference, labels, annotations validate.RuleSets) bool {
+	if client == nil || resource == nil || resource.Name == "" {
+		return true
+	}
+
+	resourceLabels := map[string]string{}
+	resourceAnnotations := map[string]string{}
+
+	obj, err := client.Metadata(resource)
+	if err != nil {
+		zap.L().Debug("failed to resolve resource metadata",
+			zap.String("kind", resource.Kind),
+			zap.String("name", resource.Name),
+			zap.String("namespace", resource.Namespace),
+			zap.Error(err),
+		)
+	} else {
+		resourceLabels = obj.GetLabels()
+		resourceAnnotations = obj.GetAnnotations()
+	}
+
+	return validate.Labels(resourceLabels, labels) && validate.Labels(resourceAnnotations, annotations)
+}
@@ -0,0 +1,66 @@
+package report_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kyverno/policy-reporter/pkg/fixtures"
+	"github.com/kyverno/policy-reporter/pkg/report"
+	"github.com/kyverno/policy-reporter/pkg/validate"
+)
+
+type resourceClient struct {
+	objects map[string]*metav1.PartialObjectMetadata
+}
+
+func (c *resourceClient) Metadata(ref *corev1.ObjectReference) (*metav1.PartialObjectMetadata, error) {
+	obj, ok := c.objects[ref.Name]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+
+	return obj, nil
+}
+
+func Test_ValidateResourceMetadata(t *testing.T) {
+	t.Parallel()
+	client := &resourceClient{objects: map[string]*metav1.PartialObjectMetadata{
+		"nginx": {ObjectMeta: metav1.ObjectMeta{
+			Labels:      map[string]string{"app": "nginx"},
+			Annotations: map[string]string{"policy-reporter.io/ignore": "true"},
+		}},
+	}}
+
+	nginx := &corev1.ObjectReference{APIVersion: "apps/v1", Kind: "Deployment", Name: "nginx", Namespace: "test"}
+	redis := &corev1.ObjectReference{APIVersion: "apps/v1", Kind: "Deployment", Name: "redis", Namespace: "test"}
+
+	t.Run("exclude annotated resources", func(t *testing.T) {
+		t.Parallel()
+		rules := validate.RuleSets{Exclude: []string{"policy-reporter.io/ignore:true"}}
+
+		assert.False(t, report.ValidateResourceMetadata(client, nginx, validate.RuleSets{}, rules))
+		assert.True(t, report.ValidateResourceMetadata(client, redis, validate.RuleSets{}, rules))
+	})
+	t.Run("include labeled resources", func(t *testing.T) {
+		t.Parallel()
+		rules := validate.RuleSets{Include: []string{"app:nginx"}}
+
+		assert.True(t, report.ValidateResourceMetadata(client, nginx, rules, validate.RuleSets{}))
+		assert.False(t, report.ValidateResourceMetadata(client, redis, rules, validate.RuleSets{}))
+	})
+	t.Run("skip validation without client or resource", func(t *testing.T) {
+		t.Parallel()
+		rules := validate.RuleSets{Include: []string{"app:nginx"}}
+
+		assert.True(t, report.ValidateResourceMetadata(nil, redis, rules, validate.RuleSets{}))
+		assert.True(t, report.ValidateResourceMetadata(client, nil, rules, validate.RuleSets{}))
+	})
+	t.Run("fallback to the report scope", func(t *testing.T) {
+		t.Parallel()
+		assert.Equal(t, fixtures.FailResult.GetResource(), report.ResultResource(fixtures.DefaultPolicyReport, fixtures.FailResult))
+	})
+}
@@ -0,0 +1,86 @@
+package result
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/kyverno/policy-reporter/pkg/kubernetes/namespaces"
+	"github.com/kyverno/policy-reporter/pkg/openreports"
+)
+
+// NamespaceEnricher copies configured labels and annotations of the result namespace into the result properties
+type NamespaceEnricher struct {
+	client      namespaces.Client
+	labels      []string
+	annotations []string
+}
+
+// Enrich adds the namespace metadata, existing result properties are not overwritten
+func (e *NamespaceEnricher) Enrich(polr openreports.ReportInterface, result openreports.ResultAdapter) openreports.ResultAdapter {
+	if e == nil {
+		return result
+	}
+
+	namespace := polr.GetNamespace()
+	if res := result.GetResource(); res != nil && res.Namespace != "" {
+		namespace = res.Namespace
+	}
+
+	if namespace == "" {
+		return result
+	}
+
+	properties := make(map[string]string, len(result.Properties)+len(e.labels)+len(e.annotations))
+
+	if len(e.labels) > 0 {
+		labels, err := e.client.Labels(context.Background(), namespace)
+		if err != nil {
+			zap.L().Error("failed to get namespace labels for enrichment", zap.String("namespace", namespace), zap.Error(err))
+		}
+
+		copyKeys(properties, labels, e.labels)
+	}
+
+	if len(e.annotations) > 0 {
+		annotations, err := e.client.Annotations(context.Background(), namespace)
+		if err != nil {
+			zap.L().Error("failed to get namespace annotations for enrichment", zap.String("namespace", namespace), zap.Error(err))
+		}
+
+		copyKeys(properties, annotations, e.annotations)
+	}
+
+	if len(properties) == 0 {
+		return result
+	}
+
+	// copy the properties to not modify the shared map of the original report
+	for k, v := range result.Properties {
+		properties[k] = v
+	}
+
+	result.Properties = properties
+
+	return result
+}
+
+func copyKeys(target, source map[string]string, keys []string) {
+	for _, key := range keys {
+		if value, ok := source[key]; ok {
+			target[key] = value
+		}
+	}
+}
+
+func NewNamespaceEnricher(client namespaces.Client, labels, annotations []string) *NamespaceEnricher {
+	if client == nil || len(labels)+len(annotations) == 0 {
+		return nil
+	}
+
+	return &NamespaceEnricher{
+		client:      client,
+		labels:      labels,
+		annotations: annotations,
+	}
+}
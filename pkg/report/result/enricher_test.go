@@ -0,0 +1,89 @@
+package result_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kyverno/policy-reporter/pkg/fixtures"
+	"github.com/kyverno/policy-reporter/pkg/report/result"
+)
+
+type nsClient struct {
+	labels      map[string]map[string]string
+	annotations map[string]map[string]string
+}
+
+func (c *nsClient) List(_ context.Context, _ map[string]string) ([]string, error) {
+	return nil, nil
+}
+
+func (c *nsClient) Labels(_ context.Context, name string) (map[string]string, error) {
+	labels, ok := c.labels[name]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+
+	return labels, nil
+}
+
+func (c *nsClient) Annotations(_ context.Context, name string) (map[string]string, error) {
+	annotations, ok := c.annotations[name]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+
+	return annotations, nil
+}
+
+var client = &nsClient{
+	labels:      map[string]map[string]string{"test": {"team": "payments", "cost-center": "cc-42", "other": "value"}},
+	annotations: map[string]map[string]string{"test": {"owner-email": "payments@example.com"}},
+}
+
+func TestNamespaceEnricher(t *testing.T) {
+	t.Parallel()
+	t.Run("copy configured labels and annotations", func(t *testing.T) {
+		t.Parallel()
+		enricher := result.NewNamespaceEnricher(client, []string{"team", "cost-center", "unknown"}, []string{"owner-email"})
+
+		res := enricher.Enrich(fixtures.DefaultPolicyReport, fixtures.FailResult)
+
+		assert.Equal(t, "payments", res.Properties["team"])
+		assert.Equal(t, "cc-42", res.Properties["cost-center"])
+		assert.Equal(t, "payments@example.com", res.Properties["owner-email"])
+		assert.NotContains(t, res.Properties, "other")
+		assert.NotContains(t, res.Properties, "unknown")
+		assert.NotContains(t, fixtures.FailResult.Properties, "team")
+	})
+	t.Run("keep existing properties", func(t *testing.T) {
+		t.Parallel()
+		enricher := result.NewNamespaceEnricher(client, []string{"team"}, nil)
+
+		source := fixtures.FailResult
+		source.Properties = map[string]string{"team": "engine"}
+
+		res := enricher.Enrich(fixtures.DefaultPolicyReport, source)
+
+		assert.Equal(t, "engine", res.Properties["team"])
+	})
+	t.Run("ignore unknown namespaces and cluster results", func(t *testing.T) {
+		t.Parallel()
+		enricher := result.NewNamespaceEnricher(client, []string{"team"}, nil)
+
+		res := enricher.Enrich(fixtures.ClusterPolicyReport, fixtures.PassNamespaceResult)
+
+		assert.NotContains(t, res.Properties, "team")
+	})
+	t.Run("disabled without keys", func(t *testing.T) {
+		t.Parallel()
+		assert.Nil(t, result.NewNamespaceEnricher(client, nil, nil))
+		assert.Nil(t, result.NewNamespaceEnricher(nil, []string{"team"}, nil))
+
+		var enricher *result.NamespaceEnricher
+
+		assert.Equal(t, fixtures.FailResult, enricher.Enrich(fixtures.DefaultPolicyReport, fixtures.FailResult))
+	})
+}
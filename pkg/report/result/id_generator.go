@@ -18,22 +18,34 @@ type IDGenerator interface {
 	Generate(polr openreports.ReportInterface, res openreports.ResultAdapter) string
 }
 
-var fieldMapper = map[string]FieldMapperFunc{
-	"resource": func(h1 uint64, polr openreports.ReportInterface, res openreports.ResultAdapter) uint64 {
-		var resource *corev1.ObjectReference
+func resourceField(h1 uint64, polr openreports.ReportInterface, res openreports.ResultAdapter) uint64 {
+	var resource *corev1.ObjectReference
 
-		if res.HasResource() {
-			resource = res.GetResource()
-		} else if polr.GetScope() != nil {
-			resource = polr.GetScope()
-		}
+	if res.HasResource() {
+		resource = res.GetResource()
+	} else if polr.GetScope() != nil {
+		resource = polr.GetScope()
+	}
+
+	if resource != nil {
+		h1 = fnv1a.AddString64(h1, string(resource.UID))
+		h1 = fnv1a.AddString64(h1, resource.Name)
+	}
 
-		if resource != nil {
-			h1 = fnv1a.AddString64(h1, string(resource.UID))
-			h1 = fnv1a.AddString64(h1, resource.Name)
+	return h1
+}
+
+var fieldMapper = map[string]FieldMapperFunc{
+	"resource": resourceField,
+	"owner": func(h1 uint64, polr openreports.ReportInterface, res openreports.ResultAdapter) uint64 {
+		// requires the owner resolution, falls back to the resource if no owner was resolved
+		if kind, ok := res.Properties[OwnerKindProperty]; ok {
+			h1 = fnv1a.AddString64(h1, polr.GetNamespace())
+			h1 = fnv1a.AddString64(h1, kind)
+			return fnv1a.AddString64(h1, res.Properties[OwnerNameProperty])
 		}
 
-		return h1
+		return resourceField(h1, polr, res)
 	},
 	"namespace": func(h1 uint64, polr openreports.ReportInterface, res openreports.ResultAdapter) uint64 {
 		return fnv1a.AddString64(h1, polr.GetNamespace())
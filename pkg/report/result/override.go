@@ -0,0 +1,59 @@
+package result
+
+import (
+	"github.com/openreports/reports-api/apis/openreports.io/v1alpha1"
+
+	"github.com/kyverno/policy-reporter/pkg/openreports"
+	"github.com/kyverno/policy-reporter/pkg/validate"
+)
+
+// OverrideMatchers of an override rule, all configured matchers have to match
+type OverrideMatchers struct {
+	Sources    validate.RuleSets
+	Policies   validate.RuleSets
+	Rules      validate.RuleSets
+	Categories validate.RuleSets
+	// Severities uses exact matches, an empty string matches results without severity
+	Severities validate.RuleSets
+}
+
+// Override sets severity, category or additional properties of matching results
+type Override struct {
+	Match      OverrideMatchers
+	Severity   v1alpha1.ResultSeverity
+	Category   string
+	Properties map[string]string
+}
+
+func (o Override) Matches(result openreports.ResultAdapter) bool {
+	return validate.MatchRuleSet(result.Source, o.Match.Sources) &&
+		validate.MatchRuleSet(result.Policy, o.Match.Policies) &&
+		validate.MatchRuleSet(result.Rule, o.Match.Rules) &&
+		validate.MatchRuleSet(result.Category, o.Match.Categories) &&
+		validate.ContainsRuleSet(string(result.Severity), o.Match.Severities)
+}
+
+func (o Override) Apply(result openreports.ResultAdapter) openreports.ResultAdapter {
+	if o.Severity != "" {
+		result.Severity = o.Severity
+	}
+
+	if o.Category != "" {
+		result.Category = o.Category
+	}
+
+	if len(o.Properties) > 0 {
+		// copy the properties to not modify the shared map of the original report
+		properties := make(map[string]string, len(result.Properties)+len(o.Properties))
+		for k, v := range result.Properties {
+			properties[k] = v
+		}
+		for k, v := range o.Properties {
+			properties[k] = v
+		}
+
+		result.Properties = properties
+	}
+
+	return result
+}
@@ -0,0 +1,130 @@
+package result
+
+import (
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	gocache "zgo.at/zcache/v2"
+
+	"github.com/kyverno/policy-reporter/pkg/kubernetes/jobs"
+	"github.com/kyverno/policy-reporter/pkg/kubernetes/pods"
+	"github.com/kyverno/policy-reporter/pkg/kubernetes/replicasets"
+	"github.com/kyverno/policy-reporter/pkg/openreports"
+)
+
+const (
+	OwnerKindProperty = "owner.kind"
+	OwnerNameProperty = "owner.name"
+)
+
+// maximum owner chain length, e.g. Pod -> Job -> CronJob
+const maxOwnerDepth = 3
+
+// OwnerResolver adds the top level controller of Pod, ReplicaSet and Job results as owner properties.
+// Higher level controllers like Deployments, StatefulSets, CronJobs or Argo Rollouts are resolved from the owner references.
+type OwnerResolver struct {
+	pods        pods.Client
+	replicasets replicasets.Client
+	jobs        jobs.Client
+	cache       *gocache.Cache[types.UID, *corev1.ObjectReference]
+}
+
+// Enrich adds the owner properties, results without resource are not changed
+func (o *OwnerResolver) Enrich(polr openreports.ReportInterface, result openreports.ResultAdapter) openreports.ResultAdapter {
+	if o == nil {
+		return result
+	}
+
+	resource := result.GetResource()
+	if resource == nil {
+		return result
+	}
+
+	owner := o.Resolve(resource)
+
+	// copy the properties to not modify the shared map of the original report
+	properties := make(map[string]string, len(result.Properties)+2)
+	for k, v := range result.Properties {
+		properties[k] = v
+	}
+
+	properties[OwnerKindProperty] = owner.Kind
+	properties[OwnerNameProperty] = owner.Name
+
+	result.Properties = properties
+
+	return result
+}
+
+// Resolve the top level controller of the resource, the resource itself is returned if it is not controlled
+func (o *OwnerResolver) Resolve(resource *corev1.ObjectReference) *corev1.ObjectReference {
+	if resource.UID != "" {
+		if owner, ok := o.cache.Get(resource.UID); ok {
+			return owner
+		}
+	}
+
+	owner := resource
+	resolved := true
+
+	for i := 0; i < maxOwnerDepth; i++ {
+		controller, err := o.controller(owner)
+		if err != nil {
+			zap.L().Debug("failed to resolve owner", zap.String("kind", owner.Kind), zap.String("name", owner.Name), zap.String("namespace", owner.Namespace), zap.Error(err))
+			resolved = false
+			break
+		}
+
+		if controller == nil {
+			break
+		}
+
+		owner = &corev1.ObjectReference{
+			APIVersion: controller.APIVersion,
+			Kind:       controller.Kind,
+			Name:       controller.Name,
+			Namespace:  resource.Namespace,
+			UID:        controller.UID,
+		}
+	}
+
+	// failed lookups are not cached to resolve the owner on the next report update, e.g. if the informer cache was not synced yet
+	if resource.UID != "" && resolved {
+		o.cache.Set(resource.UID, owner)
+	}
+
+	return owner
+}
+
+func (o *OwnerResolver) controller(ref *corev1.ObjectReference) (*metav1.OwnerReference, error) {
+	switch {
+	case ref.Kind == "Pod":
+		pod, err := o.pods.Get(ref)
+		if err != nil {
+			return nil, err
+		}
+
+		return metav1.GetControllerOf(pod), nil
+	case ref.Kind == "ReplicaSet" && ref.APIVersion == "apps/v1":
+		rs, err := o.replicasets.Get(ref)
+		if err != nil {
+			return nil, err
+		}
+
+		return metav1.GetControllerOf(rs), nil
+	case ref.Kind == "Job":
+		job, err := o.jobs.Get(ref)
+		if err != nil {
+			return nil, err
+		}
+
+		return metav1.GetControllerOf(job), nil
+	}
+
+	return nil, nil
+}
+
+func NewOwnerResolver(pods pods.Client, rs replicasets.Client, jobs jobs.Client, cache *gocache.Cache[types.UID, *corev1.ObjectReference]) *OwnerResolver {
+	return &OwnerResolver{pods: pods, replicasets: rs, jobs: jobs, cache: cache}
+}
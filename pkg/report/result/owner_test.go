@@ -0,0 +1,129 @@
+package result_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/openreports/reports-api/apis/openreports.io/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	gocache "zgo.at/zcache/v2"
+
+	"github.com/kyverno/policy-reporter/pkg/kubernetes/jobs"
+	"github.com/kyverno/policy-reporter/pkg/kubernetes/pods"
+	"github.com/kyverno/policy-reporter/pkg/kubernetes/replicasets"
+	"github.com/kyverno/policy-reporter/pkg/openreports"
+	"github.com/kyverno/policy-reporter/pkg/report/result"
+)
+
+var isController = true
+
+func owner(apiVersion, kind, name string) []v1.OwnerReference {
+	return []v1.OwnerReference{{APIVersion: apiVersion, Kind: kind, Name: name, UID: types.UID(name), Controller: &isController}}
+}
+
+func newOwnerResolver() *result.OwnerResolver {
+	client := fake.NewClientset(
+		&corev1.Pod{ObjectMeta: v1.ObjectMeta{Name: "nginx-7d8f-abc12", Namespace: "test", OwnerReferences: owner("apps/v1", "ReplicaSet", "nginx-7d8f")}},
+		&appsv1.ReplicaSet{ObjectMeta: v1.ObjectMeta{Name: "nginx-7d8f", Namespace: "test", OwnerReferences: owner("apps/v1", "Deployment", "nginx")}},
+		&corev1.Pod{ObjectMeta: v1.ObjectMeta{Name: "backup-28901-xyz", Namespace: "test", OwnerReferences: owner("batch/v1", "Job", "backup-28901")}},
+		&batchv1.Job{ObjectMeta: v1.ObjectMeta{Name: "backup-28901", Namespace: "test", OwnerReferences: owner("batch/v1", "CronJob", "backup")}},
+		&appsv1.ReplicaSet{ObjectMeta: v1.ObjectMeta{Name: "canary-5f6d", Namespace: "test", OwnerReferences: owner("argoproj.io/v1alpha1", "Rollout", "canary")}},
+		&corev1.Pod{ObjectMeta: v1.ObjectMeta{Name: "debug", Namespace: "test"}},
+	)
+
+	return result.NewOwnerResolver(
+		pods.NewClient(client.CoreV1()),
+		replicasets.NewClient(client.AppsV1()),
+		jobs.NewClient(client.BatchV1()),
+		gocache.New[types.UID, *corev1.ObjectReference](gocache.DefaultExpiration, 0),
+	)
+}
+
+func TestOwnerResolver(t *testing.T) {
+	t.Parallel()
+	resolver := newOwnerResolver()
+
+	t.Run("resolve top level owners", func(t *testing.T) {
+		t.Parallel()
+		cases := []struct {
+			resource *corev1.ObjectReference
+			kind     string
+			name     string
+		}{
+			{&corev1.ObjectReference{APIVersion: "v1", Kind: "Pod", Name: "nginx-7d8f-abc12", Namespace: "test"}, "Deployment", "nginx"},
+			{&corev1.ObjectReference{APIVersion: "v1", Kind: "Pod", Name: "backup-28901-xyz", Namespace: "test"}, "CronJob", "backup"},
+			{&corev1.ObjectReference{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "canary-5f6d", Namespace: "test"}, "Rollout", "canary"},
+			{&corev1.ObjectReference{APIVersion: "v1", Kind: "Pod", Name: "debug", Namespace: "test"}, "Pod", "debug"},
+			{&corev1.ObjectReference{APIVersion: "v1", Kind: "Pod", Name: "unknown", Namespace: "test"}, "Pod", "unknown"},
+			{&corev1.ObjectReference{APIVersion: "apps/v1", Kind: "Deployment", Name: "nginx", Namespace: "test"}, "Deployment", "nginx"},
+		}
+
+		for _, c := range cases {
+			owner := resolver.Resolve(c.resource)
+
+			assert.Equal(t, c.kind, owner.Kind, c.resource.Name)
+			assert.Equal(t, c.name, owner.Name, c.resource.Name)
+			assert.Equal(t, "test", owner.Namespace, c.resource.Name)
+		}
+	})
+	t.Run("enrich result and reconditioner IDs", func(t *testing.T) {
+		t.Parallel()
+		newReport := func(pod string) openreports.ReportInterface {
+			return &openreports.ReportAdapter{Report: &v1alpha1.Report{
+				ObjectMeta: v1.ObjectMeta{Name: pod, Namespace: "test"},
+				Scope:      &corev1.ObjectReference{APIVersion: "v1", Kind: "Pod", Name: pod, Namespace: "test", UID: types.UID(pod)},
+				Results:    []v1alpha1.ReportResult{{Policy: "require-requests", Rule: "resources", Result: "fail", Properties: map[string]string{"version": "1.0.0"}}},
+			}}
+		}
+
+		rec := result.NewReconditioner(map[string]result.ReconditionerConfig{
+			"": {IDGenerators: result.NewIDGenerator([]string{"owner", "policy", "rule"})},
+		}, nil, []result.Enricher{resolver})
+
+		first := rec.Prepare(newReport("nginx-7d8f-abc12")).GetResults()[0]
+
+		assert.Equal(t, "Deployment", first.Properties[result.OwnerKindProperty])
+		assert.Equal(t, "nginx", first.Properties[result.OwnerNameProperty])
+		assert.Equal(t, "1.0.0", first.Properties["version"])
+
+		rs := newReport("nginx-7d8f-abc12")
+		rs.GetScope().Kind = "ReplicaSet"
+		rs.GetScope().APIVersion = "apps/v1"
+		rs.GetScope().Name = "nginx-7d8f"
+		rs.GetScope().UID = "nginx-7d8f"
+
+		second := rec.Prepare(rs).GetResults()[0]
+
+		assert.Equal(t, first.ID, second.ID, "results of the same owner should share the ID")
+	})
+	t.Run("retry failed lookups", func(t *testing.T) {
+		t.Parallel()
+		client := fake.NewClientset()
+		resolver := result.NewOwnerResolver(
+			pods.NewClient(client.CoreV1()),
+			replicasets.NewClient(client.AppsV1()),
+			jobs.NewClient(client.BatchV1()),
+			gocache.New[types.UID, *corev1.ObjectReference](gocache.DefaultExpiration, 0),
+		)
+
+		resource := &corev1.ObjectReference{APIVersion: "v1", Kind: "Pod", Name: "late-abc12", Namespace: "test", UID: "late-abc12"}
+
+		assert.Equal(t, "Pod", resolver.Resolve(resource).Kind)
+
+		_, err := client.CoreV1().Pods("test").Create(context.Background(), &corev1.Pod{
+			ObjectMeta: v1.ObjectMeta{Name: "late-abc12", Namespace: "test", OwnerReferences: owner("apps/v1", "DaemonSet", "late")},
+		}, v1.CreateOptions{})
+		assert.Nil(t, err)
+
+		owner := resolver.Resolve(resource)
+
+		assert.Equal(t, "DaemonSet", owner.Kind)
+		assert.Equal(t, "late", owner.Name)
+	})
+}
@@ -7,19 +7,43 @@ import (
 	"github.com/kyverno/policy-reporter/pkg/openreports"
 )
 
+// ClusterProperty tags results of a named cluster with the cluster name
+const ClusterProperty = "cluster"
+
 type ReconditionerConfig struct {
 	IDGenerators         IDGenerator
 	SelfassignNamespaces bool
 }
 
+// Enricher adds additional information to a result before its ID is generated
+type Enricher interface {
+	Enrich(polr openreports.ReportInterface, result openreports.ResultAdapter) openreports.ResultAdapter
+}
+
 type Reconditioner struct {
 	defaultIDGenerator IDGenerator
 	configs            map[string]ReconditionerConfig
+	overrides          []Override
+	enrichers          []Enricher
+	cluster            string
+}
+
+// WithCluster returns a copy of the Reconditioner which tags all reports with the given cluster name
+func (r *Reconditioner) WithCluster(cluster string) *Reconditioner {
+	c := *r
+	c.cluster = cluster
+
+	return &c
 }
 
 func (r *Reconditioner) Prepare(polr openreports.ReportInterface) openreports.ReportInterface {
 	generator := r.defaultIDGenerator
 
+	if r.cluster != "" {
+		openreports.SetCluster(polr, r.cluster)
+	}
+	cluster := openreports.Cluster(polr)
+
 	config, ok := r.configs[strings.ToLower(polr.GetSource())]
 	if ok && config.IDGenerators != nil {
 		generator = config.IDGenerators
@@ -31,12 +55,12 @@ func (r *Reconditioner) Prepare(polr openreports.ReportInterface) openreports.Re
 		polr.SetNamespace(scope.Name)
 	}
 
+	overrides := r.overrides
+	enrichers := r.enrichers
+
 	results := polr.GetResults()
 	newResults := make([]openreports.ResultAdapter, 0, len(results))
 	for _, r := range results {
-		r.ID = generator.Generate(polr, r)
-		r.Category = helper.Defaults(r.Category, "Other")
-
 		if len(r.Subjects) == 0 && scope != nil {
 			r.Subjects = append(r.Subjects, *scope)
 		}
@@ -45,15 +69,61 @@ func (r *Reconditioner) Prepare(polr openreports.ReportInterface) openreports.Re
 			r.Source = polr.GetSource()
 		}
 
+		// enriched properties are available for custom ID generators
+		for _, e := range enrichers {
+			r = e.Enrich(polr, r)
+		}
+
+		r.ID = openreports.ClusterID(cluster, generator.Generate(polr, r))
+		if cluster != "" {
+			r.Properties = withCluster(r.Properties, cluster)
+		}
+		r.Category = helper.Defaults(r.Category, "Other")
+
+		// overrides are applied after the ID generation to keep result IDs stable
+		for _, o := range overrides {
+			if o.Matches(r) {
+				r = o.Apply(r)
+			}
+		}
+
 		newResults = append(newResults, r)
 	}
 	polr.SetResults(newResults)
 	return polr
 }
 
-func NewReconditioner(configs map[string]ReconditionerConfig) *Reconditioner {
+// Suppressor returns the configured suppression enricher, nil if the suppression is disabled
+func (r *Reconditioner) Suppressor() *Suppressor {
+	if r == nil {
+		return nil
+	}
+
+	for _, e := range r.enrichers {
+		if s, ok := e.(*Suppressor); ok {
+			return s
+		}
+	}
+
+	return nil
+}
+
+// withCluster copies the properties to not modify the shared map of the report result
+func withCluster(properties map[string]string, cluster string) map[string]string {
+	m := make(map[string]string, len(properties)+1)
+	for k, v := range properties {
+		m[k] = v
+	}
+	m[ClusterProperty] = cluster
+
+	return m
+}
+
+func NewReconditioner(configs map[string]ReconditionerConfig, overrides []Override, enrichers []Enricher) *Reconditioner {
 	return &Reconditioner{
 		defaultIDGenerator: NewIDGenerator(nil),
 		configs:            configs,
+		overrides:          overrides,
+		enrichers:          enrichers,
 	}
 }
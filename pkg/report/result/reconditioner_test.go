@@ -11,6 +11,7 @@ import (
 	"github.com/kyverno/policy-reporter/pkg/crd/api/policyreport/v1alpha2"
 	"github.com/kyverno/policy-reporter/pkg/openreports"
 	"github.com/kyverno/policy-reporter/pkg/report/result"
+	"github.com/kyverno/policy-reporter/pkg/validate"
 )
 
 func TestReconditioner(t *testing.T) {
@@ -54,7 +55,7 @@ func TestReconditioner(t *testing.T) {
 			},
 		}
 
-		rec := result.NewReconditioner(nil)
+		rec := result.NewReconditioner(nil, nil, nil)
 
 		report = rec.Prepare(report)
 		res := report.GetResults()[0]
@@ -125,7 +126,7 @@ func TestReconditioner(t *testing.T) {
 			"test": {
 				IDGenerators: result.NewIDGenerator([]string{"policy", "rule", "resource"}),
 			},
-		})
+		}, nil, nil)
 
 		report = rec.Prepare(report)
 		res := report.GetResults()[0]
@@ -177,7 +178,7 @@ func TestReconditioner(t *testing.T) {
 			"test": {
 				SelfassignNamespaces: true,
 			},
-		})
+		}, nil, nil)
 
 		report = rec.Prepare(report)
 		res := report.GetResults()[0]
@@ -187,4 +188,87 @@ func TestReconditioner(t *testing.T) {
 		assert.Equal(t, "Other", res.Category)
 		assert.Equal(t, *report.GetScope(), res.Subjects[0])
 	})
+	t.Run("prepare with overrides", func(t *testing.T) {
+		t.Parallel()
+		properties := map[string]string{"version": "1.2.0"}
+
+		var report openreports.ReportInterface = &openreports.ReportAdapter{
+			Report: &v1alpha1.Report{
+				ObjectMeta: v1.ObjectMeta{
+					Name:      "policy-report",
+					Namespace: "test",
+				},
+				Results: []v1alpha1.ReportResult{
+					{
+						Result:     v1alpha2.StatusFail,
+						Policy:     "cis-5.1.1",
+						Rule:       "cluster-admin",
+						Source:     "kube-bench",
+						Properties: properties,
+					},
+					{
+						Result:   v1alpha2.StatusFail,
+						Policy:   "require-labels",
+						Source:   "kyverno",
+						Severity: v1alpha2.SeverityLow,
+					},
+				},
+			},
+		}
+
+		rec := result.NewReconditioner(nil, []result.Override{
+			{
+				Match:    result.OverrideMatchers{Sources: validate.RuleSets{Include: []string{"kube-bench"}}, Severities: validate.RuleSets{Include: []string{""}}},
+				Severity: v1alpha2.SeverityMedium,
+			},
+			{
+				Match:      result.OverrideMatchers{Policies: validate.RuleSets{Include: []string{"cis-5.*"}}},
+				Category:   "RBAC",
+				Properties: map[string]string{"team": "platform"},
+			},
+		}, nil)
+
+		report = rec.Prepare(report)
+		results := report.GetResults()
+
+		assert.Equal(t, v1alpha1.ResultSeverity(v1alpha2.SeverityMedium), results[0].Severity)
+		assert.Equal(t, "RBAC", results[0].Category)
+		assert.Equal(t, map[string]string{"version": "1.2.0", "team": "platform"}, results[0].Properties)
+		assert.Equal(t, map[string]string{"version": "1.2.0"}, properties)
+
+		assert.Equal(t, v1alpha1.ResultSeverity(v1alpha2.SeverityLow), results[1].Severity)
+		assert.Equal(t, "Other", results[1].Category)
+	})
+	t.Run("prepare with cluster", func(t *testing.T) {
+		t.Parallel()
+		newReport := func() openreports.ReportInterface {
+			return &openreports.ReportAdapter{
+				Report: &v1alpha1.Report{
+					ObjectMeta: v1.ObjectMeta{
+						Name:      "policy-report",
+						Namespace: "test",
+					},
+					Results: []v1alpha1.ReportResult{
+						{
+							Result: v1alpha2.StatusFail,
+							Policy: "require-labels",
+							Source: "kyverno",
+						},
+					},
+				},
+			}
+		}
+
+		rec := result.NewReconditioner(nil, nil, nil)
+
+		untagged := rec.Prepare(newReport())
+		tagged := rec.WithCluster("cluster-a").Prepare(newReport())
+
+		assert.Equal(t, "", openreports.Cluster(untagged))
+		assert.Equal(t, "cluster-a", openreports.Cluster(tagged))
+		assert.NotEqual(t, untagged.GetID(), tagged.GetID())
+		assert.Equal(t, openreports.ClusterID("cluster-a", untagged.GetResults()[0].ID), tagged.GetResults()[0].ID)
+		assert.Equal(t, "cluster-a", tagged.GetResults()[0].Properties[result.ClusterProperty])
+		assert.Empty(t, untagged.GetResults()[0].Properties)
+	})
 }
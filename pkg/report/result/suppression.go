@@ -0,0 +1,256 @@
+package result
+
+import (
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/kyverno/policy-reporter/pkg/crd/api/policyreport/v1alpha2"
+	"github.com/kyverno/policy-reporter/pkg/kubernetes/resources"
+	"github.com/kyverno/policy-reporter/pkg/openreports"
+)
+
+const (
+	// SuppressAnnotation contains a comma separated list of "policy" or "policy/rule" entries
+	SuppressAnnotation = "policy-reporter.kyverno.io/suppress"
+	// SuppressUntilAnnotation is an optional RFC3339 timestamp or date after which the suppression expires
+	SuppressUntilAnnotation = "policy-reporter.kyverno.io/suppress-until"
+	// SuppressReasonAnnotation is an optional reason of the suppression
+	SuppressReasonAnnotation = "policy-reporter.kyverno.io/suppress-reason"
+
+	SuppressedProperty       = "suppressed"
+	SuppressedReasonProperty = "suppressed.reason"
+	SuppressedUntilProperty  = "suppressed.until"
+
+	// SuppressionInterval in which remembered results are re-evaluated
+	SuppressionInterval = time.Minute
+
+	// suppressionRetention covers multiple informer resyncs, which process all reports again
+	suppressionRetention = time.Hour
+)
+
+// IsSuppressed checks if the result was marked as suppressed during ingestion
+func IsSuppressed(result openreports.ResultAdapter) bool {
+	return result.Properties[SuppressedProperty] == "true"
+}
+
+// Suppressor marks fail, warn and error results as suppressed
+// if the affected resource acknowledges the violation with the suppress annotations
+type Suppressor struct {
+	client resources.Client
+	now    func() time.Time
+
+	mx     sync.Mutex
+	checks map[string]*suppressionCheck
+}
+
+// suppressionCheck is an evaluated result, re-evaluated to detect expired or changed suppressions
+type suppressionCheck struct {
+	report     string
+	resource   *corev1.ObjectReference
+	policy     string
+	rule       string
+	suppressed bool
+	seen       time.Time
+}
+
+// Enrich adds the suppressed properties to matching results
+func (s *Suppressor) Enrich(polr openreports.ReportInterface, result openreports.ResultAdapter) openreports.ResultAdapter {
+	if s == nil {
+		return result
+	}
+
+	if result.Result != v1alpha2.StatusFail && result.Result != v1alpha2.StatusWarn && result.Result != v1alpha2.StatusError {
+		return result
+	}
+
+	resource := Resource(polr, result)
+	if resource == nil || resource.Name == "" {
+		return result
+	}
+
+	metadata, err := s.client.Metadata(resource)
+	if err != nil {
+		zap.L().Debug("failed to get resource metadata for suppression", zap.String("resource", openreports.ToResourceString(resource)), zap.Error(err))
+		return result
+	}
+
+	annotations := metadata.GetAnnotations()
+	suppressed := s.suppressed(annotations, resource, result.Policy, result.Rule)
+
+	s.remember(polr, resource, result.Policy, result.Rule, suppressed)
+
+	if !suppressed {
+		return result
+	}
+
+	// copy the properties to not modify the shared map of the original report
+	properties := make(map[string]string, len(result.Properties)+3)
+	for k, v := range result.Properties {
+		properties[k] = v
+	}
+
+	properties[SuppressedProperty] = "true"
+	if reason := annotations[SuppressReasonAnnotation]; reason != "" {
+		properties[SuppressedReasonProperty] = reason
+	}
+	if until := strings.TrimSpace(annotations[SuppressUntilAnnotation]); until != "" {
+		properties[SuppressedUntilProperty] = until
+	}
+
+	result.Properties = properties
+
+	return result
+}
+
+// Run re-evaluates the remembered results in the given interval until stop is closed,
+// requeue is called with the namespace/name key of each report with changed suppressions
+func (s *Suppressor) Run(interval time.Duration, requeue func(key string), stop <-chan struct{}) {
+	if s == nil {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			for _, key := range s.Reevaluate() {
+				requeue(key)
+			}
+		case <-stop:
+			return
+		}
+	}
+}
+
+// Reevaluate returns the keys of reports with expired, added, changed or removed suppressions.
+// Results which were not processed again within the retention are forgotten.
+// The metadata is fetched without holding the lock to not block the enrichment of processed reports.
+func (s *Suppressor) Reevaluate() []string {
+	now := s.now()
+
+	s.mx.Lock()
+	checks := make(map[string]*suppressionCheck, len(s.checks))
+	for key, check := range s.checks {
+		if now.Sub(check.seen) > suppressionRetention {
+			delete(s.checks, key)
+			continue
+		}
+
+		checks[key] = check
+	}
+	s.mx.Unlock()
+
+	results := make(map[string]bool, len(checks))
+	for key, check := range checks {
+		metadata, err := s.client.Metadata(check.resource)
+		if err != nil {
+			continue
+		}
+
+		results[key] = s.suppressed(metadata.GetAnnotations(), check.resource, check.policy, check.rule)
+	}
+
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	changed := make(map[string]bool)
+	for key, suppressed := range results {
+		check := checks[key]
+
+		// skip checks which were forgotten or replaced by a newer evaluation in the meantime
+		if s.checks[key] != check || suppressed == check.suppressed {
+			continue
+		}
+
+		// updated before the report is processed again to requeue it only once
+		check.suppressed = suppressed
+		changed[check.report] = true
+	}
+
+	return slices.Sorted(maps.Keys(changed))
+}
+
+func (s *Suppressor) suppressed(annotations map[string]string, resource *corev1.ObjectReference, policy, rule string) bool {
+	if !matchSuppression(annotations[SuppressAnnotation], policy, rule) {
+		return false
+	}
+
+	until := strings.TrimSpace(annotations[SuppressUntilAnnotation])
+	if until == "" {
+		return true
+	}
+
+	expiry, err := parseExpiry(until)
+	if err != nil {
+		zap.L().Warn("invalid suppression expiry, suppression ignored", zap.String("resource", openreports.ToResourceString(resource)), zap.String("until", until))
+		return false
+	}
+
+	return s.now().Before(expiry)
+}
+
+func (s *Suppressor) remember(polr openreports.ReportInterface, resource *corev1.ObjectReference, policy, rule string, suppressed bool) {
+	report := polr.GetName()
+	if polr.GetNamespace() != "" {
+		report = polr.GetNamespace() + "/" + report
+	}
+
+	key := strings.Join([]string{report, openreports.ToResourceString(resource), string(resource.UID), policy, rule}, "|")
+
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	s.checks[key] = &suppressionCheck{
+		report:     report,
+		resource:   resource,
+		policy:     policy,
+		rule:       rule,
+		suppressed: suppressed,
+		seen:       s.now(),
+	}
+}
+
+func matchSuppression(value, policy, rule string) bool {
+	for _, entry := range strings.Split(value, ",") {
+		p, r, found := strings.Cut(strings.TrimSpace(entry), "/")
+		if p == "" || p != policy {
+			continue
+		}
+
+		if !found || r == rule {
+			return true
+		}
+	}
+
+	return false
+}
+
+func parseExpiry(value string) (time.Time, error) {
+	if expiry, err := time.Parse(time.RFC3339, value); err == nil {
+		return expiry, nil
+	}
+
+	// a date suppresses until the end of the day
+	expiry, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return expiry.AddDate(0, 0, 1), nil
+}
+
+func NewSuppressor(client resources.Client) *Suppressor {
+	if client == nil {
+		return nil
+	}
+
+	return &Suppressor{client: client, now: time.Now, checks: make(map[string]*suppressionCheck)}
+}
@@ -0,0 +1,209 @@
+package result_test
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kyverno/policy-reporter/pkg/fixtures"
+	"github.com/kyverno/policy-reporter/pkg/report/result"
+)
+
+type resourceClient struct {
+	annotations map[string]string
+}
+
+func (c *resourceClient) Metadata(ref *corev1.ObjectReference) (*metav1.PartialObjectMetadata, error) {
+	if c.annotations == nil {
+		return nil, errors.New("not found")
+	}
+
+	return &metav1.PartialObjectMetadata{ObjectMeta: metav1.ObjectMeta{Name: ref.Name, Annotations: c.annotations}}, nil
+}
+
+// blockingClient blocks the metadata request with the given call number until release is closed
+type blockingClient struct {
+	resourceClient
+	block   int32
+	calls   atomic.Int32
+	entered chan struct{}
+	release chan struct{}
+}
+
+func (c *blockingClient) Metadata(ref *corev1.ObjectReference) (*metav1.PartialObjectMetadata, error) {
+	if c.calls.Add(1) == c.block {
+		close(c.entered)
+		<-c.release
+	}
+
+	return c.resourceClient.Metadata(ref)
+}
+
+func TestSuppressor(t *testing.T) {
+	t.Parallel()
+	t.Run("suppress policy rule", func(t *testing.T) {
+		t.Parallel()
+		suppressor := result.NewSuppressor(&resourceClient{annotations: map[string]string{
+			result.SuppressAnnotation:       "require-labels, require-requests-and-limits-required/autogen-check-for-requests-and-limits",
+			result.SuppressUntilAnnotation:  "2999-12-31",
+			result.SuppressReasonAnnotation: "tracked in JIRA-123",
+		}})
+
+		res := suppressor.Enrich(fixtures.DefaultPolicyReport, fixtures.FailResult)
+
+		assert.True(t, result.IsSuppressed(res))
+		assert.Equal(t, "tracked in JIRA-123", res.Properties[result.SuppressedReasonProperty])
+		assert.Equal(t, "2999-12-31", res.Properties[result.SuppressedUntilProperty])
+		assert.False(t, result.IsSuppressed(fixtures.FailResult), "the original result should not be modified")
+	})
+	t.Run("suppress all rules of a policy", func(t *testing.T) {
+		t.Parallel()
+		suppressor := result.NewSuppressor(&resourceClient{annotations: map[string]string{
+			result.SuppressAnnotation: "require-requests-and-limits-required",
+		}})
+
+		res := suppressor.Enrich(fixtures.DefaultPolicyReport, fixtures.FailResult)
+
+		assert.True(t, result.IsSuppressed(res))
+		assert.NotContains(t, res.Properties, result.SuppressedUntilProperty)
+	})
+	t.Run("ignore other rules", func(t *testing.T) {
+		t.Parallel()
+		suppressor := result.NewSuppressor(&resourceClient{annotations: map[string]string{
+			result.SuppressAnnotation: "require-requests-and-limits-required/other-rule",
+		}})
+
+		assert.False(t, result.IsSuppressed(suppressor.Enrich(fixtures.DefaultPolicyReport, fixtures.FailResult)))
+	})
+	t.Run("ignore expired and invalid suppressions", func(t *testing.T) {
+		t.Parallel()
+		for _, until := range []string{"2000-01-01", "2000-01-01T10:00:00Z", "next week"} {
+			suppressor := result.NewSuppressor(&resourceClient{annotations: map[string]string{
+				result.SuppressAnnotation:      "require-requests-and-limits-required",
+				result.SuppressUntilAnnotation: until,
+			}})
+
+			assert.False(t, result.IsSuppressed(suppressor.Enrich(fixtures.DefaultPolicyReport, fixtures.FailResult)), until)
+		}
+	})
+	t.Run("ignore pass results", func(t *testing.T) {
+		t.Parallel()
+		suppressor := result.NewSuppressor(&resourceClient{annotations: map[string]string{
+			result.SuppressAnnotation: "require-requests-and-limits-required",
+		}})
+
+		assert.False(t, result.IsSuppressed(suppressor.Enrich(fixtures.DefaultPolicyReport, fixtures.PassResult)))
+	})
+	t.Run("ignore unknown resources", func(t *testing.T) {
+		t.Parallel()
+		suppressor := result.NewSuppressor(&resourceClient{})
+
+		assert.False(t, result.IsSuppressed(suppressor.Enrich(fixtures.DefaultPolicyReport, fixtures.FailResult)))
+	})
+	t.Run("nil suppressor", func(t *testing.T) {
+		t.Parallel()
+		suppressor := result.NewSuppressor(nil)
+
+		assert.Nil(t, suppressor)
+		assert.Equal(t, fixtures.FailResult, suppressor.Enrich(fixtures.DefaultPolicyReport, fixtures.FailResult))
+	})
+}
+
+func TestSuppressorReevaluate(t *testing.T) {
+	t.Parallel()
+	t.Run("changed annotations", func(t *testing.T) {
+		t.Parallel()
+		client := &resourceClient{annotations: map[string]string{result.SuppressAnnotation: "require-requests-and-limits-required"}}
+		suppressor := result.NewSuppressor(client)
+
+		assert.True(t, result.IsSuppressed(suppressor.Enrich(fixtures.DefaultPolicyReport, fixtures.FailResult)))
+		assert.Empty(t, suppressor.Reevaluate())
+
+		client.annotations = map[string]string{}
+
+		assert.Equal(t, []string{"test/policy-report"}, suppressor.Reevaluate())
+		assert.Empty(t, suppressor.Reevaluate(), "changed reports should be returned once")
+
+		client.annotations = map[string]string{result.SuppressAnnotation: "require-requests-and-limits-required"}
+
+		assert.Equal(t, []string{"test/policy-report"}, suppressor.Reevaluate())
+	})
+	t.Run("expired suppression", func(t *testing.T) {
+		t.Parallel()
+		suppressor := result.NewSuppressor(&resourceClient{annotations: map[string]string{
+			result.SuppressAnnotation:      "require-requests-and-limits-required",
+			result.SuppressUntilAnnotation: time.Now().Add(200 * time.Millisecond).Format(time.RFC3339Nano),
+		}})
+
+		assert.True(t, result.IsSuppressed(suppressor.Enrich(fixtures.DefaultPolicyReport, fixtures.FailResult)))
+
+		time.Sleep(300 * time.Millisecond)
+
+		assert.Equal(t, []string{"test/policy-report"}, suppressor.Reevaluate())
+	})
+	t.Run("enrich during metadata requests", func(t *testing.T) {
+		t.Parallel()
+		client := &blockingClient{
+			resourceClient: resourceClient{annotations: map[string]string{result.SuppressAnnotation: "require-requests-and-limits-required"}},
+			block:          2,
+			entered:        make(chan struct{}),
+			release:        make(chan struct{}),
+		}
+		suppressor := result.NewSuppressor(client)
+		suppressor.Enrich(fixtures.DefaultPolicyReport, fixtures.FailResult)
+
+		done := make(chan []string)
+		go func() { done <- suppressor.Reevaluate() }()
+
+		<-client.entered
+
+		enriched := make(chan bool, 1)
+		go func() {
+			enriched <- result.IsSuppressed(suppressor.Enrich(fixtures.DefaultPolicyReport, fixtures.FailResult))
+		}()
+
+		select {
+		case suppressed := <-enriched:
+			assert.True(t, suppressed)
+		case <-time.After(time.Second):
+			t.Error("expected enrich not to wait for the re-evaluation")
+		}
+
+		close(client.release)
+		assert.Empty(t, <-done)
+	})
+	t.Run("requeue changed reports", func(t *testing.T) {
+		t.Parallel()
+		client := &resourceClient{annotations: map[string]string{result.SuppressAnnotation: "require-requests-and-limits-required"}}
+		suppressor := result.NewSuppressor(client)
+		suppressor.Enrich(fixtures.DefaultPolicyReport, fixtures.FailResult)
+
+		client.annotations = map[string]string{}
+
+		stop := make(chan struct{})
+		keys := make(chan string, 1)
+
+		go suppressor.Run(10*time.Millisecond, func(key string) { keys <- key }, stop)
+		defer close(stop)
+
+		select {
+		case key := <-keys:
+			assert.Equal(t, "test/policy-report", key)
+		case <-time.After(time.Second):
+			t.Error("expected the report to be requeued")
+		}
+	})
+}
+
+func TestReconditionerSuppressor(t *testing.T) {
+	t.Parallel()
+	suppressor := result.NewSuppressor(&resourceClient{})
+
+	assert.Same(t, suppressor, result.NewReconditioner(nil, nil, []result.Enricher{suppressor}).Suppressor())
+	assert.Nil(t, result.NewReconditioner(nil, nil, nil).Suppressor())
+}
@@ -6,16 +6,24 @@ import (
 
 type ResultValidation = func(openreports.ResultAdapter) bool
 
+type ReportResultValidation = func(openreports.ReportInterface, openreports.ResultAdapter) bool
+
 type ResultFilter struct {
-	validations     []ResultValidation
-	Sources         []string
-	MinimumSeverity string
+	validations       []ResultValidation
+	reportValidations []ReportResultValidation
+	Sources           []string
+	MinimumSeverity   string
 }
 
 func (rf *ResultFilter) AddValidation(v ResultValidation) {
 	rf.validations = append(rf.validations, v)
 }
 
+// AddReportValidation adds a validation which depends on the report of the result
+func (rf *ResultFilter) AddReportValidation(v ReportResultValidation) {
+	rf.reportValidations = append(rf.reportValidations, v)
+}
+
 func (rf *ResultFilter) Validate(result openreports.ResultAdapter) bool {
 	for _, validation := range rf.validations {
 		if !validation(result) {
@@ -26,6 +34,21 @@ func (rf *ResultFilter) Validate(result openreports.ResultAdapter) bool {
 	return true
 }
 
+// ValidateWithReport runs all validations including the report dependent ones
+func (rf *ResultFilter) ValidateWithReport(report openreports.ReportInterface, result openreports.ResultAdapter) bool {
+	if !rf.Validate(result) {
+		return false
+	}
+
+	for _, validation := range rf.reportValidations {
+		if !validation(report, result) {
+			return false
+		}
+	}
+
+	return true
+}
+
 func NewResultFilter() *ResultFilter {
 	return &ResultFilter{}
 }
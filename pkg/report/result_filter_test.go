@@ -25,4 +25,17 @@ func Test_ResultFilter(t *testing.T) {
 			t.Error("Expected result validates to false")
 		}
 	})
+	t.Run("filter result with a false report validation", func(t *testing.T) {
+		t.Parallel()
+		filter := report.NewResultFilter()
+		filter.AddReportValidation(func(rep openreports.ReportInterface, r openreports.ResultAdapter) bool {
+			return rep.GetNamespace() != fixtures.DefaultPolicyReport.GetNamespace()
+		})
+		if !filter.Validate(fixtures.FailResult) {
+			t.Error("Expected result without report validates to true")
+		}
+		if filter.ValidateWithReport(fixtures.DefaultPolicyReport, fixtures.FailResult) {
+			t.Error("Expected result validates to false")
+		}
+	})
 }
@@ -11,10 +11,12 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	gocache "zgo.at/zcache/v2"
 
+	"github.com/kyverno/policy-reporter/pkg/expression"
 	"github.com/kyverno/policy-reporter/pkg/helper"
 	"github.com/kyverno/policy-reporter/pkg/kubernetes/jobs"
 	"github.com/kyverno/policy-reporter/pkg/kubernetes/pods"
 	"github.com/kyverno/policy-reporter/pkg/kubernetes/replicasets"
+	"github.com/kyverno/policy-reporter/pkg/kubernetes/resources"
 	"github.com/kyverno/policy-reporter/pkg/openreports"
 	"github.com/kyverno/policy-reporter/pkg/validate"
 )
@@ -32,12 +34,16 @@ type SourceValidation struct {
 	Namespaces            validate.RuleSets
 	UncontrolledOnly      bool
 	DisableClusterReports bool
+	ResourceLabels        validate.RuleSets
+	ResourceAnnotations   validate.RuleSets
+	Expression            *expression.Program
 }
 
 type SourceFilter struct {
 	pods        pods.Client
 	jobs        jobs.Client
 	replicasets replicasets.Client
+	resources   resources.Client
 	validations []SourceValidation
 	controlled  *gocache.Cache[types.UID, bool]
 }
@@ -72,6 +78,11 @@ func (s *SourceFilter) run(polr openreports.ReportInterface, options SourceValid
 		return false
 	}
 
+	if options.Expression != nil && !options.Expression.MatchReport(polr) {
+		logger.Debug("filter report by expression", zap.String("expression", options.Expression.Expression()))
+		return false
+	}
+
 	scope := polr.GetScope()
 	if scope == nil {
 		return true
@@ -99,6 +110,11 @@ func (s *SourceFilter) run(polr openreports.ReportInterface, options SourceValid
 		return false
 	}
 
+	if (options.ResourceLabels.Enabled() || options.ResourceAnnotations.Enabled()) && !ValidateResourceMetadata(s.resources, scope, options.ResourceLabels, options.ResourceAnnotations) {
+		logger.Debug("filter scope resource metadata")
+		return false
+	}
+
 	if !options.UncontrolledOnly {
 		return true
 	}
@@ -170,8 +186,8 @@ func (s *SourceFilter) cache(uid types.UID, controlled bool) {
 	s.controlled.Set(uid, controlled)
 }
 
-func NewSourceFilter(pods pods.Client, jobs jobs.Client, rs replicasets.Client, cache *gocache.Cache[types.UID, bool], validations []SourceValidation) *SourceFilter {
-	return &SourceFilter{pods: pods, jobs: jobs, replicasets: rs, controlled: cache, validations: validations}
+func NewSourceFilter(pods pods.Client, jobs jobs.Client, rs replicasets.Client, resources resources.Client, cache *gocache.Cache[types.UID, bool], validations []SourceValidation) *SourceFilter {
+	return &SourceFilter{pods: pods, jobs: jobs, replicasets: rs, resources: resources, controlled: cache, validations: validations}
 }
 
 var podControllers = map[string]bool{
@@ -44,7 +44,7 @@ func TestSourceFilter(t *testing.T) {
 	t.Parallel()
 	t.Run("include by namespace succeed", func(t *testing.T) {
 		t.Parallel()
-		filter := report.NewSourceFilter(nil, nil, nil, gocache.New[types.UID, bool](gocache.DefaultExpiration, 0), []report.SourceValidation{
+		filter := report.NewSourceFilter(nil, nil, nil, nil, gocache.New[types.UID, bool](gocache.DefaultExpiration, 0), []report.SourceValidation{
 			{
 				Selector: report.ReportSelector{
 					Sources: []string{"kyverno"},
@@ -66,7 +66,7 @@ func TestSourceFilter(t *testing.T) {
 
 	t.Run("include by namespace fails", func(t *testing.T) {
 		t.Parallel()
-		filter := report.NewSourceFilter(nil, nil, nil, gocache.New[types.UID, bool](gocache.DefaultExpiration, 0), []report.SourceValidation{
+		filter := report.NewSourceFilter(nil, nil, nil, nil, gocache.New[types.UID, bool](gocache.DefaultExpiration, 0), []report.SourceValidation{
 			{
 				Selector: report.ReportSelector{
 					Source: "kyverno",
@@ -88,7 +88,7 @@ func TestSourceFilter(t *testing.T) {
 
 	t.Run("include by kind succeed", func(t *testing.T) {
 		t.Parallel()
-		filter := report.NewSourceFilter(nil, nil, nil, gocache.New[types.UID, bool](gocache.DefaultExpiration, 0), []report.SourceValidation{
+		filter := report.NewSourceFilter(nil, nil, nil, nil, gocache.New[types.UID, bool](gocache.DefaultExpiration, 0), []report.SourceValidation{
 			{
 				Selector: report.ReportSelector{
 					Source: "kyverno",
@@ -110,7 +110,7 @@ func TestSourceFilter(t *testing.T) {
 
 	t.Run("include by kind fails", func(t *testing.T) {
 		t.Parallel()
-		filter := report.NewSourceFilter(nil, nil, nil, gocache.New[types.UID, bool](gocache.DefaultExpiration, 0), []report.SourceValidation{
+		filter := report.NewSourceFilter(nil, nil, nil, nil, gocache.New[types.UID, bool](gocache.DefaultExpiration, 0), []report.SourceValidation{
 			{
 				Selector: report.ReportSelector{
 					Source: "kyverno",
@@ -132,7 +132,7 @@ func TestSourceFilter(t *testing.T) {
 
 	t.Run("disable cluster reports", func(t *testing.T) {
 		t.Parallel()
-		filter := report.NewSourceFilter(nil, nil, nil, gocache.New[types.UID, bool](gocache.DefaultExpiration, 0), []report.SourceValidation{
+		filter := report.NewSourceFilter(nil, nil, nil, nil, gocache.New[types.UID, bool](gocache.DefaultExpiration, 0), []report.SourceValidation{
 			{
 				Selector: report.ReportSelector{
 					Source: "kyverno",
@@ -154,7 +154,7 @@ func TestSourceFilter(t *testing.T) {
 
 	t.Run("include by kind succeed", func(t *testing.T) {
 		t.Parallel()
-		filter := report.NewSourceFilter(nil, nil, nil, gocache.New[types.UID, bool](gocache.DefaultExpiration, 0), []report.SourceValidation{
+		filter := report.NewSourceFilter(nil, nil, nil, nil, gocache.New[types.UID, bool](gocache.DefaultExpiration, 0), []report.SourceValidation{
 			{
 				Selector: report.ReportSelector{
 					Source: "kyverno",
@@ -181,7 +181,7 @@ func TestSourceFilter(t *testing.T) {
 			}}},
 		}
 
-		filter := report.NewSourceFilter(&c, nil, nil, gocache.New[types.UID, bool](gocache.DefaultExpiration, 0), []report.SourceValidation{
+		filter := report.NewSourceFilter(&c, nil, nil, nil, gocache.New[types.UID, bool](gocache.DefaultExpiration, 0), []report.SourceValidation{
 			{
 				Selector: report.ReportSelector{
 					Source: "kyverno",
@@ -213,7 +213,7 @@ func TestSourceFilter(t *testing.T) {
 			}}},
 		}
 
-		filter := report.NewSourceFilter(nil, &c, nil, gocache.New[types.UID, bool](gocache.DefaultExpiration, 0), []report.SourceValidation{
+		filter := report.NewSourceFilter(nil, &c, nil, nil, gocache.New[types.UID, bool](gocache.DefaultExpiration, 0), []report.SourceValidation{
 			{
 				Selector: report.ReportSelector{
 					Source: "kyverno",
@@ -245,7 +245,7 @@ func TestSourceFilter(t *testing.T) {
 			}}},
 		}
 
-		filter := report.NewSourceFilter(&c, nil, nil, gocache.New[types.UID, bool](gocache.DefaultExpiration, 0), []report.SourceValidation{
+		filter := report.NewSourceFilter(&c, nil, nil, nil, gocache.New[types.UID, bool](gocache.DefaultExpiration, 0), []report.SourceValidation{
 			{
 				Selector: report.ReportSelector{
 					Source: "kyverno",
@@ -269,7 +269,7 @@ func TestSourceFilter(t *testing.T) {
 			}}},
 		}
 
-		filter := report.NewSourceFilter(nil, &c, nil, gocache.New[types.UID, bool](gocache.DefaultExpiration, 0), []report.SourceValidation{
+		filter := report.NewSourceFilter(nil, &c, nil, nil, gocache.New[types.UID, bool](gocache.DefaultExpiration, 0), []report.SourceValidation{
 			{
 				Selector: report.ReportSelector{
 					Source: "kyverno",
@@ -294,7 +294,7 @@ func TestSourceFilter(t *testing.T) {
 			}}},
 		}
 
-		filter := report.NewSourceFilter(&c, nil, nil, gocache.New[types.UID, bool](gocache.DefaultExpiration, 0), []report.SourceValidation{
+		filter := report.NewSourceFilter(&c, nil, nil, nil, gocache.New[types.UID, bool](gocache.DefaultExpiration, 0), []report.SourceValidation{
 			{
 				Selector: report.ReportSelector{
 					Source: "kyverno",
@@ -319,7 +319,7 @@ func TestSourceFilter(t *testing.T) {
 			}}},
 		}
 
-		filter := report.NewSourceFilter(nil, &c, nil, gocache.New[types.UID, bool](gocache.DefaultExpiration, 0), []report.SourceValidation{
+		filter := report.NewSourceFilter(nil, &c, nil, nil, gocache.New[types.UID, bool](gocache.DefaultExpiration, 0), []report.SourceValidation{
 			{
 				Selector: report.ReportSelector{
 					Source: "kyverno",
@@ -343,7 +343,7 @@ func TestSourceFilter(t *testing.T) {
 			}}},
 		}
 
-		filter := report.NewSourceFilter(&c, nil, nil, gocache.New[types.UID, bool](gocache.DefaultExpiration, 0), []report.SourceValidation{
+		filter := report.NewSourceFilter(&c, nil, nil, nil, gocache.New[types.UID, bool](gocache.DefaultExpiration, 0), []report.SourceValidation{
 			{
 				Selector: report.ReportSelector{
 					Source: "kyverno",
@@ -367,7 +367,7 @@ func TestSourceFilter(t *testing.T) {
 			}}},
 		}
 
-		filter := report.NewSourceFilter(nil, &c, nil, gocache.New[types.UID, bool](gocache.DefaultExpiration, 0), []report.SourceValidation{
+		filter := report.NewSourceFilter(nil, &c, nil, nil, gocache.New[types.UID, bool](gocache.DefaultExpiration, 0), []report.SourceValidation{
 			{
 				Selector: report.ReportSelector{
 					Source: "kyverno",
@@ -382,4 +382,33 @@ func TestSourceFilter(t *testing.T) {
 			Results:    []v1alpha1.ReportResult{fixtures.FailPodResult.ReportResult},
 		}}))
 	})
+
+	t.Run("exclude scope resources by annotation", func(t *testing.T) {
+		t.Parallel()
+		c := &resourceClient{objects: map[string]*v1.PartialObjectMetadata{
+			"nginx": {ObjectMeta: v1.ObjectMeta{Annotations: map[string]string{"policy-reporter.io/ignore": "true"}}},
+		}}
+
+		filter := report.NewSourceFilter(nil, nil, nil, c, gocache.New[types.UID, bool](gocache.DefaultExpiration, 0), []report.SourceValidation{
+			{
+				Selector: report.ReportSelector{
+					Source: "kyverno",
+				},
+				ResourceAnnotations: validate.RuleSets{
+					Exclude: []string{"policy-reporter.io/ignore:true"},
+				},
+			},
+		})
+
+		assert.False(t, filter.Validate(&openreports.ReportAdapter{Report: &v1alpha1.Report{
+			ObjectMeta: v1.ObjectMeta{Name: "polr", Namespace: "test"},
+			Scope:      &corev1.ObjectReference{APIVersion: "v1", Kind: "Pod", Name: "nginx", Namespace: "test"},
+			Results:    []v1alpha1.ReportResult{fixtures.FailPodResult.ReportResult},
+		}}))
+		assert.True(t, filter.Validate(&openreports.ReportAdapter{Report: &v1alpha1.Report{
+			ObjectMeta: v1.ObjectMeta{Name: "polr", Namespace: "test"},
+			Scope:      &corev1.ObjectReference{APIVersion: "v1", Kind: "Pod", Name: "redis", Namespace: "test"},
+			Results:    []v1alpha1.ReportResult{fixtures.FailPodResult.ReportResult},
+		}}))
+	})
 }
@@ -23,6 +23,13 @@ type PolicyReportStore interface {
 	CleanUp(ctx context.Context) error
 }
 
+type FindingStore interface {
+	// UpdateFindings tracks the violations of the given PolicyReport
+	UpdateFindings(ctx context.Context, r openreports.ReportInterface) error
+	// ResolveFindings resolves all open violations of the PolicyReport with the given ID
+	ResolveFindings(ctx context.Context, id string) error
+}
+
 // PolicyReportStore caches the latest version of an PolicyReport
 type policyReportStore struct {
 	store map[string]map[string]openreports.ReportInterface
@@ -0,0 +1,249 @@
+package routing
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/kyverno/go-wildcard"
+	"go.uber.org/zap"
+
+	"github.com/kyverno/policy-reporter/pkg/kubernetes/namespaces"
+	"github.com/kyverno/policy-reporter/pkg/openreports"
+	"github.com/kyverno/policy-reporter/pkg/validate"
+)
+
+// Matchers of a route, all configured matchers have to match
+type Matchers struct {
+	Namespaces      validate.RuleSets
+	NamespaceLabels map[string]string
+	Policies        validate.RuleSets
+	Severities      validate.RuleSets
+	Sources         validate.RuleSets
+	ReportLabels    map[string]string
+}
+
+// Route of the routing tree, child routes without a receiver inherit the receiver of their parent
+type Route struct {
+	Receiver string
+	Continue bool
+	Matchers Matchers
+	Routes   []*Route
+}
+
+// Receiver is a named group of existing targets
+type Receiver struct {
+	Name    string
+	Targets []string
+}
+
+// Destinations of a single result, the zero value allows all targets
+type Destinations struct {
+	routed  map[string]bool
+	targets map[string]bool
+}
+
+// Allows reports if the result should be sent to the given target,
+// targets without a receiver are not controlled by the routing tree
+func (d Destinations) Allows(target string) bool {
+	return !d.routed[target] || d.targets[target]
+}
+
+// Router evaluates the routing tree for each result
+type Router struct {
+	root      *Route
+	receivers map[string][]string
+	routed    map[string]bool
+	client    namespaces.Client
+}
+
+// Route resolves the destinations of the given result, a nil router allows all targets
+func (r *Router) Route(report openreports.ReportInterface, result openreports.ResultAdapter) Destinations {
+	if r == nil {
+		return Destinations{}
+	}
+
+	m := &match{report: report, result: result, client: r.client}
+
+	targets := make(map[string]bool)
+	for _, receiver := range r.root.route(m, r.root.Receiver) {
+		for _, t := range r.receivers[receiver] {
+			targets[t] = true
+		}
+	}
+
+	return Destinations{routed: r.routed, targets: targets}
+}
+
+// Routed returns if the given target is referenced by any receiver
+func (r *Router) Routed(target string) bool {
+	return r != nil && r.routed[target]
+}
+
+// UnknownTargets returns the sorted targets referenced by receivers which are not part of the given target names
+func (r *Router) UnknownTargets(names []string) []string {
+	if r == nil {
+		return nil
+	}
+
+	unknown := make([]string, 0)
+	for target := range r.routed {
+		if !slices.Contains(names, target) {
+			unknown = append(unknown, target)
+		}
+	}
+
+	slices.Sort(unknown)
+
+	return unknown
+}
+
+// route returns the receivers of the first matching child routes or the own receiver if no child matches
+func (r *Route) route(m *match, receiver string) []string {
+	if r.Receiver != "" {
+		receiver = r.Receiver
+	}
+
+	matched := false
+	receivers := make([]string, 0)
+
+	for _, child := range r.Routes {
+		if !child.Matchers.matches(m) {
+			continue
+		}
+
+		matched = true
+		receivers = append(receivers, child.route(m, receiver)...)
+
+		if !child.Continue {
+			break
+		}
+	}
+
+	if !matched && receiver != "" {
+		receivers = append(receivers, receiver)
+	}
+
+	return receivers
+}
+
+// match caches resolved values of a single result while walking the routing tree
+type match struct {
+	report     openreports.ReportInterface
+	result     openreports.ResultAdapter
+	client     namespaces.Client
+	namespaces map[string][]string
+}
+
+func (m *match) namespace() string {
+	if res := m.result.GetResource(); res != nil && res.Namespace != "" {
+		return res.Namespace
+	}
+
+	return m.report.GetNamespace()
+}
+
+func (m *match) labeledNamespaces(selector map[string]string) []string {
+	key := fmt.Sprint(selector)
+	if list, ok := m.namespaces[key]; ok {
+		return list
+	}
+
+	if m.namespaces == nil {
+		m.namespaces = make(map[string][]string)
+	}
+
+	list, err := m.client.List(context.Background(), selector)
+	if err != nil {
+		zap.L().Error("failed to resolve namespace selector of route", zap.Error(err))
+	}
+
+	m.namespaces[key] = list
+
+	return list
+}
+
+func (ma Matchers) matches(m *match) bool {
+	if ma.Namespaces.Count() > 0 && !validate.Namespace(m.namespace(), ma.Namespaces) {
+		return false
+	}
+
+	if len(ma.NamespaceLabels) > 0 {
+		if m.client == nil || m.namespace() == "" {
+			return false
+		}
+
+		if !validate.Namespace(m.namespace(), validate.RuleSets{Include: m.labeledNamespaces(ma.NamespaceLabels)}) {
+			return false
+		}
+	}
+
+	if ma.Policies.Count() > 0 && !validate.MatchRuleSet(m.result.Policy, ma.Policies) {
+		return false
+	}
+
+	if ma.Severities.Count() > 0 && !validate.ContainsRuleSet(string(m.result.Severity), ma.Severities) {
+		return false
+	}
+
+	if ma.Sources.Count() > 0 && !validate.MatchRuleSet(m.result.Source, ma.Sources) {
+		return false
+	}
+
+	labels := m.report.GetLabels()
+	for key, pattern := range ma.ReportLabels {
+		value, ok := labels[key]
+		if !ok || !wildcard.Match(pattern, value) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func validateRoute(route *Route, receivers map[string][]string) error {
+	if route.Receiver != "" {
+		if _, ok := receivers[route.Receiver]; !ok {
+			return fmt.Errorf("route references unknown receiver %s", route.Receiver)
+		}
+	}
+
+	for _, child := range route.Routes {
+		if err := validateRoute(child, receivers); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// NewRouter creates a router for the given routing tree, all referenced receivers have to exist
+func NewRouter(root *Route, receivers []Receiver, client namespaces.Client) (*Router, error) {
+	mapping := make(map[string][]string, len(receivers))
+	routed := make(map[string]bool)
+
+	for _, r := range receivers {
+		if r.Name == "" {
+			return nil, fmt.Errorf("receiver name is required")
+		}
+		if _, ok := mapping[r.Name]; ok {
+			return nil, fmt.Errorf("duplicated receiver %s", r.Name)
+		}
+
+		mapping[r.Name] = r.Targets
+		for _, t := range r.Targets {
+			routed[t] = true
+		}
+	}
+
+	if err := validateRoute(root, mapping); err != nil {
+		return nil, err
+	}
+
+	return &Router{
+		root:      root,
+		receivers: mapping,
+		routed:    routed,
+		client:    client,
+	}, nil
+}
@@ -0,0 +1,168 @@
+package routing_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kyverno/policy-reporter/pkg/fixtures"
+	"github.com/kyverno/policy-reporter/pkg/routing"
+	"github.com/kyverno/policy-reporter/pkg/validate"
+)
+
+type nsClient struct {
+	namespaces []string
+}
+
+func (c *nsClient) List(_ context.Context, _ map[string]string) ([]string, error) {
+	return c.namespaces, nil
+}
+
+func (c *nsClient) Labels(_ context.Context, _ string) (map[string]string, error) {
+	return nil, nil
+}
+
+func (c *nsClient) Annotations(_ context.Context, _ string) (map[string]string, error) {
+	return nil, nil
+}
+
+var receivers = []routing.Receiver{
+	{Name: "default", Targets: []string{"Slack"}},
+	{Name: "payments", Targets: []string{"Slack Payments"}},
+	{Name: "security", Targets: []string{"Jira"}},
+}
+
+func Test_Router(t *testing.T) {
+	t.Parallel()
+	t.Run("fallback to the root receiver", func(t *testing.T) {
+		t.Parallel()
+		router, err := routing.NewRouter(&routing.Route{
+			Receiver: "default",
+			Routes: []*routing.Route{
+				{Receiver: "payments", Matchers: routing.Matchers{Namespaces: validate.RuleSets{Include: []string{"payments"}}}},
+			},
+		}, receivers, nil)
+		assert.Nil(t, err)
+
+		destinations := router.Route(fixtures.DefaultPolicyReport, fixtures.FailResult)
+
+		assert.True(t, destinations.Allows("Slack"))
+		assert.False(t, destinations.Allows("Slack Payments"))
+		assert.True(t, destinations.Allows("Webhook"), "targets without receiver are not routed")
+	})
+	t.Run("first matching route wins", func(t *testing.T) {
+		t.Parallel()
+		router, err := routing.NewRouter(&routing.Route{
+			Receiver: "default",
+			Routes: []*routing.Route{
+				{Receiver: "payments", Matchers: routing.Matchers{Namespaces: validate.RuleSets{Include: []string{"te*"}}}},
+				{Receiver: "security", Matchers: routing.Matchers{Severities: validate.RuleSets{Include: []string{"high"}}}},
+			},
+		}, receivers, nil)
+		assert.Nil(t, err)
+
+		destinations := router.Route(fixtures.DefaultPolicyReport, fixtures.FailResult)
+
+		assert.True(t, destinations.Allows("Slack Payments"))
+		assert.False(t, destinations.Allows("Jira"))
+		assert.False(t, destinations.Allows("Slack"))
+	})
+	t.Run("continue with the next sibling", func(t *testing.T) {
+		t.Parallel()
+		router, err := routing.NewRouter(&routing.Route{
+			Receiver: "default",
+			Routes: []*routing.Route{
+				{Receiver: "payments", Continue: true, Matchers: routing.Matchers{Policies: validate.RuleSets{Include: []string{"require-*"}}}},
+				{Receiver: "security", Matchers: routing.Matchers{Sources: validate.RuleSets{Include: []string{"Kyverno"}}}},
+			},
+		}, receivers, nil)
+		assert.Nil(t, err)
+
+		destinations := router.Route(fixtures.DefaultPolicyReport, fixtures.FailResult)
+
+		assert.True(t, destinations.Allows("Slack Payments"))
+		assert.True(t, destinations.Allows("Jira"))
+		assert.False(t, destinations.Allows("Slack"))
+	})
+	t.Run("nested routes inherit the receiver", func(t *testing.T) {
+		t.Parallel()
+		router, err := routing.NewRouter(&routing.Route{
+			Receiver: "default",
+			Routes: []*routing.Route{
+				{
+					Receiver: "payments",
+					Matchers: routing.Matchers{Namespaces: validate.RuleSets{Include: []string{"test"}}},
+					Routes: []*routing.Route{
+						{Matchers: routing.Matchers{Severities: validate.RuleSets{Include: []string{"high"}}}},
+					},
+				},
+			},
+		}, receivers, nil)
+		assert.Nil(t, err)
+
+		destinations := router.Route(fixtures.DefaultPolicyReport, fixtures.FailResult)
+
+		assert.True(t, destinations.Allows("Slack Payments"))
+		assert.False(t, destinations.Allows("Slack"))
+	})
+	t.Run("match namespace labels", func(t *testing.T) {
+		t.Parallel()
+		route := &routing.Route{
+			Receiver: "default",
+			Routes: []*routing.Route{
+				{Receiver: "payments", Matchers: routing.Matchers{NamespaceLabels: map[string]string{"team": "payments"}}},
+			},
+		}
+
+		router, err := routing.NewRouter(route, receivers, &nsClient{namespaces: []string{"test"}})
+		assert.Nil(t, err)
+		assert.True(t, router.Route(fixtures.DefaultPolicyReport, fixtures.FailResult).Allows("Slack Payments"))
+
+		router, err = routing.NewRouter(route, receivers, &nsClient{namespaces: []string{"payments"}})
+		assert.Nil(t, err)
+		assert.False(t, router.Route(fixtures.DefaultPolicyReport, fixtures.FailResult).Allows("Slack Payments"))
+	})
+	t.Run("nil router allows all targets", func(t *testing.T) {
+		t.Parallel()
+		var router *routing.Router
+
+		assert.True(t, router.Route(fixtures.DefaultPolicyReport, fixtures.FailResult).Allows("Slack"))
+		assert.False(t, router.Routed("Slack"))
+	})
+}
+
+func Test_NewRouter(t *testing.T) {
+	t.Parallel()
+	t.Run("unknown receiver", func(t *testing.T) {
+		t.Parallel()
+		_, err := routing.NewRouter(&routing.Route{
+			Receiver: "default",
+			Routes:   []*routing.Route{{Receiver: "unknown"}},
+		}, receivers, nil)
+
+		assert.ErrorContains(t, err, "unknown receiver unknown")
+	})
+	t.Run("duplicated receiver", func(t *testing.T) {
+		t.Parallel()
+		_, err := routing.NewRouter(&routing.Route{}, append(receivers, routing.Receiver{Name: "default"}), nil)
+
+		assert.ErrorContains(t, err, "duplicated receiver default")
+	})
+	t.Run("routed targets", func(t *testing.T) {
+		t.Parallel()
+		router, err := routing.NewRouter(&routing.Route{Receiver: "default"}, receivers, nil)
+
+		assert.Nil(t, err)
+		assert.True(t, router.Routed("Jira"))
+		assert.False(t, router.Routed("Webhook"))
+	})
+	t.Run("unknown targets", func(t *testing.T) {
+		t.Parallel()
+		router, err := routing.NewRouter(&routing.Route{Receiver: "default"}, receivers, nil)
+
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"Jira", "Slack Payments"}, router.UnknownTargets([]string{"Slack", "Webhook"}))
+		assert.Empty(t, router.UnknownTargets([]string{"Slack", "Slack Payments", "Jira"}))
+	})
+}
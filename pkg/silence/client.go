@@ -0,0 +1,72 @@
+package silence
+
+import (
+	"fmt"
+
+	"go.uber.org/zap"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/kyverno/policy-reporter/pkg/crd/api/targetconfig/v1alpha1"
+	crds "github.com/kyverno/policy-reporter/pkg/crd/client/clientset/versioned"
+	informer "github.com/kyverno/policy-reporter/pkg/crd/client/informers/externalversions"
+)
+
+// Client keeps the Store in sync with the Silence resources of the cluster
+type Client struct {
+	informer cache.SharedIndexInformer
+	store    *Store
+}
+
+func (c *Client) ConfigureInformer() {
+	c.informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			c.update(obj.(*v1alpha1.Silence))
+		},
+		UpdateFunc: func(_, newObj interface{}) {
+			c.update(newObj.(*v1alpha1.Silence))
+		},
+		DeleteFunc: func(obj interface{}) {
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+
+			if s, ok := obj.(*v1alpha1.Silence); ok {
+				zap.L().Info("delete silence", zap.String("name", s.Name))
+				c.store.Remove(s.Name)
+			}
+		},
+	})
+}
+
+func (c *Client) update(obj *v1alpha1.Silence) {
+	s, err := FromCRD(obj)
+	if err != nil {
+		zap.L().Error("invalid silence is ignored", zap.String("name", obj.Name), zap.Error(err))
+		c.store.Remove(obj.Name)
+		return
+	}
+
+	zap.L().Info("update silence", zap.String("name", obj.Name))
+	c.store.Add(s)
+}
+
+func (c *Client) Run(stopChan chan struct{}) error {
+	go c.informer.Run(stopChan)
+
+	if !cache.WaitForCacheSync(stopChan, c.informer.HasSynced) {
+		return fmt.Errorf("failed to sync silence cache")
+	}
+
+	zap.L().Info("silence cache synced")
+
+	return nil
+}
+
+func NewClient(client crds.Interface, store *Store) *Client {
+	factory := informer.NewSharedInformerFactory(client, 0)
+
+	return &Client{
+		informer: factory.Policyreporter().V1alpha1().Silences().Informer(),
+		store:    store,
+	}
+}
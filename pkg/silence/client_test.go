@@ -0,0 +1,48 @@
+package silence_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kyverno/policy-reporter/pkg/crd/api/targetconfig/v1alpha1"
+	"github.com/kyverno/policy-reporter/pkg/crd/client/clientset/versioned/fake"
+	"github.com/kyverno/policy-reporter/pkg/silence"
+)
+
+func Test_Client(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	stop := make(chan struct{})
+	t.Cleanup(func() { close(stop) })
+
+	kclient := fake.NewSimpleClientset()
+	store := silence.NewStore()
+
+	client := silence.NewClient(kclient, store)
+	client.ConfigureInformer()
+
+	assert.Nil(t, client.Run(stop))
+
+	silences := kclient.PolicyreporterV1alpha1().Silences("")
+
+	silences.Create(ctx, &v1alpha1.Silence{
+		ObjectMeta: metav1.ObjectMeta{Name: "migration"},
+		Spec:       v1alpha1.SilenceSpec{Matchers: v1alpha1.SilenceMatchers{Namespaces: []string{"test"}}},
+	}, metav1.CreateOptions{})
+
+	silences.Create(ctx, &v1alpha1.Silence{
+		ObjectMeta: metav1.ObjectMeta{Name: "invalid"},
+		Spec:       v1alpha1.SilenceSpec{Schedule: &v1alpha1.SilenceSchedule{Cron: "invalid"}},
+	}, metav1.CreateOptions{})
+
+	assert.Eventually(t, func() bool { return len(store.List()) == 1 }, time.Second, 10*time.Millisecond)
+	assert.Equal(t, "migration", store.List()[0].Name)
+
+	silences.Delete(ctx, "migration", metav1.DeleteOptions{})
+
+	assert.Eventually(t, func() bool { return len(store.List()) == 0 }, time.Second, 10*time.Millisecond)
+}
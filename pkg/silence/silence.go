@@ -0,0 +1,238 @@
+package silence
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/robfig/cron/v3"
+
+	"github.com/kyverno/policy-reporter/pkg/crd/api/targetconfig/v1alpha1"
+	"github.com/kyverno/policy-reporter/pkg/helper"
+	"github.com/kyverno/policy-reporter/pkg/openreports"
+	"github.com/kyverno/policy-reporter/pkg/report"
+	"github.com/kyverno/policy-reporter/pkg/validate"
+)
+
+// Matchers of a silence, all configured matchers have to match
+type Matchers struct {
+	Namespaces validate.RuleSets
+	Policies   validate.RuleSets
+	Rules      validate.RuleSets
+	Severities validate.RuleSets
+	Kinds      validate.RuleSets
+	Resources  validate.RuleSets
+	Targets    []string
+}
+
+// Schedule is a recurring window which starts with each cron activation
+type Schedule struct {
+	Cron     string
+	Duration time.Duration
+	TimeZone string
+	schedule cron.Schedule
+}
+
+// Silence mutes matching results for all or the selected targets
+type Silence struct {
+	Name      string
+	Matchers  Matchers
+	StartsAt  time.Time
+	EndsAt    time.Time
+	Schedule  *Schedule
+	CreatedBy string
+	Comment   string
+}
+
+// Active reports if the silence mutes results at the given time, a zero EndsAt never expires
+func (s Silence) Active(now time.Time) bool {
+	if now.Before(s.StartsAt) {
+		return false
+	}
+
+	if !s.EndsAt.IsZero() && !now.Before(s.EndsAt) {
+		return false
+	}
+
+	if s.Schedule == nil {
+		return true
+	}
+
+	// the last activation within the window duration has to be before or equal now
+	return !s.Schedule.schedule.Next(now.Add(-s.Schedule.Duration)).After(now)
+}
+
+// Matches reports if the result should not be sent to the given target
+func (s Silence) Matches(rep openreports.ReportInterface, result openreports.ResultAdapter, target string) bool {
+	if len(s.Matchers.Targets) > 0 && !helper.Contains(target, s.Matchers.Targets) {
+		return false
+	}
+
+	namespace := rep.GetNamespace()
+	kind, name := "", ""
+
+	if res := report.ResultResource(rep, result); res != nil {
+		kind, name = res.Kind, res.Name
+		if res.Namespace != "" {
+			namespace = res.Namespace
+		}
+	}
+
+	return validate.MatchRuleSet(namespace, s.Matchers.Namespaces) &&
+		validate.MatchRuleSet(result.Policy, s.Matchers.Policies) &&
+		validate.MatchRuleSet(result.Rule, s.Matchers.Rules) &&
+		validate.ContainsRuleSet(string(result.Severity), s.Matchers.Severities) &&
+		validate.MatchRuleSet(kind, s.Matchers.Kinds) &&
+		validate.MatchRuleSet(name, s.Matchers.Resources)
+}
+
+// FromCRD maps a Silence resource, the creation time is used as default start
+func FromCRD(obj *v1alpha1.Silence) (Silence, error) {
+	spec := obj.Spec
+
+	silence := Silence{
+		Name:      obj.Name,
+		StartsAt:  obj.CreationTimestamp.Time,
+		CreatedBy: spec.CreatedBy,
+		Comment:   spec.Comment,
+		Matchers: Matchers{
+			Namespaces: validate.RuleSets{Include: spec.Matchers.Namespaces},
+			Policies:   validate.RuleSets{Include: spec.Matchers.Policies},
+			Rules:      validate.RuleSets{Include: spec.Matchers.Rules},
+			Severities: validate.RuleSets{Include: spec.Matchers.Severities},
+			Kinds:      validate.RuleSets{Include: spec.Matchers.Kinds},
+			Resources:  validate.RuleSets{Include: spec.Matchers.Resources},
+			Targets:    spec.Matchers.Targets,
+		},
+	}
+
+	if spec.StartsAt != nil {
+		silence.StartsAt = spec.StartsAt.Time
+	}
+
+	if spec.EndsAt != nil {
+		silence.EndsAt = spec.EndsAt.Time
+
+		if !silence.EndsAt.After(silence.StartsAt) {
+			return silence, fmt.Errorf("endsAt has to be after startsAt")
+		}
+	}
+
+	if spec.Schedule != nil {
+		schedule, err := NewSchedule(spec.Schedule.Cron, spec.Schedule.Duration.Duration, spec.Schedule.TimeZone)
+		if err != nil {
+			return silence, err
+		}
+
+		silence.Schedule = schedule
+	}
+
+	return silence, nil
+}
+
+// NewSchedule parses a standard five field cron expression
+func NewSchedule(expression string, duration time.Duration, timeZone string) (*Schedule, error) {
+	if duration <= 0 {
+		return nil, fmt.Errorf("schedule duration has to be positive")
+	}
+
+	spec := expression
+	if timeZone != "" {
+		if _, err := time.LoadLocation(timeZone); err != nil {
+			return nil, fmt.Errorf("invalid schedule timeZone: %w", err)
+		}
+
+		spec = fmt.Sprintf("CRON_TZ=%s %s", timeZone, expression)
+	}
+
+	schedule, err := cron.ParseStandard(spec)
+	if err != nil {
+		return nil, fmt.Errorf("invalid schedule cron: %w", err)
+	}
+
+	return &Schedule{Cron: expression, Duration: duration, TimeZone: timeZone, schedule: schedule}, nil
+}
+
+// Store holds all known silences, a nil store never silences results
+type Store struct {
+	mx       *sync.RWMutex
+	silences map[string]Silence
+	now      func() time.Time
+}
+
+func (s *Store) Add(silence Silence) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	s.silences[silence.Name] = silence
+}
+
+func (s *Store) Remove(name string) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	delete(s.silences, name)
+}
+
+// List all silences sorted by name
+func (s *Store) List() []Silence {
+	if s == nil {
+		return []Silence{}
+	}
+
+	s.mx.RLock()
+	defer s.mx.RUnlock()
+
+	list := make([]Silence, 0, len(s.silences))
+	for _, silence := range s.silences {
+		list = append(list, silence)
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Name < list[j].Name
+	})
+
+	return list
+}
+
+// Active silences at the current time sorted by name
+func (s *Store) Active() []Silence {
+	if s == nil {
+		return []Silence{}
+	}
+
+	now := s.now()
+
+	return helper.Filter(s.List(), func(silence Silence) bool {
+		return silence.Active(now)
+	})
+}
+
+// Silenced reports if any active silence mutes the result for the given target
+func (s *Store) Silenced(rep openreports.ReportInterface, result openreports.ResultAdapter, target string) bool {
+	if s == nil {
+		return false
+	}
+
+	now := s.now()
+
+	s.mx.RLock()
+	defer s.mx.RUnlock()
+
+	for _, silence := range s.silences {
+		if silence.Active(now) && silence.Matches(rep, result, target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func NewStore() *Store {
+	return &Store{
+		mx:       new(sync.RWMutex),
+		silences: make(map[string]Silence),
+		now:      time.Now,
+	}
+}
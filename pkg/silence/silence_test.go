@@ -0,0 +1,153 @@
+package silence_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kyverno/policy-reporter/pkg/crd/api/targetconfig/v1alpha1"
+	"github.com/kyverno/policy-reporter/pkg/fixtures"
+	"github.com/kyverno/policy-reporter/pkg/silence"
+	"github.com/kyverno/policy-reporter/pkg/validate"
+)
+
+func date(value string) time.Time {
+	t, _ := time.Parse(time.RFC3339, value)
+	return t
+}
+
+func Test_Active(t *testing.T) {
+	t.Parallel()
+	t.Run("time range", func(t *testing.T) {
+		t.Parallel()
+		s := silence.Silence{StartsAt: date("2025-01-01T10:00:00Z"), EndsAt: date("2025-01-01T12:00:00Z")}
+
+		assert.False(t, s.Active(date("2025-01-01T09:59:59Z")))
+		assert.True(t, s.Active(date("2025-01-01T10:00:00Z")))
+		assert.True(t, s.Active(date("2025-01-01T11:59:59Z")))
+		assert.False(t, s.Active(date("2025-01-01T12:00:00Z")))
+	})
+	t.Run("without end", func(t *testing.T) {
+		t.Parallel()
+		s := silence.Silence{StartsAt: date("2025-01-01T10:00:00Z")}
+
+		assert.True(t, s.Active(date("2030-01-01T10:00:00Z")))
+	})
+	t.Run("recurring window", func(t *testing.T) {
+		t.Parallel()
+		schedule, err := silence.NewSchedule("0 22 * * 6", 4*time.Hour, "")
+		assert.Nil(t, err)
+
+		s := silence.Silence{Schedule: schedule}
+
+		// 2025-01-04 is a saturday
+		assert.False(t, s.Active(date("2025-01-04T21:59:00Z")))
+		assert.True(t, s.Active(date("2025-01-04T22:00:00Z")))
+		assert.True(t, s.Active(date("2025-01-05T01:59:00Z")))
+		assert.False(t, s.Active(date("2025-01-05T02:00:00Z")))
+	})
+	t.Run("recurring window with time zone", func(t *testing.T) {
+		t.Parallel()
+		schedule, err := silence.NewSchedule("0 22 * * *", time.Hour, "Europe/Berlin")
+		assert.Nil(t, err)
+
+		s := silence.Silence{Schedule: schedule}
+
+		assert.True(t, s.Active(date("2025-01-04T21:30:00Z")))
+		assert.False(t, s.Active(date("2025-01-04T22:30:00Z")))
+	})
+}
+
+func Test_NewSchedule(t *testing.T) {
+	t.Parallel()
+	_, err := silence.NewSchedule("0 22 * *", time.Hour, "")
+	assert.ErrorContains(t, err, "invalid schedule cron")
+
+	_, err = silence.NewSchedule("0 22 * * *", 0, "")
+	assert.ErrorContains(t, err, "duration has to be positive")
+
+	_, err = silence.NewSchedule("0 22 * * *", time.Hour, "Mars/Olympus")
+	assert.ErrorContains(t, err, "invalid schedule timeZone")
+}
+
+func Test_Matches(t *testing.T) {
+	t.Parallel()
+	t.Run("all matchers have to match", func(t *testing.T) {
+		t.Parallel()
+		s := silence.Silence{Matchers: silence.Matchers{
+			Namespaces: validate.RuleSets{Include: []string{"te*"}},
+			Policies:   validate.RuleSets{Include: []string{"require-*"}},
+			Severities: validate.RuleSets{Include: []string{"high"}},
+			Kinds:      validate.RuleSets{Include: []string{"Deployment"}},
+			Resources:  validate.RuleSets{Include: []string{"nginx"}},
+		}}
+
+		assert.True(t, s.Matches(fixtures.DefaultPolicyReport, fixtures.FailResult, "Slack"))
+		assert.False(t, s.Matches(fixtures.DefaultPolicyReport, fixtures.PassNamespaceResult, "Slack"))
+	})
+	t.Run("restrict to targets", func(t *testing.T) {
+		t.Parallel()
+		s := silence.Silence{Matchers: silence.Matchers{Targets: []string{"Slack"}}}
+
+		assert.True(t, s.Matches(fixtures.DefaultPolicyReport, fixtures.FailResult, "Slack"))
+		assert.False(t, s.Matches(fixtures.DefaultPolicyReport, fixtures.FailResult, "Loki"))
+	})
+}
+
+func Test_FromCRD(t *testing.T) {
+	t.Parallel()
+	t.Run("map silence", func(t *testing.T) {
+		t.Parallel()
+		s, err := silence.FromCRD(&v1alpha1.Silence{
+			ObjectMeta: metav1.ObjectMeta{Name: "migration", CreationTimestamp: metav1.NewTime(date("2025-01-01T08:00:00Z"))},
+			Spec: v1alpha1.SilenceSpec{
+				Matchers:  v1alpha1.SilenceMatchers{Namespaces: []string{"payments"}},
+				EndsAt:    &metav1.Time{Time: date("2025-01-02T08:00:00Z")},
+				Schedule:  &v1alpha1.SilenceSchedule{Cron: "0 * * * *", Duration: metav1.Duration{Duration: time.Minute}},
+				CreatedBy: "jane",
+				Comment:   "database migration",
+			},
+		})
+
+		assert.Nil(t, err)
+		assert.Equal(t, "migration", s.Name)
+		assert.Equal(t, date("2025-01-01T08:00:00Z"), s.StartsAt)
+		assert.Equal(t, []string{"payments"}, s.Matchers.Namespaces.Include)
+		assert.Equal(t, "0 * * * *", s.Schedule.Cron)
+		assert.Equal(t, "jane", s.CreatedBy)
+	})
+	t.Run("reject end before start", func(t *testing.T) {
+		t.Parallel()
+		_, err := silence.FromCRD(&v1alpha1.Silence{
+			Spec: v1alpha1.SilenceSpec{
+				StartsAt: &metav1.Time{Time: date("2025-01-02T08:00:00Z")},
+				EndsAt:   &metav1.Time{Time: date("2025-01-01T08:00:00Z")},
+			},
+		})
+
+		assert.ErrorContains(t, err, "endsAt has to be after startsAt")
+	})
+}
+
+func Test_Store(t *testing.T) {
+	t.Parallel()
+	store := silence.NewStore()
+	store.Add(silence.Silence{Name: "payments", Matchers: silence.Matchers{Namespaces: validate.RuleSets{Include: []string{"payments"}}}})
+	store.Add(silence.Silence{Name: "expired", EndsAt: date("2020-01-01T00:00:00Z")})
+	store.Add(silence.Silence{Name: "test", Matchers: silence.Matchers{Namespaces: validate.RuleSets{Include: []string{"test"}}}})
+
+	assert.Len(t, store.List(), 3)
+	assert.Equal(t, "payments", store.Active()[0].Name)
+	assert.Len(t, store.Active(), 2)
+	assert.True(t, store.Silenced(fixtures.DefaultPolicyReport, fixtures.FailResult, "Slack"))
+
+	store.Remove("test")
+
+	assert.False(t, store.Silenced(fixtures.DefaultPolicyReport, fixtures.FailResult, "Slack"))
+
+	var empty *silence.Store
+	assert.False(t, empty.Silenced(fixtures.DefaultPolicyReport, fixtures.FailResult, "Slack"))
+	assert.Len(t, empty.Active(), 0)
+}
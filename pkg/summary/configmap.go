@@ -0,0 +1,101 @@
+package summary
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/kyverno/policy-reporter/pkg/kubernetes/retry"
+)
+
+const (
+	DefaultName  = "policy-reporter-summary"
+	SummaryLabel = "policy-reporter.io/summary"
+)
+
+type configMapWriter struct {
+	client v1.ConfigMapsGetter
+	name   string
+}
+
+func (w *configMapWriter) Write(ctx context.Context, summary Summary) error {
+	data, err := configMapData(summary)
+	if err != nil {
+		return err
+	}
+
+	_, err = retry.Retry(func() (*corev1.ConfigMap, error) {
+		client := w.client.ConfigMaps(summary.Namespace)
+
+		cm, err := client.Get(ctx, w.name, metav1.GetOptions{})
+		if errors.IsNotFound(err) {
+			return client.Create(ctx, &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      w.name,
+					Namespace: summary.Namespace,
+					Labels: map[string]string{
+						"app.kubernetes.io/managed-by": "policy-reporter",
+						SummaryLabel:                   "true",
+					},
+				},
+				Data: data,
+			}, metav1.CreateOptions{})
+		} else if err != nil {
+			return nil, err
+		}
+
+		cm.Data = data
+
+		return client.Update(ctx, cm, metav1.UpdateOptions{})
+	})
+
+	return err
+}
+
+func (w *configMapWriter) Delete(ctx context.Context, namespace string) error {
+	err := w.client.ConfigMaps(namespace).Delete(ctx, w.name, metav1.DeleteOptions{})
+	if errors.IsNotFound(err) {
+		return nil
+	}
+
+	return err
+}
+
+func configMapData(summary Summary) (map[string]string, error) {
+	data := map[string]string{
+		"pass":        strconv.Itoa(summary.Total.Pass),
+		"fail":        strconv.Itoa(summary.Total.Fail),
+		"warn":        strconv.Itoa(summary.Total.Warn),
+		"error":       strconv.Itoa(summary.Total.Error),
+		"skip":        strconv.Itoa(summary.Total.Skip),
+		"lastUpdated": summary.LastUpdated.UTC().Format(time.RFC3339),
+	}
+
+	values := map[string]any{
+		"sources":            summary.Sources,
+		"severities":         summary.Severities,
+		"topFailingPolicies": summary.TopFailingPolicies,
+	}
+
+	for key, value := range values {
+		content, err := yaml.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+
+		data[key] = string(content)
+	}
+
+	return data, nil
+}
+
+// NewConfigMapWriter writes the summary of each namespace into a ConfigMap with the given name
+func NewConfigMapWriter(client v1.ConfigMapsGetter, name string) Writer {
+	return &configMapWriter{client: client, name: name}
+}
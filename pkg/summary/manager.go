@@ -0,0 +1,156 @@
+package summary
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/kyverno/policy-reporter/pkg/openreports"
+	"github.com/kyverno/policy-reporter/pkg/report"
+)
+
+// Writer persists the summary of a namespace
+type Writer interface {
+	Write(ctx context.Context, summary Summary) error
+	Delete(ctx context.Context, namespace string) error
+}
+
+// Manager aggregates the reports of each namespace and writes debounced summaries
+type Manager struct {
+	mx      *sync.Mutex
+	reports map[string]map[string]reportSummary
+	pending map[string]*time.Timer
+	writer  Writer
+	delay   time.Duration
+	top     int
+	cluster string
+	active  bool
+	now     func() time.Time
+}
+
+// WithCluster sets the name of the local cluster, reports of other clusters are ignored
+func (m *Manager) WithCluster(cluster string) *Manager {
+	m.cluster = cluster
+
+	return m
+}
+
+// Listen is a report.PolicyReportListener to keep the aggregation up to date,
+// cluster scoped reports and reports of other clusters are ignored
+func (m *Manager) Listen(_ context.Context, event report.LifecycleEvent) {
+	namespace := event.PolicyReport.GetNamespace()
+	if namespace == "" || openreports.Cluster(event.PolicyReport) != m.cluster {
+		return
+	}
+
+	m.mx.Lock()
+	defer m.mx.Unlock()
+
+	if event.Type == report.Deleted {
+		delete(m.reports[namespace], event.PolicyReport.GetID())
+	} else {
+		if _, ok := m.reports[namespace]; !ok {
+			m.reports[namespace] = make(map[string]reportSummary)
+		}
+
+		m.reports[namespace][event.PolicyReport.GetID()] = newReportSummary(event.PolicyReport)
+	}
+
+	m.schedule(namespace)
+}
+
+// Start writing summaries, all known namespaces are written after the configured delay
+func (m *Manager) Start() {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+
+	m.active = true
+
+	for namespace := range m.reports {
+		m.schedule(namespace)
+	}
+}
+
+// Stop writing summaries, changes are still aggregated
+func (m *Manager) Stop() {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+
+	m.active = false
+
+	for namespace, timer := range m.pending {
+		timer.Stop()
+		delete(m.pending, namespace)
+	}
+}
+
+// Summary of the given namespace
+func (m *Manager) Summary(namespace string) Summary {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+
+	return aggregate(namespace, m.reports[namespace], m.top, m.now())
+}
+
+// schedule a write if none is pending for the namespace, requires the lock
+func (m *Manager) schedule(namespace string) {
+	if !m.active {
+		return
+	}
+
+	if _, ok := m.pending[namespace]; ok {
+		return
+	}
+
+	m.pending[namespace] = time.AfterFunc(m.delay, func() {
+		m.flush(namespace)
+	})
+}
+
+func (m *Manager) flush(namespace string) {
+	m.mx.Lock()
+	delete(m.pending, namespace)
+
+	if !m.active {
+		m.mx.Unlock()
+		return
+	}
+
+	reports := m.reports[namespace]
+	empty := len(reports) == 0
+	if empty {
+		delete(m.reports, namespace)
+	}
+
+	summary := aggregate(namespace, reports, m.top, m.now())
+	m.mx.Unlock()
+
+	var err error
+	if empty {
+		err = m.writer.Delete(context.Background(), namespace)
+	} else {
+		err = m.writer.Write(context.Background(), summary)
+	}
+
+	if err != nil {
+		zap.L().Error("failed to write namespace summary", zap.String("namespace", namespace), zap.Error(err))
+		return
+	}
+
+	zap.L().Debug("namespace summary written", zap.String("namespace", namespace))
+}
+
+// NewManager creates a new Manager, summaries are written after Start was called
+func NewManager(writer Writer, delay time.Duration, top int) *Manager {
+	return &Manager{
+		mx:      new(sync.Mutex),
+		reports: make(map[string]map[string]reportSummary),
+		pending: make(map[string]*time.Timer),
+		writer:  writer,
+		delay:   delay,
+		top:     top,
+		now:     time.Now,
+	}
+}
@@ -0,0 +1,118 @@
+package summary_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/openreports/reports-api/apis/openreports.io/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"sigs.k8s.io/yaml"
+
+	"github.com/kyverno/policy-reporter/pkg/crd/api/policyreport/v1alpha2"
+	"github.com/kyverno/policy-reporter/pkg/openreports"
+	"github.com/kyverno/policy-reporter/pkg/report"
+	"github.com/kyverno/policy-reporter/pkg/summary"
+)
+
+func newReport(name, namespace string, results ...v1alpha1.ReportResult) *openreports.ReportAdapter {
+	return &openreports.ReportAdapter{
+		Report: &v1alpha1.Report{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Results:    results,
+		},
+	}
+}
+
+var reports = []*openreports.ReportAdapter{
+	newReport("report-1", "test",
+		v1alpha1.ReportResult{Source: "kyverno", Policy: "require-labels", Result: v1alpha2.StatusFail, Severity: v1alpha2.SeverityHigh},
+		v1alpha1.ReportResult{Source: "kyverno", Policy: "disallow-latest", Result: v1alpha2.StatusFail, Severity: v1alpha2.SeverityMedium},
+		v1alpha1.ReportResult{Source: "kyverno", Policy: "require-probes", Result: v1alpha2.StatusPass},
+	),
+	newReport("report-2", "test",
+		v1alpha1.ReportResult{Source: "kyverno", Policy: "require-labels", Result: v1alpha2.StatusFail, Severity: v1alpha2.SeverityHigh},
+		v1alpha1.ReportResult{Source: "trivy", Policy: "CVE-2024-1234", Result: v1alpha2.StatusWarn, Severity: v1alpha2.SeverityCritical},
+	),
+}
+
+func Test_ManagerSummary(t *testing.T) {
+	t.Parallel()
+	manager := summary.NewManager(summary.NewConfigMapWriter(fake.NewClientset().CoreV1(), summary.DefaultName), time.Minute, 1)
+
+	for _, r := range reports {
+		manager.Listen(context.Background(), report.LifecycleEvent{Type: report.Added, PolicyReport: r})
+	}
+
+	manager.Listen(context.Background(), report.LifecycleEvent{Type: report.Added, PolicyReport: newReport("cluster", "",
+		v1alpha1.ReportResult{Source: "kyverno", Policy: "cluster-policy", Result: v1alpha2.StatusFail},
+	)})
+
+	s := manager.Summary("test")
+
+	assert.Equal(t, summary.Counts{Pass: 1, Fail: 3, Warn: 1}, s.Total)
+	assert.Equal(t, summary.Counts{Pass: 1, Fail: 3}, s.Sources["kyverno"])
+	assert.Equal(t, summary.Counts{Warn: 1}, s.Sources["trivy"])
+	assert.Equal(t, summary.Counts{Fail: 2}, s.Severities["high"])
+	assert.Equal(t, summary.Counts{Pass: 1}, s.Severities["unknown"])
+	assert.Equal(t, []summary.Policy{{Source: "kyverno", Policy: "require-labels", Fail: 2}}, s.TopFailingPolicies)
+
+	assert.Equal(t, summary.Counts{}, manager.Summary("").Total, "cluster reports should be ignored")
+
+	remote := newReport("remote", "test", v1alpha1.ReportResult{Source: "kyverno", Policy: "require-labels", Result: v1alpha2.StatusFail})
+	openreports.SetCluster(remote, "remote")
+	manager.Listen(context.Background(), report.LifecycleEvent{Type: report.Added, PolicyReport: remote})
+
+	assert.Equal(t, summary.Counts{Pass: 1, Fail: 3, Warn: 1}, manager.Summary("test").Total, "reports of other clusters should be ignored")
+
+	manager.Listen(context.Background(), report.LifecycleEvent{Type: report.Deleted, PolicyReport: reports[1]})
+
+	assert.Equal(t, summary.Counts{Pass: 1, Fail: 2}, manager.Summary("test").Total)
+}
+
+func Test_ManagerWritesConfigMap(t *testing.T) {
+	t.Parallel()
+	client := fake.NewClientset()
+	manager := summary.NewManager(summary.NewConfigMapWriter(client.CoreV1(), summary.DefaultName), 10*time.Millisecond, 5)
+
+	for _, r := range reports {
+		manager.Listen(context.Background(), report.LifecycleEvent{Type: report.Added, PolicyReport: r})
+	}
+
+	_, err := client.CoreV1().ConfigMaps("test").Get(context.Background(), summary.DefaultName, metav1.GetOptions{})
+	assert.Error(t, err, "summaries should not be written before Start was called")
+
+	manager.Start()
+	defer manager.Stop()
+
+	var cm *corev1.ConfigMap
+	assert.Eventually(t, func() bool {
+		cm, err = client.CoreV1().ConfigMaps("test").Get(context.Background(), summary.DefaultName, metav1.GetOptions{})
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	assert.Equal(t, "true", cm.Labels[summary.SummaryLabel])
+	assert.Equal(t, "3", cm.Data["fail"])
+	assert.Equal(t, "1", cm.Data["pass"])
+	assert.Equal(t, "1", cm.Data["warn"])
+	assert.NotEmpty(t, cm.Data["lastUpdated"])
+
+	policies := make([]summary.Policy, 0)
+	assert.Nil(t, yaml.Unmarshal([]byte(cm.Data["topFailingPolicies"]), &policies))
+	assert.Equal(t, []summary.Policy{
+		{Source: "kyverno", Policy: "require-labels", Fail: 2},
+		{Source: "kyverno", Policy: "disallow-latest", Fail: 1},
+	}, policies)
+
+	for _, r := range reports {
+		manager.Listen(context.Background(), report.LifecycleEvent{Type: report.Deleted, PolicyReport: r})
+	}
+
+	assert.Eventually(t, func() bool {
+		_, err = client.CoreV1().ConfigMaps("test").Get(context.Background(), summary.DefaultName, metav1.GetOptions{})
+		return err != nil
+	}, 5*time.Second, 10*time.Millisecond, "summary should be deleted without reports")
+}
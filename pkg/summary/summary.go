@@ -0,0 +1,162 @@
+package summary
+
+import (
+	"sort"
+	"time"
+
+	"github.com/openreports/reports-api/apis/openreports.io/v1alpha1"
+
+	"github.com/kyverno/policy-reporter/pkg/crd/api/policyreport/v1alpha2"
+	"github.com/kyverno/policy-reporter/pkg/openreports"
+)
+
+// Counts of results per status
+type Counts struct {
+	Pass  int `json:"pass"`
+	Fail  int `json:"fail"`
+	Warn  int `json:"warn"`
+	Error int `json:"error"`
+	Skip  int `json:"skip"`
+}
+
+func (c *Counts) add(status v1alpha1.Result, count int) {
+	switch status {
+	case v1alpha2.StatusPass:
+		c.Pass += count
+	case v1alpha2.StatusFail:
+		c.Fail += count
+	case v1alpha2.StatusWarn:
+		c.Warn += count
+	case v1alpha2.StatusError:
+		c.Error += count
+	case v1alpha2.StatusSkip:
+		c.Skip += count
+	}
+}
+
+func (c *Counts) merge(counts Counts) {
+	c.Pass += counts.Pass
+	c.Fail += counts.Fail
+	c.Warn += counts.Warn
+	c.Error += counts.Error
+	c.Skip += counts.Skip
+}
+
+// Policy with its number of failing results
+type Policy struct {
+	Source string `json:"source,omitempty"`
+	Policy string `json:"policy"`
+	Fail   int    `json:"fail"`
+}
+
+// Summary of all reports within a namespace
+type Summary struct {
+	Namespace          string            `json:"namespace"`
+	Total              Counts            `json:"total"`
+	Sources            map[string]Counts `json:"sources"`
+	Severities         map[string]Counts `json:"severities"`
+	TopFailingPolicies []Policy          `json:"topFailingPolicies"`
+	LastUpdated        time.Time         `json:"lastUpdated"`
+}
+
+type policyKey struct {
+	source string
+	policy string
+}
+
+// reportSummary is the contribution of a single report to the namespace summary
+type reportSummary struct {
+	sources    map[string]Counts
+	severities map[string]Counts
+	failing    map[policyKey]int
+}
+
+func newReportSummary(polr openreports.ReportInterface) reportSummary {
+	s := reportSummary{
+		sources:    make(map[string]Counts),
+		severities: make(map[string]Counts),
+		failing:    make(map[policyKey]int),
+	}
+
+	for _, result := range polr.GetResults() {
+		source := result.Source
+		if source == "" {
+			source = polr.GetSource()
+		}
+
+		counts := s.sources[source]
+		counts.add(result.Result, 1)
+		s.sources[source] = counts
+
+		severity := string(result.Severity)
+		if severity == "" {
+			severity = "unknown"
+		}
+
+		counts = s.severities[severity]
+		counts.add(result.Result, 1)
+		s.severities[severity] = counts
+
+		if result.Result == v1alpha2.StatusFail {
+			s.failing[policyKey{source: source, policy: result.Policy}]++
+		}
+	}
+
+	return s
+}
+
+func aggregate(namespace string, reports map[string]reportSummary, top int, now time.Time) Summary {
+	summary := Summary{
+		Namespace:          namespace,
+		Sources:            make(map[string]Counts),
+		Severities:         make(map[string]Counts),
+		TopFailingPolicies: make([]Policy, 0, top),
+		LastUpdated:        now,
+	}
+
+	failing := make(map[policyKey]int)
+
+	for _, report := range reports {
+		for source, counts := range report.sources {
+			c := summary.Sources[source]
+			c.merge(counts)
+			summary.Sources[source] = c
+
+			summary.Total.merge(counts)
+		}
+
+		for severity, counts := range report.severities {
+			c := summary.Severities[severity]
+			c.merge(counts)
+			summary.Severities[severity] = c
+		}
+
+		for key, count := range report.failing {
+			failing[key] += count
+		}
+	}
+
+	policies := make([]Policy, 0, len(failing))
+	for key, count := range failing {
+		policies = append(policies, Policy{Source: key.source, Policy: key.policy, Fail: count})
+	}
+
+	sort.Slice(policies, func(i, j int) bool {
+		if policies[i].Fail != policies[j].Fail {
+			return policies[i].Fail > policies[j].Fail
+		}
+		if policies[i].Policy != policies[j].Policy {
+			return policies[i].Policy < policies[j].Policy
+		}
+
+		return policies[i].Source < policies[j].Source
+	})
+
+	if len(policies) > top {
+		policies = policies[:top]
+	}
+
+	summary.TopFailingPolicies = append(summary.TopFailingPolicies, policies...)
+
+	return summary
+}
@@ -2,16 +2,18 @@ package target
 
 import (
 	"context"
-	"strings"
+	"sync"
 
-	"github.com/kyverno/go-wildcard"
 	"github.com/openreports/reports-api/apis/openreports.io/v1alpha1"
 	"go.uber.org/zap"
 
 	"github.com/kyverno/policy-reporter/pkg/crd/api/policyreport/v1alpha2"
+	"github.com/kyverno/policy-reporter/pkg/expression"
 	"github.com/kyverno/policy-reporter/pkg/kubernetes/namespaces"
+	"github.com/kyverno/policy-reporter/pkg/kubernetes/resources"
 	"github.com/kyverno/policy-reporter/pkg/openreports"
 	"github.com/kyverno/policy-reporter/pkg/report"
+	"github.com/kyverno/policy-reporter/pkg/report/result"
 	"github.com/kyverno/policy-reporter/pkg/validate"
 )
 
@@ -50,7 +52,11 @@ type Client interface {
 }
 
 type ResultFilterFactory struct {
-	client namespaces.Client
+	client            namespaces.Client
+	resources         resources.Client
+	includeSuppressed bool
+	mx                sync.Mutex
+	programs          map[string]*expression.Program
 }
 
 func (rf *ResultFilterFactory) CreateFilter(namespace, severity, status, policy, sources validate.RuleSets, minimumSeverity string) *report.ResultFilter {
@@ -58,6 +64,12 @@ func (rf *ResultFilterFactory) CreateFilter(namespace, severity, status, policy,
 	f.Sources = sources.Include
 	f.MinimumSeverity = minimumSeverity
 
+	if rf == nil || !rf.includeSuppressed {
+		f.AddValidation(func(r openreports.ResultAdapter) bool {
+			return !result.IsSuppressed(r)
+		})
+	}
+
 	if namespace.Count() > 0 {
 		f.AddValidation(func(r openreports.ResultAdapter) bool {
 			if r.GetResource() == nil {
@@ -117,48 +129,80 @@ func (rf *ResultFilterFactory) CreateFilter(namespace, severity, status, policy,
 	return f
 }
 
+// Compile a CEL result expression, programs are cached by expression and shared between targets.
+// Namespace labels are resolved with the namespace client of the factory.
+func (rf *ResultFilterFactory) Compile(cel string) (*expression.Program, error) {
+	if cel == "" {
+		return nil, nil
+	}
+
+	if rf == nil {
+		return expression.Compile(cel, nil)
+	}
+
+	rf.mx.Lock()
+	defer rf.mx.Unlock()
+
+	if program, ok := rf.programs[cel]; ok {
+		return program, nil
+	}
+
+	program, err := expression.Compile(cel, rf.client)
+	if err != nil {
+		return nil, err
+	}
+
+	if rf.programs == nil {
+		rf.programs = make(map[string]*expression.Program)
+	}
+	rf.programs[cel] = program
+
+	return program, nil
+}
+
+// AddExpression adds the compiled CEL program as report dependent validation
+func (rf *ResultFilterFactory) AddExpression(f *report.ResultFilter, program *expression.Program) {
+	if program == nil {
+		return
+	}
+
+	f.AddReportValidation(program.MatchResult)
+}
+
+// AddResourceFilter adds validations for the kind, labels and annotations of the affected resource,
+// results without resource are validated against the scope of the report
+func (rf *ResultFilterFactory) AddResourceFilter(f *report.ResultFilter, kinds, labels, annotations validate.RuleSets) {
+	if kinds.Count() > 0 {
+		f.AddReportValidation(func(rep openreports.ReportInterface, r openreports.ResultAdapter) bool {
+			res := report.ResultResource(rep, r)
+			if res == nil {
+				return true
+			}
+
+			return validate.Kind(res.Kind, kinds)
+		})
+	}
+
+	if labels.Count() > 0 || annotations.Count() > 0 {
+		f.AddReportValidation(func(rep openreports.ReportInterface, r openreports.ResultAdapter) bool {
+			return report.ValidateResourceMetadata(rf.resources, report.ResultResource(rep, r), labels, annotations)
+		})
+	}
+}
+
+// IncludeSuppressed configures if results suppressed by resource annotations are sent to targets
+func (rf *ResultFilterFactory) IncludeSuppressed(include bool) *ResultFilterFactory {
+	rf.includeSuppressed = include
+
+	return rf
+}
+
 func NewReportFilter(labels, sources validate.RuleSets) *report.ReportFilter {
 	f := report.NewReportFilter()
 
 	if labels.Count() > 0 {
 		f.AddValidation(func(r openreports.ReportInterface) bool {
-			if len(labels.Include) > 0 {
-				for _, label := range labels.Include {
-					parts := strings.Split(label, ":")
-					if len(parts) == 1 {
-						parts = append(parts, "*")
-					}
-
-					labelName := strings.TrimSpace(parts[0])
-					labelValue := strings.TrimSpace(parts[1])
-
-					for key, value := range r.GetLabels() {
-						if labelName == key && wildcard.Match(labelValue, value) {
-							return true
-						}
-					}
-				}
-
-				return false
-			} else if len(labels.Exclude) > 0 {
-				for _, label := range labels.Exclude {
-					parts := strings.Split(label, ":")
-					if len(parts) == 1 {
-						parts = append(parts, "*")
-					}
-
-					labelName := strings.TrimSpace(parts[0])
-					labelValue := strings.TrimSpace(parts[1])
-
-					for key, value := range r.GetLabels() {
-						if labelName == key && wildcard.Match(labelValue, value) {
-							return false
-						}
-					}
-				}
-			}
-
-			return true
+			return validate.Labels(r.GetLabels(), labels)
 		})
 	}
 
@@ -176,8 +220,8 @@ func NewReportFilter(labels, sources validate.RuleSets) *report.ReportFilter {
 	return f
 }
 
-func NewResultFilterFactory(client namespaces.Client) *ResultFilterFactory {
-	return &ResultFilterFactory{client: client}
+func NewResultFilterFactory(client namespaces.Client, resources resources.Client) *ResultFilterFactory {
+	return &ResultFilterFactory{client: client, resources: resources}
 }
 
 type BaseClient struct {
@@ -224,7 +268,7 @@ func (c *BaseClient) Validate(rep openreports.ReportInterface, result openreport
 		return false
 	}
 
-	if c.resultFilter != nil && !c.resultFilter.Validate(result) {
+	if c.resultFilter != nil && !c.resultFilter.ValidateWithReport(rep, result) {
 		return false
 	}
 
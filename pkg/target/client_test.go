@@ -10,6 +10,7 @@ import (
 	"github.com/kyverno/policy-reporter/pkg/fixtures"
 	"github.com/kyverno/policy-reporter/pkg/openreports"
 	"github.com/kyverno/policy-reporter/pkg/report"
+	"github.com/kyverno/policy-reporter/pkg/report/result"
 	"github.com/kyverno/policy-reporter/pkg/target"
 	"github.com/kyverno/policy-reporter/pkg/validate"
 )
@@ -22,7 +23,7 @@ var preport = &openreports.ReportAdapter{
 	},
 }
 
-var factory = target.NewResultFilterFactory(nil)
+var factory = target.NewResultFilterFactory(nil, nil)
 
 func Test_BaseClient(t *testing.T) {
 	t.Parallel()
@@ -53,6 +54,35 @@ func Test_BaseClient(t *testing.T) {
 		assert.False(t, filter.Validate(fixtures.FailResult), "Unexpected Validation Result")
 	})
 
+	t.Run("Validate Suppressed", func(t *testing.T) {
+		t.Parallel()
+		suppressed := fixtures.FailResult
+		suppressed.Properties = map[string]string{result.SuppressedProperty: "true"}
+
+		filter := factory.CreateFilter(
+			validate.RuleSets{},
+			validate.RuleSets{},
+			validate.RuleSets{},
+			validate.RuleSets{},
+			validate.RuleSets{},
+			"",
+		)
+
+		assert.False(t, filter.Validate(suppressed), "suppressed results should be excluded by default")
+		assert.True(t, filter.Validate(fixtures.FailResult), "Unexpected Validation Result")
+
+		filter = target.NewResultFilterFactory(nil, nil).IncludeSuppressed(true).CreateFilter(
+			validate.RuleSets{},
+			validate.RuleSets{},
+			validate.RuleSets{},
+			validate.RuleSets{},
+			validate.RuleSets{},
+			"",
+		)
+
+		assert.True(t, filter.Validate(suppressed), "suppressed results should be included")
+	})
+
 	t.Run("Validate ClusterResult", func(t *testing.T) {
 		t.Parallel()
 		filter := factory.CreateFilter(
@@ -454,3 +484,29 @@ func Test_BaseClient(t *testing.T) {
 		assert.Equal(t, client.Sources()[0], "Kyverno")
 	})
 }
+
+func Test_CompileExpression(t *testing.T) {
+	t.Parallel()
+	factory := target.NewResultFilterFactory(nil, nil)
+
+	t.Run("reuse compiled programs", func(t *testing.T) {
+		t.Parallel()
+		program, err := factory.Compile("result.status == 'fail'")
+		assert.Nil(t, err)
+
+		cached, err := factory.Compile("result.status == 'fail'")
+		assert.Nil(t, err)
+		assert.Same(t, program, cached)
+	})
+	t.Run("empty expression", func(t *testing.T) {
+		t.Parallel()
+		program, err := factory.Compile("")
+		assert.Nil(t, err)
+		assert.Nil(t, program)
+	})
+	t.Run("invalid expression", func(t *testing.T) {
+		t.Parallel()
+		_, err := factory.Compile("result.status ==")
+		assert.NotNil(t, err)
+	})
+}
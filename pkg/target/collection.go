@@ -16,39 +16,57 @@ import (
 type TargetType = string
 
 const (
-	Loki          TargetType = "Loki"
-	Elasticsearch TargetType = "Elasticsearch"
-	Slack         TargetType = "Slack"
-	Discord       TargetType = "Discord"
-	Teams         TargetType = "Teams"
-	GoogleChat    TargetType = "GoogleChat"
-	Jira          TargetType = "Jira"
-	Telegram      TargetType = "Telegram"
-	Webhook       TargetType = "Webhook"
-	S3            TargetType = "S3"
-	Kinesis       TargetType = "Kinesis"
-	SecurityHub   TargetType = "SecurityHub"
-	GCS           TargetType = "GCS"
-	AlertManager  TargetType = "AlertManager"
-	Splunk        TargetType = "Splunk"
+	Loki             TargetType = "Loki"
+	Elasticsearch    TargetType = "Elasticsearch"
+	Slack            TargetType = "Slack"
+	Discord          TargetType = "Discord"
+	Teams            TargetType = "Teams"
+	GoogleChat       TargetType = "GoogleChat"
+	Jira             TargetType = "Jira"
+	Telegram         TargetType = "Telegram"
+	Webhook          TargetType = "Webhook"
+	S3               TargetType = "S3"
+	Kinesis          TargetType = "Kinesis"
+	SNS              TargetType = "SNS"
+	SQS              TargetType = "SQS"
+	SecurityHub      TargetType = "SecurityHub"
+	GCS              TargetType = "GCS"
+	AlertManager     TargetType = "AlertManager"
+	Splunk           TargetType = "Splunk"
+	DefectDojo       TargetType = "DefectDojo"
+	Mattermost       TargetType = "Mattermost"
+	RocketChat       TargetType = "RocketChat"
+	Webex            TargetType = "Webex"
+	Matrix           TargetType = "Matrix"
+	ServiceNow       TargetType = "ServiceNow"
+	KubernetesEvents TargetType = "KubernetesEvents"
 )
 
 type Targets struct {
-	Loki          *targetconfig.Config[v1alpha1.LokiOptions]          `mapstructure:"loki"`
-	Elasticsearch *targetconfig.Config[v1alpha1.ElasticsearchOptions] `mapstructure:"elasticsearch"`
-	Slack         *targetconfig.Config[v1alpha1.SlackOptions]         `mapstructure:"slack"`
-	Discord       *targetconfig.Config[v1alpha1.WebhookOptions]       `mapstructure:"discord"`
-	Teams         *targetconfig.Config[v1alpha1.WebhookOptions]       `mapstructure:"teams"`
-	Webhook       *targetconfig.Config[v1alpha1.WebhookOptions]       `mapstructure:"webhook"`
-	GoogleChat    *targetconfig.Config[v1alpha1.WebhookOptions]       `mapstructure:"googleChat"`
-	Jira          *targetconfig.Config[v1alpha1.JiraOptions]          `mapstructure:"jira"`
-	Telegram      *targetconfig.Config[v1alpha1.TelegramOptions]      `mapstructure:"telegram"`
-	S3            *targetconfig.Config[v1alpha1.S3Options]            `mapstructure:"s3"`
-	Kinesis       *targetconfig.Config[v1alpha1.KinesisOptions]       `mapstructure:"kinesis"`
-	SecurityHub   *targetconfig.Config[v1alpha1.SecurityHubOptions]   `mapstructure:"securityHub"`
-	GCS           *targetconfig.Config[v1alpha1.GCSOptions]           `mapstructure:"gcs"`
-	AlertManager  *targetconfig.Config[v1alpha1.HostOptions]          `mapstructure:"alertManager"`
-	Splunk        *targetconfig.Config[v1alpha1.SplunkOptions]        `mapstructure:"splunk"`
+	Loki             *targetconfig.Config[v1alpha1.LokiOptions]             `mapstructure:"loki"`
+	Elasticsearch    *targetconfig.Config[v1alpha1.ElasticsearchOptions]    `mapstructure:"elasticsearch"`
+	Slack            *targetconfig.Config[v1alpha1.SlackOptions]            `mapstructure:"slack"`
+	Discord          *targetconfig.Config[v1alpha1.WebhookOptions]          `mapstructure:"discord"`
+	Teams            *targetconfig.Config[v1alpha1.WebhookOptions]          `mapstructure:"teams"`
+	Webhook          *targetconfig.Config[v1alpha1.WebhookOptions]          `mapstructure:"webhook"`
+	GoogleChat       *targetconfig.Config[v1alpha1.WebhookOptions]          `mapstructure:"googleChat"`
+	Jira             *targetconfig.Config[v1alpha1.JiraOptions]             `mapstructure:"jira"`
+	Telegram         *targetconfig.Config[v1alpha1.TelegramOptions]         `mapstructure:"telegram"`
+	S3               *targetconfig.Config[v1alpha1.S3Options]               `mapstructure:"s3"`
+	Kinesis          *targetconfig.Config[v1alpha1.KinesisOptions]          `mapstructure:"kinesis"`
+	SNS              *targetconfig.Config[v1alpha1.SNSOptions]              `mapstructure:"sns"`
+	SQS              *targetconfig.Config[v1alpha1.SQSOptions]              `mapstructure:"sqs"`
+	SecurityHub      *targetconfig.Config[v1alpha1.SecurityHubOptions]      `mapstructure:"securityHub"`
+	GCS              *targetconfig.Config[v1alpha1.GCSOptions]              `mapstructure:"gcs"`
+	AlertManager     *targetconfig.Config[v1alpha1.HostOptions]             `mapstructure:"alertManager"`
+	Splunk           *targetconfig.Config[v1alpha1.SplunkOptions]           `mapstructure:"splunk"`
+	DefectDojo       *targetconfig.Config[v1alpha1.DefectDojoOptions]       `mapstructure:"defectDojo"`
+	Mattermost       *targetconfig.Config[v1alpha1.MattermostOptions]       `mapstructure:"mattermost"`
+	RocketChat       *targetconfig.Config[v1alpha1.RocketChatOptions]       `mapstructure:"rocketChat"`
+	Webex            *targetconfig.Config[v1alpha1.WebexOptions]            `mapstructure:"webex"`
+	Matrix           *targetconfig.Config[v1alpha1.MatrixOptions]           `mapstructure:"matrix"`
+	ServiceNow       *targetconfig.Config[v1alpha1.ServiceNowOptions]       `mapstructure:"serviceNow"`
+	KubernetesEvents *targetconfig.Config[v1alpha1.KubernetesEventsOptions] `mapstructure:"kubernetesEvents"`
 }
 
 type TargetConfig interface {
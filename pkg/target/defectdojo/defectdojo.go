@@ -0,0 +1,301 @@
+package defectdojo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"mime/multipart"
+	"net/http"
+	"slices"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/openreports/reports-api/apis/openreports.io/v1alpha1"
+	"go.uber.org/zap"
+
+	"github.com/kyverno/policy-reporter/pkg/helper"
+	"github.com/kyverno/policy-reporter/pkg/openreports"
+	"github.com/kyverno/policy-reporter/pkg/target"
+	targethttp "github.com/kyverno/policy-reporter/pkg/target/http"
+)
+
+const (
+	// ScanType of the DefectDojo generic findings parser
+	ScanType = "Generic Findings Import"
+
+	reimportPath = "/api/v2/reimport-scan/"
+
+	productTemplate    = "{{ .source }}"
+	engagementTemplate = "{{ if .namespace }}{{ .namespace }}{{ else }}cluster{{ end }}"
+	productType        = "Policy Reporter"
+)
+
+// Options to configure the DefectDojo target
+type Options struct {
+	target.ClientOptions
+	Host               string
+	APIKey             string
+	ProductType        string
+	ProductTemplate    string
+	EngagementTemplate string
+	Headers            map[string]string
+	CustomFields       map[string]string
+	HTTPClient         targethttp.Client
+}
+
+// Finding of the DefectDojo generic findings format
+type Finding struct {
+	Title            string `json:"title"`
+	Description      string `json:"description"`
+	Severity         string `json:"severity"`
+	Date             string `json:"date"`
+	UniqueIDFromTool string `json:"unique_id_from_tool"`
+	VulnIDFromTool   string `json:"vuln_id_from_tool,omitempty"`
+	ComponentName    string `json:"component_name,omitempty"`
+	Service          string `json:"service,omitempty"`
+	Active           bool   `json:"active"`
+	Verified         bool   `json:"verified"`
+	StaticFinding    bool   `json:"static_finding"`
+	DynamicFinding   bool   `json:"dynamic_finding"`
+}
+
+// Findings file uploaded to the reimport-scan API
+type Findings struct {
+	Findings []Finding `json:"findings"`
+}
+
+type client struct {
+	target.BaseClient
+	host               string
+	apiKey             string
+	productType        string
+	productTemplate    *template.Template
+	engagementTemplate *template.Template
+	headers            map[string]string
+	customFields       map[string]string
+	client             targethttp.Client
+}
+
+func (c *client) Send(report openreports.ReportInterface, result openreports.ResultAdapter) {
+	c.BatchSend(report, []openreports.ResultAdapter{result})
+}
+
+// BatchSend reimports all results of the given report, DefectDojo closes findings which are no longer part of the scan
+func (c *client) BatchSend(report openreports.ReportInterface, results []openreports.ResultAdapter) {
+	req, err := c.createRequest(report, results)
+	if err != nil {
+		zap.L().Error(c.Name()+": failed to create reimport request", zap.Error(err), zap.String("report", report.GetKey()))
+		return
+	}
+
+	resp, err := c.client.Do(req)
+	targethttp.ProcessHTTPResponse(c.Name(), resp, err)
+}
+
+// CleanUp closes all findings of a report without any remaining results
+func (c *client) CleanUp(_ context.Context, report openreports.ReportInterface) {
+	results := helper.Filter(report.GetResults(), func(result openreports.ResultAdapter) bool {
+		return c.Validate(report, result)
+	})
+
+	if len(results) > 0 {
+		return
+	}
+
+	c.BatchSend(report, results)
+}
+
+func (c *client) Type() target.ClientType {
+	return target.SyncSend
+}
+
+func (c *client) createRequest(report openreports.ReportInterface, results []openreports.ResultAdapter) (*http.Request, error) {
+	values := map[string]any{
+		"source":      report.GetSource(),
+		"namespace":   report.GetNamespace(),
+		"report":      report.GetName(),
+		"customfield": c.customFields,
+	}
+
+	product, err := execute(c.productTemplate, values)
+	if err != nil {
+		return nil, err
+	}
+
+	engagement, err := execute(c.engagementTemplate, values)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := json.Marshal(Findings{Findings: MapFindings(results)})
+	if err != nil {
+		return nil, err
+	}
+
+	body := new(bytes.Buffer)
+	writer := multipart.NewWriter(body)
+
+	fields := map[string]string{
+		"scan_type":           ScanType,
+		"product_type_name":   c.productType,
+		"product_name":        product,
+		"engagement_name":     engagement,
+		"test_title":          report.GetKey(),
+		"auto_create_context": "true",
+		"close_old_findings":  "true",
+		"do_not_reactivate":   "false",
+		"active":              "true",
+		"verified":            "false",
+		"scan_date":           time.Now().Format(time.DateOnly),
+	}
+
+	for key, value := range fields {
+		if err := writer.WriteField(key, value); err != nil {
+			return nil, err
+		}
+	}
+
+	part, err := writer.CreateFormFile("file", "findings.json")
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := part.Write(file); err != nil {
+		return nil, err
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, c.host+reimportPath, body)
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range c.headers {
+		req.Header.Set(k, v)
+	}
+
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	req.Header.Set("User-Agent", "Policy-Reporter")
+	req.Header.Set("Authorization", "Token "+c.apiKey)
+
+	return req, nil
+}
+
+// MapFindings maps all failed results into DefectDojo findings, passed and skipped results are ignored
+func MapFindings(results []openreports.ResultAdapter) []Finding {
+	findings := make([]Finding, 0, len(results))
+	for _, result := range results {
+		if result.Result == openreports.StatusPass || result.Result == openreports.StatusSkip {
+			continue
+		}
+
+		findings = append(findings, mapFinding(result))
+	}
+
+	return findings
+}
+
+func mapFinding(result openreports.ResultAdapter) Finding {
+	date := time.Now()
+	if result.Timestamp.Seconds != 0 {
+		date = time.Unix(result.Timestamp.Seconds, int64(result.Timestamp.Nanos))
+	}
+
+	title := result.Policy
+	if result.Rule != "" && result.Rule != result.Policy {
+		title = fmt.Sprintf("%s: %s", result.Policy, result.Rule)
+	}
+
+	description := strings.Builder{}
+	description.WriteString(result.Description)
+	description.WriteString("\n\n")
+	fmt.Fprintf(&description, "**Policy**: %s\n", result.Policy)
+	if result.Rule != "" {
+		fmt.Fprintf(&description, "**Rule**: %s\n", result.Rule)
+	}
+	if result.Category != "" {
+		fmt.Fprintf(&description, "**Category**: %s\n", result.Category)
+	}
+	fmt.Fprintf(&description, "**Status**: %s\n", result.Result)
+	fmt.Fprintf(&description, "**Source**: %s\n", result.Source)
+	if result.HasResource() {
+		fmt.Fprintf(&description, "**Resource**: %s\n", result.ResourceString())
+	}
+	for _, key := range slices.Sorted(maps.Keys(result.Properties)) {
+		fmt.Fprintf(&description, "**%s**: %s\n", key, result.Properties[key])
+	}
+
+	finding := Finding{
+		Title:            title,
+		Description:      description.String(),
+		Severity:         MapSeverity(result.Severity),
+		Date:             date.Format(time.DateOnly),
+		UniqueIDFromTool: result.GetID(),
+		VulnIDFromTool:   result.Policy,
+		Active:           true,
+		StaticFinding:    true,
+	}
+
+	if result.HasResource() {
+		finding.ComponentName = result.ResourceString()
+		finding.Service = result.GetResource().Namespace
+	}
+
+	return finding
+}
+
+// MapSeverity maps policy report severities to DefectDojo severities
+func MapSeverity(s v1alpha1.ResultSeverity) string {
+	switch s {
+	case openreports.SeverityLow:
+		return "Low"
+	case openreports.SeverityMedium:
+		return "Medium"
+	case openreports.SeverityHigh:
+		return "High"
+	case openreports.SeverityCritical:
+		return "Critical"
+	default:
+		return "Info"
+	}
+}
+
+func execute(t *template.Template, values map[string]any) (string, error) {
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, values); err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(buf.String()), nil
+}
+
+// NewClient creates a new DefectDojo client
+func NewClient(options Options) (target.Client, error) {
+	product, err := template.New("product").Parse(helper.Defaults(options.ProductTemplate, productTemplate))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse product template: %w", err)
+	}
+
+	engagement, err := template.New("engagement").Parse(helper.Defaults(options.EngagementTemplate, engagementTemplate))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse engagement template: %w", err)
+	}
+
+	return &client{
+		BaseClient:         target.NewBaseClient(options.ClientOptions),
+		host:               strings.TrimSuffix(options.Host, "/"),
+		apiKey:             options.APIKey,
+		productType:        helper.Defaults(options.ProductType, productType),
+		productTemplate:    product,
+		engagementTemplate: engagement,
+		headers:            options.Headers,
+		customFields:       options.CustomFields,
+		client:             options.HTTPClient,
+	}, nil
+}
@@ -0,0 +1,187 @@
+package defectdojo_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kyverno/policy-reporter/pkg/crd/api/policyreport/v1alpha2"
+	"github.com/kyverno/policy-reporter/pkg/fixtures"
+	"github.com/kyverno/policy-reporter/pkg/openreports"
+	"github.com/kyverno/policy-reporter/pkg/target"
+	"github.com/kyverno/policy-reporter/pkg/target/defectdojo"
+)
+
+type testClient struct {
+	callback   func(req *http.Request)
+	statusCode int
+}
+
+func (c testClient) Do(req *http.Request) (*http.Response, error) {
+	c.callback(req)
+
+	return &http.Response{
+		StatusCode: c.statusCode,
+	}, nil
+}
+
+func parseFindings(t *testing.T, req *http.Request) defectdojo.Findings {
+	file, _, err := req.FormFile("file")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	content, err := io.ReadAll(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	findings := defectdojo.Findings{}
+	if err := json.Unmarshal(content, &findings); err != nil {
+		t.Fatal(err)
+	}
+
+	return findings
+}
+
+func Test_DefectDojoTarget(t *testing.T) {
+	t.Parallel()
+	t.Run("Reimport Report Results", func(t *testing.T) {
+		t.Parallel()
+		callback := func(req *http.Request) {
+			assert.Equal(t, "https://defectdojo.example.com/api/v2/reimport-scan/", req.URL.String())
+			assert.Equal(t, "Token api-key", req.Header.Get("Authorization"))
+			assert.Equal(t, "Policy-Reporter", req.Header.Get("User-Agent"))
+
+			assert.Equal(t, defectdojo.ScanType, req.FormValue("scan_type"))
+			assert.Equal(t, "Policy Reporter", req.FormValue("product_type_name"))
+			assert.Equal(t, "test", req.FormValue("product_name"))
+			assert.Equal(t, "test", req.FormValue("engagement_name"))
+			assert.Equal(t, "test/policy-report", req.FormValue("test_title"))
+			assert.Equal(t, "true", req.FormValue("close_old_findings"))
+			assert.Equal(t, "true", req.FormValue("auto_create_context"))
+
+			findings := parseFindings(t, req)
+			assert.Len(t, findings.Findings, len(fixtures.DefaultPolicyReport.GetResults()))
+			assert.Equal(t, fixtures.DefaultPolicyReport.GetResults()[0].GetID(), findings.Findings[0].UniqueIDFromTool)
+		}
+
+		client, err := defectdojo.NewClient(defectdojo.Options{
+			ClientOptions: target.ClientOptions{
+				Name: "DefectDojo",
+			},
+			Host:       "https://defectdojo.example.com/",
+			APIKey:     "api-key",
+			HTTPClient: testClient{callback, 201},
+		})
+		assert.Nil(t, err)
+
+		client.BatchSend(fixtures.DefaultPolicyReport, fixtures.DefaultPolicyReport.GetResults())
+	})
+	t.Run("Product and Engagement Templates", func(t *testing.T) {
+		t.Parallel()
+		callback := func(req *http.Request) {
+			assert.Equal(t, "Kubernetes", req.FormValue("product_type_name"))
+			assert.Equal(t, "prod-test", req.FormValue("product_name"))
+			assert.Equal(t, "test: policy-report", req.FormValue("engagement_name"))
+		}
+
+		client, err := defectdojo.NewClient(defectdojo.Options{
+			ClientOptions: target.ClientOptions{
+				Name: "DefectDojo",
+			},
+			Host:               "https://defectdojo.example.com",
+			APIKey:             "api-key",
+			ProductType:        "Kubernetes",
+			ProductTemplate:    "{{ .customfield.cluster }}-{{ .source }}",
+			EngagementTemplate: "{{ .namespace }}: {{ .report }}",
+			CustomFields:       map[string]string{"cluster": "prod"},
+			HTTPClient:         testClient{callback, 201},
+		})
+		assert.Nil(t, err)
+
+		client.Send(fixtures.DefaultPolicyReport, fixtures.DefaultPolicyReport.GetResults()[0])
+	})
+	t.Run("Invalid Template", func(t *testing.T) {
+		t.Parallel()
+		_, err := defectdojo.NewClient(defectdojo.Options{
+			Host:            "https://defectdojo.example.com",
+			ProductTemplate: "{{ .source ",
+		})
+
+		assert.NotNil(t, err)
+	})
+	t.Run("CleanUp Report without Results", func(t *testing.T) {
+		t.Parallel()
+		called := false
+		callback := func(req *http.Request) {
+			called = true
+
+			findings := parseFindings(t, req)
+			assert.Len(t, findings.Findings, 0)
+		}
+
+		client, err := defectdojo.NewClient(defectdojo.Options{
+			Host:       "https://defectdojo.example.com",
+			APIKey:     "api-key",
+			HTTPClient: testClient{callback, 201},
+		})
+		assert.Nil(t, err)
+
+		client.CleanUp(context.Background(), fixtures.MinPolicyReport)
+
+		assert.True(t, called, "expected empty reimport to close findings")
+	})
+	t.Run("Skip CleanUp Report with Results", func(t *testing.T) {
+		t.Parallel()
+		callback := func(req *http.Request) {
+			t.Error("unexpected reimport for report with results")
+		}
+
+		client, err := defectdojo.NewClient(defectdojo.Options{
+			Host:       "https://defectdojo.example.com",
+			APIKey:     "api-key",
+			HTTPClient: testClient{callback, 201},
+		})
+		assert.Nil(t, err)
+
+		client.CleanUp(context.Background(), fixtures.DefaultPolicyReport)
+	})
+	t.Run("Type", func(t *testing.T) {
+		t.Parallel()
+		client, _ := defectdojo.NewClient(defectdojo.Options{})
+
+		assert.Equal(t, target.SyncSend, client.Type())
+	})
+}
+
+func Test_MapFindings(t *testing.T) {
+	t.Parallel()
+	pass := fixtures.CompleteTargetSendResult
+	pass.Result = v1alpha2.StatusPass
+
+	findings := defectdojo.MapFindings([]openreports.ResultAdapter{fixtures.CompleteTargetSendResult, pass})
+
+	assert.Len(t, findings, 1)
+	assert.Equal(t, "High", findings[0].Severity)
+	assert.Equal(t, "require-requests-and-limits-required: autogen-check-for-requests-and-limits", findings[0].Title)
+	assert.Equal(t, "default/deployment/nginx", findings[0].ComponentName)
+	assert.Equal(t, "default", findings[0].Service)
+	assert.Contains(t, findings[0].Description, "**version**: 1.2.0")
+	assert.Equal(t, "2021-02-23", findings[0].Date)
+}
+
+func Test_MapSeverity(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "Info", defectdojo.MapSeverity(v1alpha2.SeverityInfo))
+	assert.Equal(t, "Low", defectdojo.MapSeverity(v1alpha2.SeverityLow))
+	assert.Equal(t, "Medium", defectdojo.MapSeverity(v1alpha2.SeverityMedium))
+	assert.Equal(t, "High", defectdojo.MapSeverity(v1alpha2.SeverityHigh))
+	assert.Equal(t, "Critical", defectdojo.MapSeverity(v1alpha2.SeverityCritical))
+	assert.Equal(t, "Info", defectdojo.MapSeverity(""))
+}
@@ -0,0 +1,132 @@
+package events
+
+import (
+	"fmt"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/kyverno/policy-reporter/pkg/crd/api/policyreport/v1alpha2"
+	"github.com/kyverno/policy-reporter/pkg/helper"
+	"github.com/kyverno/policy-reporter/pkg/openreports"
+	"github.com/kyverno/policy-reporter/pkg/report"
+	"github.com/kyverno/policy-reporter/pkg/target"
+)
+
+const DefaultComponent = "policy-reporter"
+
+// Options to configure the Kubernetes Events target
+type Options struct {
+	target.ClientOptions
+	Broadcasters *Broadcasters
+	// Cluster is the name of the local cluster, events can't be recorded for resources of other clusters
+	Cluster   string
+	Component string
+	// BurstSize of the per object rate limit, defaults to the client-go EventRecorder default
+	BurstSize int
+}
+
+type client struct {
+	target.BaseClient
+	recorder record.EventRecorder
+	cluster  string
+}
+
+// Validate only accepts fail and error results in addition to the configured filters
+func (e *client) Validate(rep openreports.ReportInterface, result openreports.ResultAdapter) bool {
+	if result.Result != v1alpha2.StatusFail && result.Result != v1alpha2.StatusError {
+		return false
+	}
+
+	return e.BaseClient.Validate(rep, result)
+}
+
+func (e *client) Send(rep openreports.ReportInterface, result openreports.ResultAdapter) {
+	if openreports.Cluster(rep) != e.cluster {
+		return
+	}
+
+	resource := report.ResultResource(rep, result)
+	if resource == nil {
+		return
+	}
+
+	annotations := map[string]string{
+		"policy-reporter.io/policy":   result.Policy,
+		"policy-reporter.io/rule":     result.Rule,
+		"policy-reporter.io/result":   string(result.Result),
+		"policy-reporter.io/severity": string(result.Severity),
+		"policy-reporter.io/source":   result.Source,
+	}
+
+	e.recorder.AnnotatedEventf(resource, annotations, corev1.EventTypeWarning, result.Policy, "%s", message(result))
+}
+
+func (e *client) Type() target.ClientType {
+	return target.SingleSend
+}
+
+func message(result openreports.ResultAdapter) string {
+	if result.Description != "" {
+		return result.Description
+	}
+
+	if result.Rule != "" {
+		return fmt.Sprintf("policy %s rule %s: %s", result.Policy, result.Rule, result.Result)
+	}
+
+	return fmt.Sprintf("policy %s: %s", result.Policy, result.Result)
+}
+
+// Broadcasters shares one started EventBroadcaster per burst size between all clients,
+// recreated clients reuse the existing broadcaster instead of starting a new recording goroutine
+type Broadcasters struct {
+	client       typedcorev1.EventsGetter
+	mx           sync.Mutex
+	broadcasters map[int]record.EventBroadcaster
+}
+
+// Get returns the broadcaster for the burst size, it is created and started on first use
+func (b *Broadcasters) Get(burstSize int) record.EventBroadcaster {
+	b.mx.Lock()
+	defer b.mx.Unlock()
+
+	if broadcaster, ok := b.broadcasters[burstSize]; ok {
+		return broadcaster
+	}
+
+	broadcaster := record.NewBroadcasterWithCorrelatorOptions(record.CorrelatorOptions{BurstSize: burstSize})
+	broadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{Interface: b.client.Events("")})
+
+	b.broadcasters[burstSize] = broadcaster
+
+	return broadcaster
+}
+
+// Shutdown stops all started broadcasters
+func (b *Broadcasters) Shutdown() {
+	b.mx.Lock()
+	defer b.mx.Unlock()
+
+	for size, broadcaster := range b.broadcasters {
+		broadcaster.Shutdown()
+		delete(b.broadcasters, size)
+	}
+}
+
+func NewBroadcasters(client typedcorev1.EventsGetter) *Broadcasters {
+	return &Broadcasters{client: client, broadcasters: make(map[int]record.EventBroadcaster)}
+}
+
+// NewClient creates a new events.client which records results as Warning Events on the affected resources.
+// Rate limiting and aggregation of similar events is handled by the client-go event correlator.
+func NewClient(options Options) target.Client {
+	return &client{
+		target.NewBaseClient(options.ClientOptions),
+		options.Broadcasters.Get(options.BurstSize).NewRecorder(scheme.Scheme, corev1.EventSource{Component: helper.Defaults(options.Component, DefaultComponent)}),
+		options.Cluster,
+	}
+}
@@ -0,0 +1,112 @@
+package events_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/kyverno/policy-reporter/pkg/fixtures"
+	"github.com/kyverno/policy-reporter/pkg/openreports"
+	"github.com/kyverno/policy-reporter/pkg/target"
+	"github.com/kyverno/policy-reporter/pkg/target/events"
+)
+
+func Test_EventsTarget(t *testing.T) {
+	t.Parallel()
+	t.Run("Record Warning Event", func(t *testing.T) {
+		t.Parallel()
+		kclient := fake.NewClientset()
+
+		client := events.NewClient(events.Options{
+			ClientOptions: target.ClientOptions{
+				Name: "KubernetesEvents",
+			},
+			Broadcasters: events.NewBroadcasters(kclient.CoreV1()),
+		})
+
+		client.Send(fixtures.DefaultPolicyReport, fixtures.FailResult)
+
+		var list *corev1.EventList
+		assert.Eventually(t, func() bool {
+			list, _ = kclient.CoreV1().Events("test").List(context.Background(), metav1.ListOptions{})
+			return len(list.Items) == 1
+		}, 5*time.Second, 10*time.Millisecond)
+
+		event := list.Items[0]
+
+		assert.Equal(t, corev1.EventTypeWarning, event.Type)
+		assert.Equal(t, fixtures.FailResult.Policy, event.Reason)
+		assert.Equal(t, fixtures.FailResult.Description, event.Message)
+		assert.Equal(t, "Deployment", event.InvolvedObject.Kind)
+		assert.Equal(t, "nginx", event.InvolvedObject.Name)
+		assert.Equal(t, "policy-reporter", event.Source.Component)
+		assert.Equal(t, "high", event.Annotations["policy-reporter.io/severity"])
+	})
+	t.Run("Skip reports of other clusters", func(t *testing.T) {
+		t.Parallel()
+		kclient := fake.NewClientset()
+
+		client := events.NewClient(events.Options{
+			ClientOptions: target.ClientOptions{
+				Name: "KubernetesEvents",
+			},
+			Broadcasters: events.NewBroadcasters(kclient.CoreV1()),
+			Cluster:      "local",
+		})
+
+		remote := &openreports.ReportAdapter{Report: fixtures.DefaultPolicyReport.Report.DeepCopy()}
+		openreports.SetCluster(remote, "remote")
+
+		client.Send(remote, fixtures.FailResult)
+
+		local := &openreports.ReportAdapter{Report: fixtures.DefaultPolicyReport.Report.DeepCopy()}
+		openreports.SetCluster(local, "local")
+
+		client.Send(local, fixtures.FailResult)
+
+		var list *corev1.EventList
+		assert.Eventually(t, func() bool {
+			list, _ = kclient.CoreV1().Events("test").List(context.Background(), metav1.ListOptions{})
+			return len(list.Items) > 0
+		}, 5*time.Second, 10*time.Millisecond)
+
+		assert.Len(t, list.Items, 1)
+		assert.Equal(t, int32(1), list.Items[0].Count)
+	})
+	t.Run("Validate fail and error results only", func(t *testing.T) {
+		t.Parallel()
+		client := events.NewClient(events.Options{
+			ClientOptions: target.ClientOptions{
+				Name: "KubernetesEvents",
+			},
+			Broadcasters: events.NewBroadcasters(fake.NewClientset().CoreV1()),
+		})
+
+		assert.True(t, client.Validate(fixtures.DefaultPolicyReport, fixtures.FailResult))
+		assert.False(t, client.Validate(fixtures.DefaultPolicyReport, fixtures.PassResult))
+	})
+	t.Run("Type", func(t *testing.T) {
+		t.Parallel()
+		client := events.NewClient(events.Options{
+			ClientOptions: target.ClientOptions{
+				Name: "KubernetesEvents",
+			},
+			Broadcasters: events.NewBroadcasters(fake.NewClientset().CoreV1()),
+		})
+
+		assert.Equal(t, target.SingleSend, client.Type())
+	})
+	t.Run("Share broadcasters", func(t *testing.T) {
+		t.Parallel()
+		broadcasters := events.NewBroadcasters(fake.NewClientset().CoreV1())
+		defer broadcasters.Shutdown()
+
+		assert.Same(t, broadcasters.Get(0), broadcasters.Get(0))
+		assert.NotSame(t, broadcasters.Get(0), broadcasters.Get(5))
+	})
+}
@@ -19,7 +19,16 @@ type Factory interface {
 	CreateJiraTarget(config, parent *targetconfig.Config[v1alpha1.JiraOptions]) *Target
 	CreateS3Target(config, parent *targetconfig.Config[v1alpha1.S3Options]) *Target
 	CreateKinesisTarget(config, parent *targetconfig.Config[v1alpha1.KinesisOptions]) *Target
+	CreateSNSTarget(config, parent *targetconfig.Config[v1alpha1.SNSOptions]) *Target
+	CreateSQSTarget(config, parent *targetconfig.Config[v1alpha1.SQSOptions]) *Target
 	CreateSecurityHubTarget(config, parent *targetconfig.Config[v1alpha1.SecurityHubOptions]) *Target
 	CreateGCSTarget(config, parent *targetconfig.Config[v1alpha1.GCSOptions]) *Target
 	CreateSplunkTarget(config, parent *targetconfig.Config[v1alpha1.SplunkOptions]) *Target
+	CreateDefectDojoTarget(config, parent *targetconfig.Config[v1alpha1.DefectDojoOptions]) *Target
+	CreateMattermostTarget(config, parent *targetconfig.Config[v1alpha1.MattermostOptions]) *Target
+	CreateRocketChatTarget(config, parent *targetconfig.Config[v1alpha1.RocketChatOptions]) *Target
+	CreateWebexTarget(config, parent *targetconfig.Config[v1alpha1.WebexOptions]) *Target
+	CreateMatrixTarget(config, parent *targetconfig.Config[v1alpha1.MatrixOptions]) *Target
+	CreateServiceNowTarget(config, parent *targetconfig.Config[v1alpha1.ServiceNowOptions]) *Target
+	CreateKubernetesEventsTarget(config, parent *targetconfig.Config[v1alpha1.KubernetesEventsOptions]) *Target
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/google/uuid"
 	_ "github.com/mattn/go-sqlite3"
 	"go.uber.org/zap"
+	corev1 "k8s.io/client-go/kubernetes/typed/core/v1"
 
 	"github.com/kyverno/policy-reporter/pkg/crd/api/targetconfig"
 	"github.com/kyverno/policy-reporter/pkg/crd/api/targetconfig/v1alpha1"
@@ -20,22 +21,31 @@ import (
 	"github.com/kyverno/policy-reporter/pkg/report"
 	"github.com/kyverno/policy-reporter/pkg/target"
 	"github.com/kyverno/policy-reporter/pkg/target/alertmanager"
+	"github.com/kyverno/policy-reporter/pkg/target/defectdojo"
 	"github.com/kyverno/policy-reporter/pkg/target/discord"
 	"github.com/kyverno/policy-reporter/pkg/target/elasticsearch"
+	"github.com/kyverno/policy-reporter/pkg/target/events"
 	"github.com/kyverno/policy-reporter/pkg/target/gcs"
 	"github.com/kyverno/policy-reporter/pkg/target/googlechat"
 	"github.com/kyverno/policy-reporter/pkg/target/http"
 	"github.com/kyverno/policy-reporter/pkg/target/jira"
 	"github.com/kyverno/policy-reporter/pkg/target/kinesis"
 	"github.com/kyverno/policy-reporter/pkg/target/loki"
+	"github.com/kyverno/policy-reporter/pkg/target/matrix"
+	"github.com/kyverno/policy-reporter/pkg/target/mattermost"
 	"github.com/kyverno/policy-reporter/pkg/target/provider/aws"
 	gs "github.com/kyverno/policy-reporter/pkg/target/provider/gcs"
+	"github.com/kyverno/policy-reporter/pkg/target/rocketchat"
 	"github.com/kyverno/policy-reporter/pkg/target/s3"
 	"github.com/kyverno/policy-reporter/pkg/target/securityhub"
+	"github.com/kyverno/policy-reporter/pkg/target/servicenow"
 	"github.com/kyverno/policy-reporter/pkg/target/slack"
+	"github.com/kyverno/policy-reporter/pkg/target/sns"
 	"github.com/kyverno/policy-reporter/pkg/target/splunk"
+	"github.com/kyverno/policy-reporter/pkg/target/sqs"
 	"github.com/kyverno/policy-reporter/pkg/target/teams"
 	"github.com/kyverno/policy-reporter/pkg/target/telegram"
+	"github.com/kyverno/policy-reporter/pkg/target/webex"
 	"github.com/kyverno/policy-reporter/pkg/target/webhook"
 	"github.com/kyverno/policy-reporter/pkg/validate"
 )
@@ -44,10 +54,31 @@ import (
 type TargetFactory struct {
 	secretClient  secrets.Client
 	filterFactory *target.ResultFilterFactory
+	policyClient  securityhub.PolicyClient
+	broadcasters  *events.Broadcasters
+	cluster       string
+}
+
+type Option func(f *TargetFactory)
+
+// WithPolicyClient is used to resolve policy annotations for target specific enrichments
+func WithPolicyClient(client securityhub.PolicyClient) Option {
+	return func(f *TargetFactory) {
+		f.policyClient = client
+	}
+}
+
+// WithEventsClient is used by the Kubernetes Events target to record events for resources of the given local cluster,
+// all created Kubernetes Events targets share the event broadcasters of the factory
+func WithEventsClient(client corev1.EventsGetter, cluster string) Option {
+	return func(f *TargetFactory) {
+		f.broadcasters = events.NewBroadcasters(client)
+		f.cluster = cluster
+	}
 }
 
 // LokiClients resolver method
-func createClients[T any](name string, config *targetconfig.Config[T], mapper func(*targetconfig.Config[T], *targetconfig.Config[T]) *target.Target) []*target.Target {
+func createClients[T any](f *TargetFactory, name string, config *targetconfig.Config[T], mapper func(*targetconfig.Config[T], *targetconfig.Config[T]) *target.Target) []*target.Target {
 	clients := make([]*target.Target, 0)
 	if config == nil {
 		return clients
@@ -59,7 +90,9 @@ func createClients[T any](name string, config *targetconfig.Config[T], mapper fu
 
 	setFallback(&config.Name, name)
 
-	if client := mapper(config, &targetconfig.Config[T]{Config: new(T)}); client != nil {
+	if _, err := f.filterFactory.Compile(config.Filter.CEL); err != nil {
+		zap.L().Error(config.Name+": invalid cel filter, target skipped", zap.Error(err))
+	} else if client := mapper(config, &targetconfig.Config[T]{Config: new(T)}); client != nil {
 		clients = append(clients, client)
 		config.Valid = true
 	}
@@ -71,6 +104,11 @@ func createClients[T any](name string, config *targetconfig.Config[T], mapper fu
 			channel.Config = new(T)
 		}
 
+		if _, err := f.filterFactory.Compile(channel.Filter.CEL); err != nil {
+			zap.L().Error(channel.Name+": invalid cel filter, channel skipped", zap.Error(err))
+			continue
+		}
+
 		if client := mapper(channel, config); client != nil {
 			clients = append(clients, client)
 			channel.Valid = true
@@ -87,21 +125,30 @@ func (f *TargetFactory) CreateClients(config *target.Targets) *target.Collection
 		return target.NewCollection()
 	}
 
-	targets = append(targets, createClients("Loki", config.Loki, f.CreateLokiTarget)...)
-	targets = append(targets, createClients("Elasticsearch", config.Elasticsearch, f.CreateElasticsearchTarget)...)
-	targets = append(targets, createClients("Slack", config.Slack, f.CreateSlackTarget)...)
-	targets = append(targets, createClients("Discord", config.Discord, f.CreateDiscordTarget)...)
-	targets = append(targets, createClients("Teams", config.Teams, f.CreateTeamsTarget)...)
-	targets = append(targets, createClients("GoogleChat", config.GoogleChat, f.CreateGoogleChatTarget)...)
-	targets = append(targets, createClients("Jira", config.Jira, f.CreateJiraTarget)...)
-	targets = append(targets, createClients("Telegram", config.Telegram, f.CreateTelegramTarget)...)
-	targets = append(targets, createClients("Webhook", config.Webhook, f.CreateWebhookTarget)...)
-	targets = append(targets, createClients("S3", config.S3, f.CreateS3Target)...)
-	targets = append(targets, createClients("Kinesis", config.Kinesis, f.CreateKinesisTarget)...)
-	targets = append(targets, createClients("SecurityHub", config.SecurityHub, f.CreateSecurityHubTarget)...)
-	targets = append(targets, createClients("GoogleCloudStorage", config.GCS, f.CreateGCSTarget)...)
-	targets = append(targets, createClients("AlertManager", config.AlertManager, f.CreateAlertManagerTarget)...)
-	targets = append(targets, createClients("Splunk", config.Splunk, f.CreateSplunkTarget)...)
+	targets = append(targets, createClients(f, "Loki", config.Loki, f.CreateLokiTarget)...)
+	targets = append(targets, createClients(f, "Elasticsearch", config.Elasticsearch, f.CreateElasticsearchTarget)...)
+	targets = append(targets, createClients(f, "Slack", config.Slack, f.CreateSlackTarget)...)
+	targets = append(targets, createClients(f, "Discord", config.Discord, f.CreateDiscordTarget)...)
+	targets = append(targets, createClients(f, "Teams", config.Teams, f.CreateTeamsTarget)...)
+	targets = append(targets, createClients(f, "GoogleChat", config.GoogleChat, f.CreateGoogleChatTarget)...)
+	targets = append(targets, createClients(f, "Jira", config.Jira, f.CreateJiraTarget)...)
+	targets = append(targets, createClients(f, "Telegram", config.Telegram, f.CreateTelegramTarget)...)
+	targets = append(targets, createClients(f, "Webhook", config.Webhook, f.CreateWebhookTarget)...)
+	targets = append(targets, createClients(f, "S3", config.S3, f.CreateS3Target)...)
+	targets = append(targets, createClients(f, "Kinesis", config.Kinesis, f.CreateKinesisTarget)...)
+	targets = append(targets, createClients(f, "SNS", config.SNS, f.CreateSNSTarget)...)
+	targets = append(targets, createClients(f, "SQS", config.SQS, f.CreateSQSTarget)...)
+	targets = append(targets, createClients(f, "SecurityHub", config.SecurityHub, f.CreateSecurityHubTarget)...)
+	targets = append(targets, createClients(f, "GoogleCloudStorage", config.GCS, f.CreateGCSTarget)...)
+	targets = append(targets, createClients(f, "AlertManager", config.AlertManager, f.CreateAlertManagerTarget)...)
+	targets = append(targets, createClients(f, "Splunk", config.Splunk, f.CreateSplunkTarget)...)
+	targets = append(targets, createClients(f, "DefectDojo", config.DefectDojo, f.CreateDefectDojoTarget)...)
+	targets = append(targets, createClients(f, "Mattermost", config.Mattermost, f.CreateMattermostTarget)...)
+	targets = append(targets, createClients(f, "RocketChat", config.RocketChat, f.CreateRocketChatTarget)...)
+	targets = append(targets, createClients(f, "Webex", config.Webex, f.CreateWebexTarget)...)
+	targets = append(targets, createClients(f, "Matrix", config.Matrix, f.CreateMatrixTarget)...)
+	targets = append(targets, createClients(f, "ServiceNow", config.ServiceNow, f.CreateServiceNowTarget)...)
+	targets = append(targets, createClients(f, "KubernetesEvents", config.KubernetesEvents, f.CreateKubernetesEventsTarget)...)
 
 	collection := target.NewCollection(targets...)
 
@@ -117,33 +164,55 @@ func (f *TargetFactory) CreateClients(config *target.Targets) *target.Collection
 func (f *TargetFactory) CreateSingleClient(tc *v1alpha1.TargetConfig) (*target.Target, error) {
 	var target *target.Target
 
+	if _, err := f.filterFactory.Compile(tc.Spec.Filter.CEL); err != nil {
+		return nil, fmt.Errorf("invalid filter.cel of TargetConfig %s: %w", tc.Name, err)
+	}
+
 	switch {
 	case tc.Spec.S3 != nil:
-		target = helper.First(createClients(tc.Name, createConfig(tc, tc.Spec.S3), f.CreateS3Target))
+		target = helper.First(createClients(f, tc.Name, createConfig(tc, tc.Spec.S3), f.CreateS3Target))
 	case tc.Spec.Webhook != nil:
-		target = helper.First(createClients(tc.Name, createConfig(tc, tc.Spec.Webhook), f.CreateWebhookTarget))
+		target = helper.First(createClients(f, tc.Name, createConfig(tc, tc.Spec.Webhook), f.CreateWebhookTarget))
 	case tc.Spec.GCS != nil:
-		target = helper.First(createClients(tc.Name, createConfig(tc, tc.Spec.GCS), f.CreateGCSTarget))
+		target = helper.First(createClients(f, tc.Name, createConfig(tc, tc.Spec.GCS), f.CreateGCSTarget))
 	case tc.Spec.ElasticSearch != nil:
-		target = helper.First(createClients(tc.Name, createConfig(tc, tc.Spec.ElasticSearch), f.CreateElasticsearchTarget))
+		target = helper.First(createClients(f, tc.Name, createConfig(tc, tc.Spec.ElasticSearch), f.CreateElasticsearchTarget))
 	case tc.Spec.Telegram != nil:
-		target = helper.First(createClients(tc.Name, createConfig(tc, tc.Spec.Telegram), f.CreateTelegramTarget))
+		target = helper.First(createClients(f, tc.Name, createConfig(tc, tc.Spec.Telegram), f.CreateTelegramTarget))
 	case tc.Spec.Kinesis != nil:
-		target = helper.First(createClients(tc.Name, createConfig(tc, tc.Spec.Kinesis), f.CreateKinesisTarget))
+		target = helper.First(createClients(f, tc.Name, createConfig(tc, tc.Spec.Kinesis), f.CreateKinesisTarget))
+	case tc.Spec.SNS != nil:
+		target = helper.First(createClients(f, tc.Name, createConfig(tc, tc.Spec.SNS), f.CreateSNSTarget))
+	case tc.Spec.SQS != nil:
+		target = helper.First(createClients(f, tc.Name, createConfig(tc, tc.Spec.SQS), f.CreateSQSTarget))
 	case tc.Spec.SecurityHub != nil:
-		target = helper.First(createClients(tc.Name, createConfig(tc, tc.Spec.SecurityHub), f.CreateSecurityHubTarget))
+		target = helper.First(createClients(f, tc.Name, createConfig(tc, tc.Spec.SecurityHub), f.CreateSecurityHubTarget))
 	case tc.Spec.Loki != nil:
-		target = helper.First(createClients(tc.Name, createConfig(tc, tc.Spec.Loki), f.CreateLokiTarget))
+		target = helper.First(createClients(f, tc.Name, createConfig(tc, tc.Spec.Loki), f.CreateLokiTarget))
 	case tc.Spec.Slack != nil:
-		target = helper.First(createClients(tc.Name, createConfig(tc, tc.Spec.Slack), f.CreateSlackTarget))
+		target = helper.First(createClients(f, tc.Name, createConfig(tc, tc.Spec.Slack), f.CreateSlackTarget))
 	case tc.Spec.Teams != nil:
-		target = helper.First(createClients(tc.Name, createConfig(tc, tc.Spec.Teams), f.CreateTeamsTarget))
+		target = helper.First(createClients(f, tc.Name, createConfig(tc, tc.Spec.Teams), f.CreateTeamsTarget))
 	case tc.Spec.Jira != nil:
-		target = helper.First(createClients(tc.Name, createConfig(tc, tc.Spec.Jira), f.CreateJiraTarget))
+		target = helper.First(createClients(f, tc.Name, createConfig(tc, tc.Spec.Jira), f.CreateJiraTarget))
 	case tc.Spec.AlertManager != nil:
-		target = helper.First(createClients(tc.Name, createConfig(tc, tc.Spec.AlertManager), f.CreateAlertManagerTarget))
+		target = helper.First(createClients(f, tc.Name, createConfig(tc, tc.Spec.AlertManager), f.CreateAlertManagerTarget))
 	case tc.Spec.Splunk != nil:
-		target = helper.First(createClients(tc.Name, createConfig(tc, tc.Spec.Splunk), f.CreateSplunkTarget))
+		target = helper.First(createClients(f, tc.Name, createConfig(tc, tc.Spec.Splunk), f.CreateSplunkTarget))
+	case tc.Spec.DefectDojo != nil:
+		target = helper.First(createClients(f, tc.Name, createConfig(tc, tc.Spec.DefectDojo), f.CreateDefectDojoTarget))
+	case tc.Spec.Mattermost != nil:
+		target = helper.First(createClients(f, tc.Name, createConfig(tc, tc.Spec.Mattermost), f.CreateMattermostTarget))
+	case tc.Spec.RocketChat != nil:
+		target = helper.First(createClients(f, tc.Name, createConfig(tc, tc.Spec.RocketChat), f.CreateRocketChatTarget))
+	case tc.Spec.Webex != nil:
+		target = helper.First(createClients(f, tc.Name, createConfig(tc, tc.Spec.Webex), f.CreateWebexTarget))
+	case tc.Spec.Matrix != nil:
+		target = helper.First(createClients(f, tc.Name, createConfig(tc, tc.Spec.Matrix), f.CreateMatrixTarget))
+	case tc.Spec.ServiceNow != nil:
+		target = helper.First(createClients(f, tc.Name, createConfig(tc, tc.Spec.ServiceNow), f.CreateServiceNowTarget))
+	case tc.Spec.KubernetesEvents != nil:
+		target = helper.First(createClients(f, tc.Name, createConfig(tc, tc.Spec.KubernetesEvents), f.CreateKubernetesEventsTarget))
 	default:
 		return nil, fmt.Errorf("invalid target type passed")
 	}
@@ -451,18 +520,6 @@ func (f *TargetFactory) CreateWebhookTarget(config, parent *targetconfig.Config[
 		return nil
 	}
 
-	var keepalive time.Duration
-	if config.Config.Keepalive != nil && config.Config.Keepalive.Interval != "" {
-		var err error
-		keepalive, err = time.ParseDuration(config.Config.Keepalive.Interval)
-		if err != nil {
-			zap.L().Error("failed to parse keepalive duration",
-				zap.String("target", config.Name),
-				zap.String("keepalive", config.Config.Keepalive.Interval),
-				zap.Error(err))
-		}
-	}
-
 	zap.S().Infof("%s configured", config.Name)
 
 	return &target.Target{
@@ -470,7 +527,7 @@ func (f *TargetFactory) CreateWebhookTarget(config, parent *targetconfig.Config[
 		Type:         target.Webhook,
 		Config:       config,
 		ParentConfig: parent,
-		Keepalive:    keepalive,
+		Keepalive:    parseKeepalive(config.Name, config.Config.Keepalive),
 		Client: webhook.NewClient(webhook.Options{
 			ClientOptions: target.ClientOptions{
 				Name:                  config.Name,
@@ -512,17 +569,7 @@ func (f *TargetFactory) CreateTelegramTarget(config, parent *targetconfig.Config
 
 	config.MapBaseParent(parent)
 
-	if len(parent.Config.Headers) > 0 {
-		headers := map[string]string{}
-		for header, value := range parent.Config.Headers {
-			headers[header] = value
-		}
-		for header, value := range config.Config.Headers {
-			headers[header] = value
-		}
-
-		config.Config.Headers = headers
-	}
+	config.Config.Headers = mergeHeaders(parent.Config.Headers, config.Config.Headers)
 
 	host := "https://api.telegram.org"
 	if config.Config.Webhook != "" {
@@ -791,6 +838,124 @@ func (f *TargetFactory) CreateKinesisTarget(config, parent *targetconfig.Config[
 	}
 }
 
+func (f *TargetFactory) CreateSNSTarget(config, parent *targetconfig.Config[v1alpha1.SNSOptions]) *target.Target {
+	if config == nil || config.Config == nil {
+		return nil
+	}
+
+	if (parent.SecretRef != "" && f.secretClient != nil) || parent.MountedSecret != "" {
+		f.mapSecretValues(parent, parent.SecretRef, parent.MountedSecret)
+	}
+
+	if (config.SecretRef != "" && f.secretClient != nil) || config.MountedSecret != "" {
+		f.mapSecretValues(config, config.SecretRef, config.MountedSecret)
+	}
+
+	setFallback(&config.Config.TopicARN, parent.Config.TopicARN)
+	if config.Config.TopicARN == "" {
+		return nil
+	}
+
+	config.Config.MapAWSParent(parent.Config.AWSConfig)
+
+	sugar := zap.S()
+	if err := checkAWSConfig(config.Name, config.Config.AWSConfig, parent.Config.AWSConfig); err != nil {
+		sugar.Error(err)
+
+		return nil
+	}
+
+	setFallback(&config.Config.Region, os.Getenv("AWS_REGION"))
+
+	config.MapBaseParent(parent)
+
+	snsClient := aws.NewSNSClient(
+		config.Config.AccessKeyID,
+		config.Config.SecretAccessKey,
+		config.Config.Region,
+		config.Config.Endpoint,
+		config.Config.TopicARN,
+	)
+
+	sugar.Infof("%s configured", config.Name)
+
+	return &target.Target{
+		ID:           uuid.NewString(),
+		Type:         target.SNS,
+		Config:       config,
+		ParentConfig: parent,
+		Client: sns.NewClient(sns.Options{
+			ClientOptions: target.ClientOptions{
+				Name:                  config.Name,
+				SkipExistingOnStartup: config.SkipExisting,
+				ResultFilter:          f.createResultFilter(config.Filter, config.MinimumSeverity, config.Sources),
+				ReportFilter:          createReportFilter(config.Filter),
+			},
+			CustomFields: config.CustomFields,
+			SNS:          snsClient,
+		}),
+	}
+}
+
+func (f *TargetFactory) CreateSQSTarget(config, parent *targetconfig.Config[v1alpha1.SQSOptions]) *target.Target {
+	if config == nil || config.Config == nil {
+		return nil
+	}
+
+	if (parent.SecretRef != "" && f.secretClient != nil) || parent.MountedSecret != "" {
+		f.mapSecretValues(parent, parent.SecretRef, parent.MountedSecret)
+	}
+
+	if (config.SecretRef != "" && f.secretClient != nil) || config.MountedSecret != "" {
+		f.mapSecretValues(config, config.SecretRef, config.MountedSecret)
+	}
+
+	setFallback(&config.Config.QueueURL, parent.Config.QueueURL)
+	if config.Config.QueueURL == "" {
+		return nil
+	}
+
+	config.Config.MapAWSParent(parent.Config.AWSConfig)
+
+	sugar := zap.S()
+	if err := checkAWSConfig(config.Name, config.Config.AWSConfig, parent.Config.AWSConfig); err != nil {
+		sugar.Error(err)
+
+		return nil
+	}
+
+	setFallback(&config.Config.Region, os.Getenv("AWS_REGION"))
+
+	config.MapBaseParent(parent)
+
+	sqsClient := aws.NewSQSClient(
+		config.Config.AccessKeyID,
+		config.Config.SecretAccessKey,
+		config.Config.Region,
+		config.Config.Endpoint,
+		config.Config.QueueURL,
+	)
+
+	sugar.Infof("%s configured", config.Name)
+
+	return &target.Target{
+		ID:           uuid.NewString(),
+		Type:         target.SQS,
+		Config:       config,
+		ParentConfig: parent,
+		Client: sqs.NewClient(sqs.Options{
+			ClientOptions: target.ClientOptions{
+				Name:                  config.Name,
+				SkipExistingOnStartup: config.SkipExisting,
+				ResultFilter:          f.createResultFilter(config.Filter, config.MinimumSeverity, config.Sources),
+				ReportFilter:          createReportFilter(config.Filter),
+			},
+			CustomFields: config.CustomFields,
+			SQS:          sqsClient,
+		}),
+	}
+}
+
 func (f *TargetFactory) CreateSecurityHubTarget(config, parent *targetconfig.Config[v1alpha1.SecurityHubOptions]) *target.Target {
 	if config == nil || config.Config == nil {
 		return nil
@@ -822,16 +987,46 @@ func (f *TargetFactory) CreateSecurityHubTarget(config, parent *targetconfig.Con
 
 	setFallback(&config.Config.ProductName, parent.Config.ProductName, "Policy Reporter")
 	setFallback(&config.Config.CompanyName, parent.Config.CompanyName, "Kyverno")
+	setFallback(&config.Config.RoleARN, parent.Config.RoleARN)
+	setFallback(&config.Config.ExternalID, parent.Config.ExternalID)
+	setFallback(&config.Config.RequirementsAnnotation, parent.Config.RequirementsAnnotation)
 	setInt(&config.Config.DelayInSeconds, parent.Config.DelayInSeconds)
 
-	client := aws.NewHubClient(
+	client := aws.NewAssumeRoleHubClient(
 		config.Config.AccessKeyID,
 		config.Config.SecretAccessKey,
 		config.Config.Region,
 		config.Config.Endpoint,
+		config.Config.RoleARN,
+		config.Config.ExternalID,
 	)
 
-	zap.L().Info(config.Name+" configured", zap.Bool("synchronize", config.Config.Synchronize))
+	routes := make([]securityhub.Route, 0, len(config.Config.Routes))
+	for _, r := range config.Config.Routes {
+		if r.AccountID == "" {
+			sugar.Errorf("%s: route without accountId is ignored", config.Name)
+			continue
+		}
+
+		setFallback(&r.Region, config.Config.Region)
+
+		routes = append(routes, securityhub.Route{
+			Namespaces:   validate.RuleSets{Include: r.Namespaces},
+			ReportLabels: r.ReportLabels,
+			AccountID:    r.AccountID,
+			Region:       r.Region,
+			Client: aws.NewAssumeRoleHubClient(
+				config.Config.AccessKeyID,
+				config.Config.SecretAccessKey,
+				r.Region,
+				config.Config.Endpoint,
+				r.RoleARN,
+				r.ExternalID,
+			),
+		})
+	}
+
+	zap.L().Info(config.Name+" configured", zap.Bool("synchronize", config.Config.Synchronize), zap.Int("routes", len(routes)))
 
 	hub := securityhub.NewClient(securityhub.Options{
 		ClientOptions: target.ClientOptions{
@@ -848,6 +1043,10 @@ func (f *TargetFactory) CreateSecurityHubTarget(config, parent *targetconfig.Con
 		Region:       config.Config.Region,
 		Delay:        time.Duration(config.Config.DelayInSeconds) * time.Second,
 		Synchronize:  config.Config.Synchronize,
+		Routes:       routes,
+		Policies:     f.policyClient,
+
+		RequirementsAnnotation: config.Config.RequirementsAnnotation,
 	})
 
 	return &target.Target{
@@ -859,7 +1058,7 @@ func (f *TargetFactory) CreateSecurityHubTarget(config, parent *targetconfig.Con
 	}
 }
 
-func (f *TargetFactory) CreateGCSTarget(config, parent *targetconfig.Config[v1alpha1.GCSOptions]) *target.Target {
+func (f *TargetFactory) CreateDefectDojoTarget(config, parent *targetconfig.Config[v1alpha1.DefectDojoOptions]) *target.Target {
 	if config == nil || config.Config == nil {
 		return nil
 	}
@@ -872,48 +1071,109 @@ func (f *TargetFactory) CreateGCSTarget(config, parent *targetconfig.Config[v1al
 		f.mapSecretValues(config, config.SecretRef, config.MountedSecret)
 	}
 
-	setFallback(&config.Config.Bucket, parent.Config.Bucket)
-	if config.Config.Bucket == "" {
+	setFallback(&config.Config.Host, parent.Config.Host)
+	setFallback(&config.Config.APIKey, parent.Config.APIKey)
+
+	if config.Config.Host == "" || config.Config.APIKey == "" {
 		return nil
 	}
 
-	sugar := zap.S()
-
-	setFallback(&config.Config.Credentials, parent.Config.Credentials)
-	setFallback(&config.Config.Prefix, parent.Config.Prefix, "policy-reporter")
+	setFallback(&config.Config.Certificate, parent.Config.Certificate)
+	setFallback(&config.Config.ProductType, parent.Config.ProductType)
+	setFallback(&config.Config.ProductTemplate, parent.Config.ProductTemplate)
+	setFallback(&config.Config.EngagementTemplate, parent.Config.EngagementTemplate)
+	setBool(&config.Config.SkipTLS, parent.Config.SkipTLS)
 
 	config.MapBaseParent(parent)
 
-	gcsClient := gs.NewClient(
-		context.Background(),
-		config.Config.Credentials,
-		config.Config.Bucket,
-	)
-	if gcsClient == nil {
+	client, err := defectdojo.NewClient(defectdojo.Options{
+		ClientOptions: target.ClientOptions{
+			Name:                  config.Name,
+			SkipExistingOnStartup: config.SkipExisting,
+			ResultFilter:          f.createResultFilter(config.Filter, config.MinimumSeverity, config.Sources),
+			ReportFilter:          createReportFilter(config.Filter),
+		},
+		Host:               config.Config.Host,
+		APIKey:             config.Config.APIKey,
+		ProductType:        config.Config.ProductType,
+		ProductTemplate:    config.Config.ProductTemplate,
+		EngagementTemplate: config.Config.EngagementTemplate,
+		Headers:            config.Config.Headers,
+		CustomFields:       config.CustomFields,
+		HTTPClient:         http.NewClient(config.Config.Certificate, config.Config.SkipTLS),
+	})
+	if err != nil {
+		zap.S().Errorf("failed to create DefectDojo client: %v", err)
 		return nil
 	}
 
-	sugar.Infof("%s configured", config.Name)
+	zap.S().Infof("%s configured", config.Name)
+
 	return &target.Target{
 		ID:           uuid.NewString(),
-		Type:         target.GCS,
+		Type:         target.DefectDojo,
 		Config:       config,
 		ParentConfig: parent,
-		Client: gcs.NewClient(gcs.Options{
+		Client:       client,
+	}
+}
+
+func (f *TargetFactory) CreateMattermostTarget(config, parent *targetconfig.Config[v1alpha1.MattermostOptions]) *target.Target {
+	if config == nil || config.Config == nil {
+		return nil
+	}
+
+	if (parent.SecretRef != "" && f.secretClient != nil) || parent.MountedSecret != "" {
+		f.mapSecretValues(parent, parent.SecretRef, parent.MountedSecret)
+	}
+
+	if (config.SecretRef != "" && f.secretClient != nil) || config.MountedSecret != "" {
+		f.mapSecretValues(config, config.SecretRef, config.MountedSecret)
+	}
+
+	setFallback(&config.Config.Webhook, parent.Config.Webhook)
+
+	if config.Config.Webhook == "" {
+		return nil
+	}
+
+	setFallback(&config.Config.Certificate, parent.Config.Certificate)
+	setFallback(&config.Config.Username, parent.Config.Username)
+	setFallback(&config.Config.IconURL, parent.Config.IconURL)
+	setBool(&config.Config.SkipTLS, parent.Config.SkipTLS)
+
+	config.MapBaseParent(parent)
+
+	config.Config.Headers = mergeHeaders(parent.Config.Headers, config.Config.Headers)
+
+	zap.S().Infof("%s configured", config.Name)
+
+	return &target.Target{
+		ID:           uuid.NewString(),
+		Type:         target.Mattermost,
+		Config:       config,
+		ParentConfig: parent,
+		Keepalive:    parseKeepalive(config.Name, config.Config.Keepalive),
+		Client: mattermost.NewClient(mattermost.Options{
 			ClientOptions: target.ClientOptions{
 				Name:                  config.Name,
 				SkipExistingOnStartup: config.SkipExisting,
 				ResultFilter:          f.createResultFilter(config.Filter, config.MinimumSeverity, config.Sources),
 				ReportFilter:          createReportFilter(config.Filter),
 			},
-			Client:       gcsClient,
+			Webhook:      config.Config.Webhook,
+			Channel:      config.Config.Channel,
+			Username:     config.Config.Username,
+			IconURL:      config.Config.IconURL,
+			Headers:      config.Config.Headers,
 			CustomFields: config.CustomFields,
-			Prefix:       config.Config.Prefix,
+			HTTPClient:   http.NewClient(config.Config.Certificate, config.Config.SkipTLS),
+			Keepalive:    config.Config.Keepalive,
 		}),
 	}
 }
 
-func (f *TargetFactory) CreateAlertManagerTarget(config, parent *targetconfig.Config[v1alpha1.HostOptions]) *target.Target {
+func (f *TargetFactory) CreateRocketChatTarget(config, parent *targetconfig.Config[v1alpha1.RocketChatOptions]) *target.Target {
 	if config == nil || config.Config == nil {
 		return nil
 	}
@@ -926,76 +1186,427 @@ func (f *TargetFactory) CreateAlertManagerTarget(config, parent *targetconfig.Co
 		f.mapSecretValues(config, config.SecretRef, config.MountedSecret)
 	}
 
-	if config.Config.Host == "" && parent.Config.Host == "" {
+	setFallback(&config.Config.Webhook, parent.Config.Webhook)
+
+	if config.Config.Webhook == "" {
 		return nil
 	}
 
-	setFallback(&config.Config.Host, parent.Config.Host)
 	setFallback(&config.Config.Certificate, parent.Config.Certificate)
+	setFallback(&config.Config.Alias, parent.Config.Alias)
+	setFallback(&config.Config.Avatar, parent.Config.Avatar)
 	setBool(&config.Config.SkipTLS, parent.Config.SkipTLS)
 
 	config.MapBaseParent(parent)
 
+	config.Config.Headers = mergeHeaders(parent.Config.Headers, config.Config.Headers)
+
 	zap.S().Infof("%s configured", config.Name)
 
 	return &target.Target{
 		ID:           uuid.NewString(),
-		Type:         target.AlertManager,
+		Type:         target.RocketChat,
 		Config:       config,
 		ParentConfig: parent,
-		Client: alertmanager.NewClient(alertmanager.Options{
+		Keepalive:    parseKeepalive(config.Name, config.Config.Keepalive),
+		Client: rocketchat.NewClient(rocketchat.Options{
 			ClientOptions: target.ClientOptions{
 				Name:                  config.Name,
 				SkipExistingOnStartup: config.SkipExisting,
 				ResultFilter:          f.createResultFilter(config.Filter, config.MinimumSeverity, config.Sources),
 				ReportFilter:          createReportFilter(config.Filter),
 			},
-			Host:         config.Config.Host,
+			Webhook:      config.Config.Webhook,
+			Channel:      config.Config.Channel,
+			Alias:        config.Config.Alias,
+			Avatar:       config.Config.Avatar,
 			Headers:      config.Config.Headers,
 			CustomFields: config.CustomFields,
 			HTTPClient:   http.NewClient(config.Config.Certificate, config.Config.SkipTLS),
+			Keepalive:    config.Config.Keepalive,
 		}),
 	}
 }
 
-func (f *TargetFactory) createResultFilter(filter filters.Filter, minimumSeverity string, sources []string) *report.ResultFilter {
-	sourceFilter := filter.Sources
-	if len(sources) > 0 {
-		sourceFilter = filters.ValueFilter{Include: sources}
+func (f *TargetFactory) CreateWebexTarget(config, parent *targetconfig.Config[v1alpha1.WebexOptions]) *target.Target {
+	if config == nil || config.Config == nil {
+		return nil
 	}
 
-	return f.filterFactory.CreateFilter(
-		validate.RuleSets{
-			Include:  filter.Namespaces.Include,
-			Exclude:  filter.Namespaces.Exclude,
-			Selector: filter.Namespaces.Selector,
-		},
-		ToRuleSet(filter.Severities),
-		ToRuleSet(filter.Status),
-		ToRuleSet(filter.Policies),
-		ToRuleSet(sourceFilter),
-		minimumSeverity,
-	)
-}
+	if (parent.SecretRef != "" && f.secretClient != nil) || parent.MountedSecret != "" {
+		f.mapSecretValues(parent, parent.SecretRef, parent.MountedSecret)
+	}
 
-func (f *TargetFactory) mapSecretValues(config any, ref, mountedSecret string) {
-	values := secrets.Values{}
+	if (config.SecretRef != "" && f.secretClient != nil) || config.MountedSecret != "" {
+		f.mapSecretValues(config, config.SecretRef, config.MountedSecret)
+	}
 
-	if ref != "" {
-		secretValues, err := f.secretClient.Get(context.Background(), ref)
-		values = secretValues
-		if err != nil {
-			zap.L().Warn("failed to get secret reference", zap.Error(err))
-			return
-		}
+	setFallback(&config.Config.Token, parent.Config.Token)
+	setFallback(&config.Config.RoomID, parent.Config.RoomID)
+
+	if config.Config.RoomID == "" || config.Config.Token == "" {
+		return nil
 	}
 
-	if mountedSecret != "" {
-		file, err := os.ReadFile(mountedSecret)
-		if err != nil {
-			zap.L().Warn("failed to get mounted secret", zap.Error(err))
-			return
-		}
+	setFallback(&config.Config.Host, parent.Config.Host)
+	setFallback(&config.Config.Certificate, parent.Config.Certificate)
+	setBool(&config.Config.SkipTLS, parent.Config.SkipTLS)
+
+	config.MapBaseParent(parent)
+
+	config.Config.Headers = mergeHeaders(parent.Config.Headers, config.Config.Headers)
+
+	zap.S().Infof("%s configured", config.Name)
+
+	return &target.Target{
+		ID:           uuid.NewString(),
+		Type:         target.Webex,
+		Config:       config,
+		ParentConfig: parent,
+		Keepalive:    parseKeepalive(config.Name, config.Config.Keepalive),
+		Client: webex.NewClient(webex.Options{
+			ClientOptions: target.ClientOptions{
+				Name:                  config.Name,
+				SkipExistingOnStartup: config.SkipExisting,
+				ResultFilter:          f.createResultFilter(config.Filter, config.MinimumSeverity, config.Sources),
+				ReportFilter:          createReportFilter(config.Filter),
+			},
+			Host:         config.Config.Host,
+			Token:        config.Config.Token,
+			RoomID:       config.Config.RoomID,
+			Headers:      config.Config.Headers,
+			CustomFields: config.CustomFields,
+			HTTPClient:   http.NewClient(config.Config.Certificate, config.Config.SkipTLS),
+			Keepalive:    config.Config.Keepalive,
+		}),
+	}
+}
+
+func (f *TargetFactory) CreateMatrixTarget(config, parent *targetconfig.Config[v1alpha1.MatrixOptions]) *target.Target {
+	if config == nil || config.Config == nil {
+		return nil
+	}
+
+	if (parent.SecretRef != "" && f.secretClient != nil) || parent.MountedSecret != "" {
+		f.mapSecretValues(parent, parent.SecretRef, parent.MountedSecret)
+	}
+
+	if (config.SecretRef != "" && f.secretClient != nil) || config.MountedSecret != "" {
+		f.mapSecretValues(config, config.SecretRef, config.MountedSecret)
+	}
+
+	setFallback(&config.Config.Host, parent.Config.Host)
+	setFallback(&config.Config.AccessToken, parent.Config.AccessToken)
+	setFallback(&config.Config.RoomID, parent.Config.RoomID)
+
+	if config.Config.Host == "" || config.Config.AccessToken == "" || config.Config.RoomID == "" {
+		return nil
+	}
+
+	setFallback(&config.Config.Certificate, parent.Config.Certificate)
+	setBool(&config.Config.SkipTLS, parent.Config.SkipTLS)
+
+	config.MapBaseParent(parent)
+
+	config.Config.Headers = mergeHeaders(parent.Config.Headers, config.Config.Headers)
+
+	zap.S().Infof("%s configured", config.Name)
+
+	return &target.Target{
+		ID:           uuid.NewString(),
+		Type:         target.Matrix,
+		Config:       config,
+		ParentConfig: parent,
+		Keepalive:    parseKeepalive(config.Name, config.Config.Keepalive),
+		Client: matrix.NewClient(matrix.Options{
+			ClientOptions: target.ClientOptions{
+				Name:                  config.Name,
+				SkipExistingOnStartup: config.SkipExisting,
+				ResultFilter:          f.createResultFilter(config.Filter, config.MinimumSeverity, config.Sources),
+				ReportFilter:          createReportFilter(config.Filter),
+			},
+			Host:         config.Config.Host,
+			AccessToken:  config.Config.AccessToken,
+			RoomID:       config.Config.RoomID,
+			Headers:      config.Config.Headers,
+			CustomFields: config.CustomFields,
+			HTTPClient:   http.NewClient(config.Config.Certificate, config.Config.SkipTLS),
+			Keepalive:    config.Config.Keepalive,
+		}),
+	}
+}
+
+func (f *TargetFactory) CreateServiceNowTarget(config, parent *targetconfig.Config[v1alpha1.ServiceNowOptions]) *target.Target {
+	if config == nil || config.Config == nil {
+		return nil
+	}
+
+	if (parent.SecretRef != "" && f.secretClient != nil) || parent.MountedSecret != "" {
+		f.mapSecretValues(parent, parent.SecretRef, parent.MountedSecret)
+	}
+
+	if (config.SecretRef != "" && f.secretClient != nil) || config.MountedSecret != "" {
+		f.mapSecretValues(config, config.SecretRef, config.MountedSecret)
+	}
+
+	setFallback(&config.Config.Host, parent.Config.Host)
+	setFallback(&config.Config.Username, parent.Config.Username)
+	setFallback(&config.Config.Password, parent.Config.Password)
+	setFallback(&config.Config.ClientID, parent.Config.ClientID)
+	setFallback(&config.Config.ClientSecret, parent.Config.ClientSecret)
+
+	if config.Config.Host == "" {
+		return nil
+	}
+
+	if config.Config.ClientID == "" && (config.Config.Username == "" || config.Config.Password == "") {
+		zap.L().Warn(config.Name + ": username and password or clientId and clientSecret required")
+		return nil
+	}
+
+	setFallback(&config.Config.Table, parent.Config.Table)
+	setFallback(&config.Config.Certificate, parent.Config.Certificate)
+	setBool(&config.Config.SkipTLS, parent.Config.SkipTLS)
+
+	if len(config.Config.Fields) == 0 {
+		config.Config.Fields = parent.Config.Fields
+	}
+	if len(config.Config.ResolveFields) == 0 {
+		config.Config.ResolveFields = parent.Config.ResolveFields
+	}
+
+	config.MapBaseParent(parent)
+
+	config.Config.Headers = mergeHeaders(parent.Config.Headers, config.Config.Headers)
+
+	client, err := servicenow.NewClient(servicenow.Options{
+		ClientOptions: target.ClientOptions{
+			Name:                  config.Name,
+			SkipExistingOnStartup: config.SkipExisting,
+			ResultFilter:          f.createResultFilter(config.Filter, config.MinimumSeverity, config.Sources),
+			ReportFilter:          createReportFilter(config.Filter),
+		},
+		Host:          config.Config.Host,
+		Table:         config.Config.Table,
+		Username:      config.Config.Username,
+		Password:      config.Config.Password,
+		ClientID:      config.Config.ClientID,
+		ClientSecret:  config.Config.ClientSecret,
+		Fields:        config.Config.Fields,
+		ResolveFields: config.Config.ResolveFields,
+		Headers:       config.Config.Headers,
+		CustomFields:  config.CustomFields,
+		HTTPClient:    http.NewClient(config.Config.Certificate, config.Config.SkipTLS),
+	})
+	if err != nil {
+		zap.S().Errorf("failed to create ServiceNow client: %v", err)
+		return nil
+	}
+
+	zap.S().Infof("%s configured", config.Name)
+
+	return &target.Target{
+		ID:           uuid.NewString(),
+		Type:         target.ServiceNow,
+		Config:       config,
+		ParentConfig: parent,
+		Client:       client,
+	}
+}
+
+func (f *TargetFactory) CreateKubernetesEventsTarget(config, parent *targetconfig.Config[v1alpha1.KubernetesEventsOptions]) *target.Target {
+	if config == nil || config.Config == nil {
+		return nil
+	}
+
+	if f.broadcasters == nil {
+		zap.L().Warn(config.Name + ": kubernetes client required")
+		return nil
+	}
+
+	setFallback(&config.Config.Component, parent.Config.Component)
+	if config.Config.BurstSize == 0 {
+		config.Config.BurstSize = parent.Config.BurstSize
+	}
+
+	config.MapBaseParent(parent)
+
+	zap.S().Infof("%s configured", config.Name)
+
+	return &target.Target{
+		ID:           uuid.NewString(),
+		Type:         target.KubernetesEvents,
+		Config:       config,
+		ParentConfig: parent,
+		Client: events.NewClient(events.Options{
+			ClientOptions: target.ClientOptions{
+				Name:                  config.Name,
+				SkipExistingOnStartup: config.SkipExisting,
+				ResultFilter:          f.createResultFilter(config.Filter, config.MinimumSeverity, config.Sources),
+				ReportFilter:          createReportFilter(config.Filter),
+			},
+			Broadcasters: f.broadcasters,
+			Cluster:      f.cluster,
+			Component:    config.Config.Component,
+			BurstSize:    config.Config.BurstSize,
+		}),
+	}
+}
+
+func (f *TargetFactory) CreateGCSTarget(config, parent *targetconfig.Config[v1alpha1.GCSOptions]) *target.Target {
+	if config == nil || config.Config == nil {
+		return nil
+	}
+
+	if (parent.SecretRef != "" && f.secretClient != nil) || parent.MountedSecret != "" {
+		f.mapSecretValues(parent, parent.SecretRef, parent.MountedSecret)
+	}
+
+	if (config.SecretRef != "" && f.secretClient != nil) || config.MountedSecret != "" {
+		f.mapSecretValues(config, config.SecretRef, config.MountedSecret)
+	}
+
+	setFallback(&config.Config.Bucket, parent.Config.Bucket)
+	if config.Config.Bucket == "" {
+		return nil
+	}
+
+	sugar := zap.S()
+
+	setFallback(&config.Config.Credentials, parent.Config.Credentials)
+	setFallback(&config.Config.Prefix, parent.Config.Prefix, "policy-reporter")
+
+	config.MapBaseParent(parent)
+
+	gcsClient := gs.NewClient(
+		context.Background(),
+		config.Config.Credentials,
+		config.Config.Bucket,
+	)
+	if gcsClient == nil {
+		return nil
+	}
+
+	sugar.Infof("%s configured", config.Name)
+	return &target.Target{
+		ID:           uuid.NewString(),
+		Type:         target.GCS,
+		Config:       config,
+		ParentConfig: parent,
+		Client: gcs.NewClient(gcs.Options{
+			ClientOptions: target.ClientOptions{
+				Name:                  config.Name,
+				SkipExistingOnStartup: config.SkipExisting,
+				ResultFilter:          f.createResultFilter(config.Filter, config.MinimumSeverity, config.Sources),
+				ReportFilter:          createReportFilter(config.Filter),
+			},
+			Client:       gcsClient,
+			CustomFields: config.CustomFields,
+			Prefix:       config.Config.Prefix,
+		}),
+	}
+}
+
+func (f *TargetFactory) CreateAlertManagerTarget(config, parent *targetconfig.Config[v1alpha1.HostOptions]) *target.Target {
+	if config == nil || config.Config == nil {
+		return nil
+	}
+
+	if (parent.SecretRef != "" && f.secretClient != nil) || parent.MountedSecret != "" {
+		f.mapSecretValues(parent, parent.SecretRef, parent.MountedSecret)
+	}
+
+	if (config.SecretRef != "" && f.secretClient != nil) || config.MountedSecret != "" {
+		f.mapSecretValues(config, config.SecretRef, config.MountedSecret)
+	}
+
+	if config.Config.Host == "" && parent.Config.Host == "" {
+		return nil
+	}
+
+	setFallback(&config.Config.Host, parent.Config.Host)
+	setFallback(&config.Config.Certificate, parent.Config.Certificate)
+	setBool(&config.Config.SkipTLS, parent.Config.SkipTLS)
+
+	config.MapBaseParent(parent)
+
+	zap.S().Infof("%s configured", config.Name)
+
+	return &target.Target{
+		ID:           uuid.NewString(),
+		Type:         target.AlertManager,
+		Config:       config,
+		ParentConfig: parent,
+		Client: alertmanager.NewClient(alertmanager.Options{
+			ClientOptions: target.ClientOptions{
+				Name:                  config.Name,
+				SkipExistingOnStartup: config.SkipExisting,
+				ResultFilter:          f.createResultFilter(config.Filter, config.MinimumSeverity, config.Sources),
+				ReportFilter:          createReportFilter(config.Filter),
+			},
+			Host:         config.Config.Host,
+			Headers:      config.Config.Headers,
+			CustomFields: config.CustomFields,
+			HTTPClient:   http.NewClient(config.Config.Certificate, config.Config.SkipTLS),
+		}),
+	}
+}
+
+func (f *TargetFactory) createResultFilter(filter filters.Filter, minimumSeverity string, sources []string) *report.ResultFilter {
+	sourceFilter := filter.Sources
+	if len(sources) > 0 {
+		sourceFilter = filters.ValueFilter{Include: sources}
+	}
+
+	resultFilter := f.filterFactory.CreateFilter(
+		validate.RuleSets{
+			Include:  filter.Namespaces.Include,
+			Exclude:  filter.Namespaces.Exclude,
+			Selector: filter.Namespaces.Selector,
+		},
+		ToRuleSet(filter.Severities),
+		ToRuleSet(filter.Status),
+		ToRuleSet(filter.Policies),
+		ToRuleSet(sourceFilter),
+		minimumSeverity,
+	)
+
+	f.filterFactory.AddResourceFilter(
+		resultFilter,
+		ToRuleSet(filter.Kinds),
+		ToRuleSet(filter.ResourceLabels),
+		ToRuleSet(filter.ResourceAnnotations),
+	)
+
+	// targets with invalid expressions are skipped by createClients, the program is already compiled
+	program, err := f.filterFactory.Compile(filter.CEL)
+	if err != nil {
+		zap.L().Error("failed to compile cel filter", zap.Error(err))
+	}
+
+	f.filterFactory.AddExpression(resultFilter, program)
+
+	return resultFilter
+}
+
+func (f *TargetFactory) mapSecretValues(config any, ref, mountedSecret string) {
+	values := secrets.Values{}
+
+	if ref != "" {
+		secretValues, err := f.secretClient.Get(context.Background(), ref)
+		values = secretValues
+		if err != nil {
+			zap.L().Warn("failed to get secret reference", zap.Error(err))
+			return
+		}
+	}
+
+	if mountedSecret != "" {
+		file, err := os.ReadFile(mountedSecret)
+		if err != nil {
+			zap.L().Warn("failed to get mounted secret", zap.Error(err))
+			return
+		}
 		err = json.Unmarshal(file, &values)
 		if err != nil {
 			zap.L().Warn("failed to unmarshal mounted secret", zap.Error(err))
@@ -1076,6 +1687,22 @@ func (f *TargetFactory) mapSecretValues(config any, ref, mountedSecret string) {
 			c.Config.SecretAccessKey = values.SecretAccessKey
 		}
 
+	case *targetconfig.Config[v1alpha1.SNSOptions]:
+		if values.AccessKeyID != "" {
+			c.Config.AccessKeyID = values.AccessKeyID
+		}
+		if values.SecretAccessKey != "" {
+			c.Config.SecretAccessKey = values.SecretAccessKey
+		}
+
+	case *targetconfig.Config[v1alpha1.SQSOptions]:
+		if values.AccessKeyID != "" {
+			c.Config.AccessKeyID = values.AccessKeyID
+		}
+		if values.SecretAccessKey != "" {
+			c.Config.SecretAccessKey = values.SecretAccessKey
+		}
+
 	case *targetconfig.Config[v1alpha1.SecurityHubOptions]:
 		if values.AccessKeyID != "" {
 			c.Config.AccessKeyID = values.AccessKeyID
@@ -1114,6 +1741,62 @@ func (f *TargetFactory) mapSecretValues(config any, ref, mountedSecret string) {
 			c.Config.Headers["Authorization"] = values.Token
 		}
 
+	case *targetconfig.Config[v1alpha1.MattermostOptions]:
+		if values.Webhook != "" {
+			c.Config.Webhook = values.Webhook
+		}
+		if values.Channel != "" {
+			c.Config.Channel = values.Channel
+		}
+
+	case *targetconfig.Config[v1alpha1.RocketChatOptions]:
+		if values.Webhook != "" {
+			c.Config.Webhook = values.Webhook
+		}
+		if values.Channel != "" {
+			c.Config.Channel = values.Channel
+		}
+
+	case *targetconfig.Config[v1alpha1.WebexOptions]:
+		if values.Host != "" {
+			c.Config.Host = values.Host
+		}
+		if values.Token != "" {
+			c.Config.Token = values.Token
+		}
+
+	case *targetconfig.Config[v1alpha1.MatrixOptions]:
+		if values.Host != "" {
+			c.Config.Host = values.Host
+		}
+		if values.Token != "" {
+			c.Config.AccessToken = values.Token
+		}
+
+	case *targetconfig.Config[v1alpha1.ServiceNowOptions]:
+		if values.Host != "" {
+			c.Config.Host = values.Host
+		}
+		if values.Username != "" {
+			c.Config.Username = values.Username
+		}
+		if values.Password != "" {
+			c.Config.Password = values.Password
+		}
+		if values.ClientSecret != "" {
+			c.Config.ClientSecret = values.ClientSecret
+		}
+
+	case *targetconfig.Config[v1alpha1.DefectDojoOptions]:
+		if values.Host != "" {
+			c.Config.Host = values.Host
+		}
+		if values.APIKey != "" {
+			c.Config.APIKey = values.APIKey
+		} else if values.Token != "" {
+			c.Config.APIKey = values.Token
+		}
+
 	case *targetconfig.Config[v1alpha1.JiraOptions]:
 		if values.Host != "" {
 			c.Config.Host = values.Host
@@ -1131,8 +1814,13 @@ func (f *TargetFactory) mapSecretValues(config any, ref, mountedSecret string) {
 }
 
 // what are those parameters ?
-func NewFactory(secretClient secrets.Client, filterFactory *target.ResultFilterFactory) target.Factory {
-	return &TargetFactory{secretClient: secretClient, filterFactory: filterFactory}
+func NewFactory(secretClient secrets.Client, filterFactory *target.ResultFilterFactory, opts ...Option) target.Factory {
+	f := &TargetFactory{secretClient: secretClient, filterFactory: filterFactory}
+	for _, opt := range opts {
+		opt(f)
+	}
+
+	return f
 }
 
 func mapWebhookTarget(config, parent *targetconfig.Config[v1alpha1.WebhookOptions]) {
@@ -1142,17 +1830,39 @@ func mapWebhookTarget(config, parent *targetconfig.Config[v1alpha1.WebhookOption
 
 	config.MapBaseParent(parent)
 
-	if len(parent.Config.Headers) > 0 {
-		headers := map[string]string{}
-		for header, value := range parent.Config.Headers {
-			headers[header] = value
-		}
-		for header, value := range config.Config.Headers {
-			headers[header] = value
-		}
+	config.Config.Headers = mergeHeaders(parent.Config.Headers, config.Config.Headers)
+}
 
-		config.Config.Headers = headers
+func mergeHeaders(parent, config map[string]string) map[string]string {
+	if len(parent) == 0 {
+		return config
 	}
+
+	headers := map[string]string{}
+	for header, value := range parent {
+		headers[header] = value
+	}
+	for header, value := range config {
+		headers[header] = value
+	}
+
+	return headers
+}
+
+func parseKeepalive(name string, keepalive *v1alpha1.KeepaliveConfig) time.Duration {
+	if keepalive == nil || keepalive.Interval == "" {
+		return 0
+	}
+
+	interval, err := time.ParseDuration(keepalive.Interval)
+	if err != nil {
+		zap.L().Error("failed to parse keepalive duration",
+			zap.String("target", name),
+			zap.String("keepalive", keepalive.Interval),
+			zap.Error(err))
+	}
+
+	return interval
 }
 
 func hasAWSIdentity() bool {
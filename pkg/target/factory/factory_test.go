@@ -16,6 +16,7 @@ import (
 	"github.com/kyverno/policy-reporter/pkg/crd/api/targetconfig"
 	"github.com/kyverno/policy-reporter/pkg/crd/api/targetconfig/v1alpha1"
 	"github.com/kyverno/policy-reporter/pkg/filters"
+	"github.com/kyverno/policy-reporter/pkg/fixtures"
 	"github.com/kyverno/policy-reporter/pkg/kubernetes/secrets"
 	"github.com/kyverno/policy-reporter/pkg/openreports"
 	"github.com/kyverno/policy-reporter/pkg/target"
@@ -239,6 +240,36 @@ var targets = target.Targets{
 		CustomFields:    map[string]string{"field": "value"},
 		Channels:        []*targetconfig.Config[v1alpha1.KinesisOptions]{{}},
 	},
+	SNS: &targetconfig.Config[v1alpha1.SNSOptions]{
+		Config: &v1alpha1.SNSOptions{
+			AWSConfig: v1alpha1.AWSConfig{
+				AccessKeyID:     "AccessKey",
+				SecretAccessKey: "SecretAccessKey",
+				Endpoint:        "http://localhost:4566",
+				Region:          "eu-central-1",
+			},
+			TopicARN: "arn:aws:sns:eu-central-1:000000000000:policy-reporter",
+		},
+		SkipExisting:    true,
+		MinimumSeverity: openreports.SeverityInfo,
+		CustomFields:    map[string]string{"field": "value"},
+		Channels:        []*targetconfig.Config[v1alpha1.SNSOptions]{{}},
+	},
+	SQS: &targetconfig.Config[v1alpha1.SQSOptions]{
+		Config: &v1alpha1.SQSOptions{
+			AWSConfig: v1alpha1.AWSConfig{
+				AccessKeyID:     "AccessKey",
+				SecretAccessKey: "SecretAccessKey",
+				Endpoint:        "http://localhost:4566",
+				Region:          "eu-central-1",
+			},
+			QueueURL: "http://localhost:4566/000000000000/policy-reporter.fifo",
+		},
+		SkipExisting:    true,
+		MinimumSeverity: openreports.SeverityInfo,
+		CustomFields:    map[string]string{"field": "value"},
+		Channels:        []*targetconfig.Config[v1alpha1.SQSOptions]{{}},
+	},
 	SecurityHub: &targetconfig.Config[v1alpha1.SecurityHubOptions]{
 		Config: &v1alpha1.SecurityHubOptions{
 			AWSConfig: v1alpha1.AWSConfig{
@@ -248,6 +279,11 @@ var targets = target.Targets{
 				Region:          "ru-central1",
 			},
 			AccountID: "AccountID",
+			RoleARN:   "arn:aws:iam::AccountID:role/policy-reporter",
+			Routes: []v1alpha1.SecurityHubRoute{
+				{Namespaces: []string{"team-a-*"}, AccountID: "TeamAccountID", RoleARN: "arn:aws:iam::TeamAccountID:role/policy-reporter"},
+				{ReportLabels: map[string]string{"team": "b"}},
+			},
 		},
 		SkipExisting:    true,
 		MinimumSeverity: openreports.SeverityInfo,
@@ -276,6 +312,72 @@ var targets = target.Targets{
 		MinimumSeverity: openreports.SeverityInfo,
 		CustomFields:    map[string]string{"field": "value"},
 	},
+	DefectDojo: &targetconfig.Config[v1alpha1.DefectDojoOptions]{
+		Config: &v1alpha1.DefectDojoOptions{
+			HostOptions: v1alpha1.HostOptions{
+				Host: "http://localhost:8080",
+			},
+			APIKey:          "apiKey",
+			ProductTemplate: "{{ .source }}",
+		},
+		SkipExisting:    true,
+		MinimumSeverity: openreports.SeverityInfo,
+		CustomFields:    map[string]string{"field": "value"},
+		Channels:        []*targetconfig.Config[v1alpha1.DefectDojoOptions]{{}},
+	},
+	Mattermost: &targetconfig.Config[v1alpha1.MattermostOptions]{
+		Config: &v1alpha1.MattermostOptions{
+			WebhookOptions: v1alpha1.WebhookOptions{
+				Webhook:   "http://localhost:8065/hooks/xxx",
+				Keepalive: &v1alpha1.KeepaliveConfig{Interval: "1h"},
+			},
+			Channel: "policy-reporter",
+		},
+		SkipExisting:    true,
+		MinimumSeverity: openreports.SeverityInfo,
+		CustomFields:    map[string]string{"field": "value"},
+		Channels:        []*targetconfig.Config[v1alpha1.MattermostOptions]{{Config: &v1alpha1.MattermostOptions{Channel: "alerts"}}},
+	},
+	RocketChat: &targetconfig.Config[v1alpha1.RocketChatOptions]{
+		Config: &v1alpha1.RocketChatOptions{
+			WebhookOptions: v1alpha1.WebhookOptions{
+				Webhook: "http://localhost:3000/hooks/xxx",
+			},
+		},
+		SkipExisting:    true,
+		MinimumSeverity: openreports.SeverityInfo,
+	},
+	Webex: &targetconfig.Config[v1alpha1.WebexOptions]{
+		Config: &v1alpha1.WebexOptions{
+			Token:  "token",
+			RoomID: "room",
+		},
+		SkipExisting:    true,
+		MinimumSeverity: openreports.SeverityInfo,
+	},
+	Matrix: &targetconfig.Config[v1alpha1.MatrixOptions]{
+		Config: &v1alpha1.MatrixOptions{
+			HostOptions: v1alpha1.HostOptions{
+				Host: "http://localhost:8008",
+			},
+			AccessToken: "token",
+			RoomID:      "!room:localhost",
+		},
+		SkipExisting:    true,
+		MinimumSeverity: openreports.SeverityInfo,
+	},
+	ServiceNow: &targetconfig.Config[v1alpha1.ServiceNowOptions]{
+		Config: &v1alpha1.ServiceNowOptions{
+			HostOptions: v1alpha1.HostOptions{
+				Host: "http://localhost:8080",
+			},
+			Username: "admin",
+			Password: "password",
+			Fields:   map[string]string{"assignment_group": "platform"},
+		},
+		SkipExisting:    true,
+		MinimumSeverity: openreports.SeverityHigh,
+	},
 	Jira: &targetconfig.Config[v1alpha1.JiraOptions]{
 		Config: &v1alpha1.JiraOptions{
 			ProjectKey: "PR",
@@ -298,8 +400,8 @@ func Test_ResolveTarget(t *testing.T) {
 	factory := factory.NewFactory(nil, nil)
 
 	clients := factory.CreateClients(&targets)
-	if len(clients.Clients()) != 27 {
-		t.Errorf("Expected 27 Client, got %d clients", len(clients.Clients()))
+	if len(clients.Clients()) != 39 {
+		t.Errorf("Expected 39 Client, got %d clients", len(clients.Clients()))
 	}
 }
 
@@ -308,18 +410,27 @@ func Test_ResolveTargetsWithoutRequiredConfiguration(t *testing.T) {
 	factory := factory.NewFactory(nil, nil)
 
 	targets := target.Targets{
-		Loki:          &targetconfig.Config[v1alpha1.LokiOptions]{},
-		Elasticsearch: &targetconfig.Config[v1alpha1.ElasticsearchOptions]{},
-		Slack:         &targetconfig.Config[v1alpha1.SlackOptions]{},
-		Discord:       &targetconfig.Config[v1alpha1.WebhookOptions]{},
-		Teams:         &targetconfig.Config[v1alpha1.WebhookOptions]{},
-		GoogleChat:    &targetconfig.Config[v1alpha1.WebhookOptions]{},
-		Webhook:       &targetconfig.Config[v1alpha1.WebhookOptions]{},
-		Telegram:      &targetconfig.Config[v1alpha1.TelegramOptions]{},
-		S3:            &targetconfig.Config[v1alpha1.S3Options]{},
-		Kinesis:       &targetconfig.Config[v1alpha1.KinesisOptions]{},
-		SecurityHub:   &targetconfig.Config[v1alpha1.SecurityHubOptions]{},
-		Jira:          &targetconfig.Config[v1alpha1.JiraOptions]{},
+		Loki:             &targetconfig.Config[v1alpha1.LokiOptions]{},
+		Elasticsearch:    &targetconfig.Config[v1alpha1.ElasticsearchOptions]{},
+		Slack:            &targetconfig.Config[v1alpha1.SlackOptions]{},
+		Discord:          &targetconfig.Config[v1alpha1.WebhookOptions]{},
+		Teams:            &targetconfig.Config[v1alpha1.WebhookOptions]{},
+		GoogleChat:       &targetconfig.Config[v1alpha1.WebhookOptions]{},
+		Webhook:          &targetconfig.Config[v1alpha1.WebhookOptions]{},
+		Telegram:         &targetconfig.Config[v1alpha1.TelegramOptions]{},
+		S3:               &targetconfig.Config[v1alpha1.S3Options]{},
+		Kinesis:          &targetconfig.Config[v1alpha1.KinesisOptions]{},
+		SNS:              &targetconfig.Config[v1alpha1.SNSOptions]{},
+		SQS:              &targetconfig.Config[v1alpha1.SQSOptions]{},
+		SecurityHub:      &targetconfig.Config[v1alpha1.SecurityHubOptions]{},
+		Jira:             &targetconfig.Config[v1alpha1.JiraOptions]{},
+		DefectDojo:       &targetconfig.Config[v1alpha1.DefectDojoOptions]{},
+		Mattermost:       &targetconfig.Config[v1alpha1.MattermostOptions]{},
+		RocketChat:       &targetconfig.Config[v1alpha1.RocketChatOptions]{},
+		Webex:            &targetconfig.Config[v1alpha1.WebexOptions]{},
+		Matrix:           &targetconfig.Config[v1alpha1.MatrixOptions]{},
+		ServiceNow:       &targetconfig.Config[v1alpha1.ServiceNowOptions]{},
+		KubernetesEvents: &targetconfig.Config[v1alpha1.KubernetesEventsOptions]{},
 	}
 
 	if len(factory.CreateClients(&targets).Clients()) != 0 {
@@ -504,6 +615,309 @@ func Test_SecurityHubValidation(t *testing.T) {
 	})
 }
 
+func Test_SNSValidation(t *testing.T) {
+	t.Parallel()
+	factory := factory.NewFactory(nil, nil)
+
+	targets := target.Targets{
+		SNS: &targetconfig.Config[v1alpha1.SNSOptions]{
+			Config: &v1alpha1.SNSOptions{
+				AWSConfig: v1alpha1.AWSConfig{Endpoint: "http://localhost:4566"},
+			},
+		},
+	}
+
+	t.Run("SNS.TopicARN", func(t *testing.T) {
+		t.Parallel()
+		if len(factory.CreateClients(&targets).Clients()) != 0 {
+			t.Error("Expected Client to be nil if no topicArn is configured")
+		}
+	})
+
+	targets.SNS.Config.TopicARN = "arn:aws:sns:eu-central-1:000000000000:policy-reporter"
+	t.Run("SNS.AccessKey", func(t *testing.T) {
+		t.Parallel()
+		if len(factory.CreateClients(&targets).Clients()) != 0 {
+			t.Error("Expected Client to be nil if no accessKey is configured")
+		}
+	})
+
+	targets.SNS.Config.AccessKeyID = "access"
+	targets.SNS.Config.SecretAccessKey = "secret"
+	t.Run("SNS.Region", func(t *testing.T) {
+		t.Parallel()
+		if len(factory.CreateClients(&targets).Clients()) != 0 {
+			t.Error("Expected Client to be nil if no region is configured")
+		}
+	})
+}
+
+func Test_SQSValidation(t *testing.T) {
+	t.Parallel()
+	factory := factory.NewFactory(nil, nil)
+
+	targets := target.Targets{
+		SQS: &targetconfig.Config[v1alpha1.SQSOptions]{
+			Config: &v1alpha1.SQSOptions{
+				AWSConfig: v1alpha1.AWSConfig{Endpoint: "http://localhost:4566"},
+			},
+		},
+	}
+
+	t.Run("SQS.QueueURL", func(t *testing.T) {
+		t.Parallel()
+		if len(factory.CreateClients(&targets).Clients()) != 0 {
+			t.Error("Expected Client to be nil if no queueUrl is configured")
+		}
+	})
+
+	targets.SQS.Config.QueueURL = "http://localhost:4566/000000000000/policy-reporter"
+	t.Run("SQS.AccessKey", func(t *testing.T) {
+		t.Parallel()
+		if len(factory.CreateClients(&targets).Clients()) != 0 {
+			t.Error("Expected Client to be nil if no accessKey is configured")
+		}
+	})
+
+	targets.SQS.Config.AccessKeyID = "access"
+	targets.SQS.Config.SecretAccessKey = "secret"
+	t.Run("SQS.Region", func(t *testing.T) {
+		t.Parallel()
+		if len(factory.CreateClients(&targets).Clients()) != 0 {
+			t.Error("Expected Client to be nil if no region is configured")
+		}
+	})
+}
+
+func Test_DefectDojoValidation(t *testing.T) {
+	t.Parallel()
+	factory := factory.NewFactory(nil, nil)
+
+	targets := target.Targets{
+		DefectDojo: &targetconfig.Config[v1alpha1.DefectDojoOptions]{
+			Config: &v1alpha1.DefectDojoOptions{
+				HostOptions: v1alpha1.HostOptions{Host: "http://localhost:8080"},
+			},
+		},
+	}
+
+	t.Run("DefectDojo.APIKey", func(t *testing.T) {
+		t.Parallel()
+		if len(factory.CreateClients(&targets).Clients()) != 0 {
+			t.Error("Expected Client to be nil if no apiKey is configured")
+		}
+	})
+
+	invalid := target.Targets{
+		DefectDojo: &targetconfig.Config[v1alpha1.DefectDojoOptions]{
+			Config: &v1alpha1.DefectDojoOptions{
+				HostOptions:     v1alpha1.HostOptions{Host: "http://localhost:8080"},
+				APIKey:          "apiKey",
+				ProductTemplate: "{{ .source ",
+			},
+		},
+	}
+
+	t.Run("DefectDojo.ProductTemplate", func(t *testing.T) {
+		t.Parallel()
+		if len(factory.CreateClients(&invalid).Clients()) != 0 {
+			t.Error("Expected Client to be nil if the product template is invalid")
+		}
+	})
+}
+
+func Test_WebexValidation(t *testing.T) {
+	t.Parallel()
+	factory := factory.NewFactory(nil, nil)
+
+	targets := target.Targets{
+		Webex: &targetconfig.Config[v1alpha1.WebexOptions]{
+			Config: &v1alpha1.WebexOptions{
+				Token: "token",
+			},
+		},
+	}
+
+	t.Run("Webex.RoomID", func(t *testing.T) {
+		t.Parallel()
+		if len(factory.CreateClients(&targets).Clients()) != 0 {
+			t.Error("Expected Client to be nil if no roomId is configured")
+		}
+	})
+	t.Run("Webex.RoomID fallback", func(t *testing.T) {
+		t.Parallel()
+		targets := target.Targets{
+			Webex: &targetconfig.Config[v1alpha1.WebexOptions]{
+				Config: &v1alpha1.WebexOptions{
+					Token:  "token",
+					RoomID: "room",
+				},
+				Channels: []*targetconfig.Config[v1alpha1.WebexOptions]{{
+					Config: &v1alpha1.WebexOptions{},
+				}},
+			},
+		}
+
+		if len(factory.CreateClients(&targets).Clients()) != 2 {
+			t.Error("Expected channel to use the roomId of the parent")
+		}
+	})
+}
+
+func Test_MatrixValidation(t *testing.T) {
+	t.Parallel()
+	factory := factory.NewFactory(nil, nil)
+
+	targets := target.Targets{
+		Matrix: &targetconfig.Config[v1alpha1.MatrixOptions]{
+			Config: &v1alpha1.MatrixOptions{
+				HostOptions: v1alpha1.HostOptions{Host: "http://localhost:8008"},
+				RoomID:      "!room:localhost",
+			},
+		},
+	}
+
+	t.Run("Matrix.AccessToken", func(t *testing.T) {
+		t.Parallel()
+		if len(factory.CreateClients(&targets).Clients()) != 0 {
+			t.Error("Expected Client to be nil if no accessToken is configured")
+		}
+	})
+	t.Run("Matrix.RoomID fallback", func(t *testing.T) {
+		t.Parallel()
+		targets := target.Targets{
+			Matrix: &targetconfig.Config[v1alpha1.MatrixOptions]{
+				Config: &v1alpha1.MatrixOptions{
+					HostOptions: v1alpha1.HostOptions{Host: "http://localhost:8008"},
+					AccessToken: "token",
+					RoomID:      "!room:localhost",
+				},
+				Channels: []*targetconfig.Config[v1alpha1.MatrixOptions]{{
+					Config: &v1alpha1.MatrixOptions{},
+				}},
+			},
+		}
+
+		if len(factory.CreateClients(&targets).Clients()) != 2 {
+			t.Error("Expected channel to use the roomId of the parent")
+		}
+	})
+}
+
+func Test_ServiceNowValidation(t *testing.T) {
+	t.Parallel()
+	factory := factory.NewFactory(nil, nil)
+
+	targets := target.Targets{
+		ServiceNow: &targetconfig.Config[v1alpha1.ServiceNowOptions]{
+			Config: &v1alpha1.ServiceNowOptions{
+				HostOptions: v1alpha1.HostOptions{Host: "http://localhost:8080"},
+				Username:    "admin",
+			},
+		},
+	}
+
+	t.Run("ServiceNow.Credentials", func(t *testing.T) {
+		t.Parallel()
+		if len(factory.CreateClients(&targets).Clients()) != 0 {
+			t.Error("Expected Client to be nil if no credentials are configured")
+		}
+	})
+
+	invalid := target.Targets{
+		ServiceNow: &targetconfig.Config[v1alpha1.ServiceNowOptions]{
+			Config: &v1alpha1.ServiceNowOptions{
+				HostOptions: v1alpha1.HostOptions{Host: "http://localhost:8080"},
+				ClientID:    "client",
+				Fields:      map[string]string{"short_description": "{{ .result "},
+			},
+		},
+	}
+
+	t.Run("ServiceNow.Fields", func(t *testing.T) {
+		t.Parallel()
+		if len(factory.CreateClients(&invalid).Clients()) != 0 {
+			t.Error("Expected Client to be nil if a field template is invalid")
+		}
+	})
+}
+
+func Test_CELFilter(t *testing.T) {
+	t.Parallel()
+	factory := factory.NewFactory(nil, target.NewResultFilterFactory(nil, nil))
+
+	t.Run("apply expression", func(t *testing.T) {
+		t.Parallel()
+		clients := factory.CreateClients(&target.Targets{
+			Webhook: &targetconfig.Config[v1alpha1.WebhookOptions]{
+				Config: &v1alpha1.WebhookOptions{Webhook: "http://localhost:8080"},
+				Filter: filters.Filter{CEL: "result.status == 'fail' && resource.kind == 'Deployment'"},
+			},
+		}).Clients()
+
+		assert.Len(t, clients, 1)
+		assert.True(t, clients[0].Validate(fixtures.DefaultPolicyReport, fixtures.FailResult))
+		assert.False(t, clients[0].Validate(fixtures.DefaultPolicyReport, fixtures.PassResult))
+	})
+	t.Run("skip targets with invalid expressions", func(t *testing.T) {
+		t.Parallel()
+		clients := factory.CreateClients(&target.Targets{
+			Webhook: &targetconfig.Config[v1alpha1.WebhookOptions]{
+				Config: &v1alpha1.WebhookOptions{Webhook: "http://localhost:8080"},
+				Filter: filters.Filter{CEL: "result.status =="},
+				Channels: []*targetconfig.Config[v1alpha1.WebhookOptions]{
+					{Config: &v1alpha1.WebhookOptions{Webhook: "http://localhost:8081"}},
+					{Config: &v1alpha1.WebhookOptions{Webhook: "http://localhost:8082"}, Filter: filters.Filter{CEL: "result.unknown("}},
+				},
+			},
+		}).Clients()
+
+		assert.Len(t, clients, 1)
+	})
+	t.Run("reject invalid TargetConfig", func(t *testing.T) {
+		t.Parallel()
+		_, err := factory.CreateSingleClient(&v1alpha1.TargetConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: "webhook"},
+			Spec: v1alpha1.TargetConfigSpec{
+				Config:  v1alpha1.Config{Filter: filters.Filter{CEL: "result.severity >= 'high"}},
+				Webhook: &v1alpha1.WebhookOptions{Webhook: "http://localhost:8080"},
+			},
+		})
+
+		assert.ErrorContains(t, err, "invalid filter.cel of TargetConfig webhook")
+	})
+}
+
+func Test_KubernetesEventsValidation(t *testing.T) {
+	t.Parallel()
+	newTargets := func() *target.Targets {
+		return &target.Targets{
+			KubernetesEvents: &targetconfig.Config[v1alpha1.KubernetesEventsOptions]{
+				Config: &v1alpha1.KubernetesEventsOptions{Component: "policy-reporter-events"},
+			},
+		}
+	}
+
+	t.Run("KubernetesEvents.Client", func(t *testing.T) {
+		t.Parallel()
+		if len(factory.NewFactory(nil, nil).CreateClients(newTargets()).Clients()) != 0 {
+			t.Error("Expected Client to be nil if no events client is configured")
+		}
+	})
+
+	t.Run("KubernetesEvents.Configured", func(t *testing.T) {
+		t.Parallel()
+		f := factory.NewFactory(nil, nil, factory.WithEventsClient(fake.NewClientset().CoreV1(), ""))
+
+		clients := f.CreateClients(newTargets()).Clients()
+		if len(clients) != 1 {
+			t.Fatalf("Expected 1 Client, got %d clients", len(clients))
+		}
+
+		assert.Equal(t, "KubernetesEvents", clients[0].Name())
+	})
+}
+
 func Test_GCSValidation(t *testing.T) {
 	t.Parallel()
 	factory := factory.NewFactory(nil, nil)
@@ -580,13 +994,88 @@ func Test_GetValuesFromSecret(t *testing.T) {
 		Splunk: &targetconfig.Config[v1alpha1.SplunkOptions]{
 			SecretRef: secretName,
 		},
+		DefectDojo: &targetconfig.Config[v1alpha1.DefectDojoOptions]{
+			SecretRef: secretName,
+		},
+		Mattermost: &targetconfig.Config[v1alpha1.MattermostOptions]{
+			SecretRef: secretName,
+		},
+		Matrix: &targetconfig.Config[v1alpha1.MatrixOptions]{
+			SecretRef: secretName,
+			Config: &v1alpha1.MatrixOptions{
+				RoomID: "!room:localhost",
+			},
+		},
+		ServiceNow: &targetconfig.Config[v1alpha1.ServiceNowOptions]{
+			SecretRef: secretName,
+		},
 	}
 
 	clients := factory.CreateClients(&targets)
-	if len(clients.Clients()) != 13 {
-		t.Fatalf("expected 12 clients created, got %d", len(clients.Clients()))
+	if len(clients.Clients()) != 17 {
+		t.Fatalf("expected 17 clients created, got %d", len(clients.Clients()))
 	}
 
+	t.Run("Get ServiceNow values from Secret", func(t *testing.T) {
+		t.Parallel()
+		client := reflect.ValueOf(clients.Client("ServiceNow")).Elem()
+
+		host := client.FieldByName("host").String()
+		if host != "http://localhost:9200" {
+			t.Errorf("Expected host from secret, got %s", host)
+		}
+
+		username := client.FieldByName("username").String()
+		if username != "username" {
+			t.Errorf("Expected username from secret, got %s", username)
+		}
+
+		password := client.FieldByName("password").String()
+		if password != "password" {
+			t.Errorf("Expected password from secret, got %s", password)
+		}
+	})
+
+	t.Run("Get Mattermost values from Secret", func(t *testing.T) {
+		t.Parallel()
+		client := reflect.ValueOf(clients.Client("Mattermost")).Elem()
+
+		webhook := client.FieldByName("webhook").String()
+		if webhook != "http://localhost:9200/webhook" {
+			t.Errorf("Expected webhook from secret, got %s", webhook)
+		}
+	})
+
+	t.Run("Get Matrix values from Secret", func(t *testing.T) {
+		t.Parallel()
+		client := reflect.ValueOf(clients.Client("Matrix")).Elem()
+
+		host := client.FieldByName("host").String()
+		if host != "http://localhost:9200" {
+			t.Errorf("Expected host from secret, got %s", host)
+		}
+
+		accessToken := client.FieldByName("accessToken").String()
+		if accessToken != "token" {
+			t.Errorf("Expected accessToken from secret, got %s", accessToken)
+		}
+	})
+
+	t.Run("Get DefectDojo values from Secret", func(t *testing.T) {
+		t.Parallel()
+		client := reflect.ValueOf(clients.Client("DefectDojo")).Elem()
+
+		host := client.FieldByName("host").String()
+		if host != "http://localhost:9200" {
+			t.Errorf("Expected host from secret, got %s", host)
+		}
+
+		apiKey := client.FieldByName("apiKey").String()
+		if apiKey != "apiKey" {
+			t.Errorf("Expected apiKey from secret, got %s", apiKey)
+		}
+	})
+
 	t.Run("Get Loki values from Secret", func(t *testing.T) {
 		t.Parallel()
 		fv := reflect.ValueOf(clients.Client("Loki")).Elem().FieldByName("host")
@@ -1156,3 +1645,56 @@ func Test_ReportFilter_Wildcard(t *testing.T) {
 		})
 	}
 }
+
+type resourceClient struct {
+	annotations map[string]string
+}
+
+func (c *resourceClient) Metadata(ref *corev1.ObjectReference) (*metav1.PartialObjectMetadata, error) {
+	return &metav1.PartialObjectMetadata{ObjectMeta: metav1.ObjectMeta{Name: ref.Name, Annotations: c.annotations}}, nil
+}
+
+func Test_ResourceFilter(t *testing.T) {
+	t.Parallel()
+	t.Run("filter kinds", func(t *testing.T) {
+		t.Parallel()
+		factory := factory.NewFactory(nil, target.NewResultFilterFactory(nil, nil))
+
+		clients := factory.CreateClients(&target.Targets{
+			Webhook: &targetconfig.Config[v1alpha1.WebhookOptions]{
+				Config: &v1alpha1.WebhookOptions{Webhook: "http://localhost:8080"},
+				Filter: filters.Filter{Kinds: filters.ValueFilter{Exclude: []string{"Deployment"}}},
+			},
+		}).Clients()
+
+		assert.False(t, clients[0].Validate(fixtures.DefaultPolicyReport, fixtures.FailResult))
+	})
+	t.Run("exclude annotated resources", func(t *testing.T) {
+		t.Parallel()
+		factory := factory.NewFactory(nil, target.NewResultFilterFactory(nil, &resourceClient{
+			annotations: map[string]string{"policy-reporter.io/ignore": "true"},
+		}))
+
+		clients := factory.CreateClients(&target.Targets{
+			Webhook: &targetconfig.Config[v1alpha1.WebhookOptions]{
+				Config: &v1alpha1.WebhookOptions{Webhook: "http://localhost:8080"},
+				Filter: filters.Filter{ResourceAnnotations: filters.ValueFilter{Exclude: []string{"policy-reporter.io/ignore:true"}}},
+			},
+		}).Clients()
+
+		assert.False(t, clients[0].Validate(fixtures.DefaultPolicyReport, fixtures.FailResult))
+	})
+	t.Run("include labeled resources", func(t *testing.T) {
+		t.Parallel()
+		factory := factory.NewFactory(nil, target.NewResultFilterFactory(nil, &resourceClient{}))
+
+		clients := factory.CreateClients(&target.Targets{
+			Webhook: &targetconfig.Config[v1alpha1.WebhookOptions]{
+				Config: &v1alpha1.WebhookOptions{Webhook: "http://localhost:8080"},
+				Filter: filters.Filter{ResourceLabels: filters.ValueFilter{Include: []string{"app:nginx"}}},
+			},
+		}).Clients()
+
+		assert.False(t, clients[0].Validate(fixtures.DefaultPolicyReport, fixtures.FailResult))
+	})
+}
@@ -0,0 +1,25 @@
+package formatting
+
+import (
+	"github.com/openreports/reports-api/apis/openreports.io/v1alpha1"
+
+	"github.com/kyverno/policy-reporter/pkg/openreports"
+)
+
+// SeverityColors used by chat targets to highlight results by severity
+var SeverityColors = map[v1alpha1.ResultSeverity]string{
+	openreports.SeverityInfo:     "#68c2ff",
+	openreports.SeverityLow:      "#36a64f",
+	openreports.SeverityMedium:   "#f2c744",
+	openreports.SeverityHigh:     "#b80707",
+	openreports.SeverityCritical: "#e20b0b",
+}
+
+// SeverityColor of the given severity, falls back to the info color
+func SeverityColor(severity v1alpha1.ResultSeverity) string {
+	if color, ok := SeverityColors[severity]; ok {
+		return color
+	}
+
+	return SeverityColors[openreports.SeverityInfo]
+}
@@ -0,0 +1,17 @@
+package formatting_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kyverno/policy-reporter/pkg/openreports"
+	"github.com/kyverno/policy-reporter/pkg/target/formatting"
+)
+
+func Test_SeverityColor(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "#e20b0b", formatting.SeverityColor(openreports.SeverityCritical))
+	assert.Equal(t, "#36a64f", formatting.SeverityColor(openreports.SeverityLow))
+	assert.Equal(t, "#68c2ff", formatting.SeverityColor(""))
+}
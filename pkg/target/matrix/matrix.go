@@ -0,0 +1,184 @@
+package matrix
+
+import (
+	"fmt"
+	"html"
+	"maps"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/kyverno/policy-reporter/pkg/crd/api/targetconfig/v1alpha1"
+	"github.com/kyverno/policy-reporter/pkg/helper"
+	"github.com/kyverno/policy-reporter/pkg/openreports"
+	"github.com/kyverno/policy-reporter/pkg/target"
+	"github.com/kyverno/policy-reporter/pkg/target/formatting"
+	"github.com/kyverno/policy-reporter/pkg/target/http"
+)
+
+const htmlFormat = "org.matrix.custom.html"
+
+// Options to configure the Matrix target
+type Options struct {
+	target.ClientOptions
+	Host         string
+	AccessToken  string
+	RoomID       string
+	Headers      map[string]string
+	CustomFields map[string]string
+	HTTPClient   http.Client
+	Keepalive    *v1alpha1.KeepaliveConfig
+}
+
+// Payload of a m.room.message event
+type Payload struct {
+	MsgType       string `json:"msgtype"`
+	Body          string `json:"body"`
+	Format        string `json:"format,omitempty"`
+	FormattedBody string `json:"formatted_body,omitempty"`
+}
+
+type field struct {
+	title string
+	value string
+}
+
+type client struct {
+	target.BaseClient
+	host         string
+	accessToken  string
+	roomID       string
+	headers      map[string]string
+	customFields map[string]string
+	client       http.Client
+	keepalive    *v1alpha1.KeepaliveConfig
+}
+
+func (m *client) newPayload(result openreports.ResultAdapter) Payload {
+	fields := []field{{"Policy", result.Policy}}
+
+	if result.Rule != "" {
+		fields = append(fields, field{"Rule", result.Rule})
+	}
+	fields = append(fields, field{"Status", string(result.Result)})
+	if result.Severity != "" {
+		fields = append(fields, field{"Severity", string(result.Severity)})
+	}
+	if result.Category != "" {
+		fields = append(fields, field{"Category", result.Category})
+	}
+	if result.Source != "" {
+		fields = append(fields, field{"Source", result.Source})
+	}
+	if result.HasResource() {
+		fields = append(fields, field{"Resource", result.ResourceString()})
+	}
+
+	for _, property := range slices.Sorted(maps.Keys(result.Properties)) {
+		fields = append(fields, field{helper.Title(property), result.Properties[property]})
+	}
+	for _, property := range slices.Sorted(maps.Keys(m.customFields)) {
+		fields = append(fields, field{helper.Title(property), m.customFields[property]})
+	}
+
+	title := fmt.Sprintf("[Policy Reporter] [%s] %s", result.Severity, helper.Defaults(result.Policy, result.Rule))
+
+	return newMessage(formatting.SeverityColor(result.Severity), title, result.Description, fields)
+}
+
+func (m *client) Send(report openreports.ReportInterface, result openreports.ResultAdapter) {
+	m.send(m.newPayload(result), m.Name())
+}
+
+func (m *client) SendHeartbeat() {
+	fields := make([]field, 0)
+	if m.keepalive != nil {
+		for _, k := range slices.Sorted(maps.Keys(m.keepalive.Params)) {
+			fields = append(fields, field{helper.Title(k), m.keepalive.Params[k]})
+		}
+	}
+
+	m.send(newMessage(
+		formatting.SeverityColor(""),
+		"Policy Reporter Heartbeat",
+		time.Now().Format(time.RFC3339),
+		fields,
+	), m.Name()+"-heartbeat")
+}
+
+// send uses a unique transaction ID per event, the homeserver deduplicates retries with the same ID
+func (m *client) send(payload Payload, name string) {
+	endpoint := fmt.Sprintf(
+		"%s/_matrix/client/v3/rooms/%s/send/m.room.message/%s",
+		m.host,
+		url.PathEscape(m.roomID),
+		uuid.NewString(),
+	)
+
+	req, err := http.CreateJSONRequest("PUT", endpoint, payload)
+	if err != nil {
+		zap.L().Error(name+": PUSH FAILED", zap.Error(err))
+		return
+	}
+
+	for header, value := range m.headers {
+		req.Header.Set(header, value)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+m.accessToken)
+
+	resp, err := m.client.Do(req)
+	http.ProcessHTTPResponse(name, resp, err)
+}
+
+func (m *client) Type() target.ClientType {
+	return target.SingleSend
+}
+
+func newMessage(color, title, text string, fields []field) Payload {
+	body := strings.Builder{}
+	formatted := strings.Builder{}
+
+	body.WriteString(title)
+	fmt.Fprintf(&formatted, `<h4><font data-mx-color="%s">%s</font></h4>`, color, html.EscapeString(title))
+
+	if text != "" {
+		fmt.Fprintf(&body, "\n\n%s", text)
+		fmt.Fprintf(&formatted, "<p>%s</p>", html.EscapeString(text))
+	}
+
+	if len(fields) > 0 {
+		body.WriteString("\n")
+		formatted.WriteString("<ul>")
+		for _, f := range fields {
+			fmt.Fprintf(&body, "\n%s: %s", f.title, f.value)
+			fmt.Fprintf(&formatted, "<li><b>%s</b>: %s</li>", html.EscapeString(f.title), html.EscapeString(f.value))
+		}
+		formatted.WriteString("</ul>")
+	}
+
+	return Payload{
+		MsgType:       "m.text",
+		Body:          body.String(),
+		Format:        htmlFormat,
+		FormattedBody: formatted.String(),
+	}
+}
+
+// NewClient creates a new matrix.client to send Results into a Matrix room
+func NewClient(options Options) target.Client {
+	return &client{
+		BaseClient:   target.NewBaseClient(options.ClientOptions),
+		host:         strings.TrimSuffix(options.Host, "/"),
+		accessToken:  options.AccessToken,
+		roomID:       options.RoomID,
+		headers:      options.Headers,
+		customFields: options.CustomFields,
+		client:       options.HTTPClient,
+		keepalive:    options.Keepalive,
+	}
+}
@@ -0,0 +1,92 @@
+package matrix_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kyverno/policy-reporter/pkg/crd/api/targetconfig/v1alpha1"
+	"github.com/kyverno/policy-reporter/pkg/fixtures"
+	"github.com/kyverno/policy-reporter/pkg/target"
+	"github.com/kyverno/policy-reporter/pkg/target/formatting"
+	"github.com/kyverno/policy-reporter/pkg/target/matrix"
+)
+
+type testClient struct {
+	callback   func(req *http.Request)
+	statusCode int
+}
+
+func (c testClient) Do(req *http.Request) (*http.Response, error) {
+	c.callback(req)
+
+	return &http.Response{
+		StatusCode: c.statusCode,
+	}, nil
+}
+
+func parsePayload(t *testing.T, req *http.Request) matrix.Payload {
+	payload := matrix.Payload{}
+	if err := json.NewDecoder(req.Body).Decode(&payload); err != nil {
+		t.Fatal(err)
+	}
+
+	return payload
+}
+
+func Test_MatrixTarget(t *testing.T) {
+	t.Parallel()
+	t.Run("Send", func(t *testing.T) {
+		t.Parallel()
+		callback := func(req *http.Request) {
+			assert.Equal(t, http.MethodPut, req.Method)
+			assert.True(t, strings.HasPrefix(req.URL.EscapedPath(), "/_matrix/client/v3/rooms/%21room:example.com/send/m.room.message/"))
+			assert.Equal(t, "Bearer token", req.Header.Get("Authorization"))
+
+			payload := parsePayload(t, req)
+			assert.Equal(t, "m.text", payload.MsgType)
+			assert.Equal(t, "org.matrix.custom.html", payload.Format)
+			assert.Contains(t, payload.Body, "require-requests-and-limits-required")
+			assert.Contains(t, payload.FormattedBody, `data-mx-color="`+formatting.SeverityColors["high"]+`"`)
+			assert.Contains(t, payload.FormattedBody, "<li><b>Cluster</b>: name</li>")
+		}
+
+		client := matrix.NewClient(matrix.Options{
+			ClientOptions: target.ClientOptions{
+				Name: "Matrix",
+			},
+			Host:         "https://matrix.example.com/",
+			AccessToken:  "token",
+			RoomID:       "!room:example.com",
+			CustomFields: map[string]string{"cluster": "name"},
+			HTTPClient:   testClient{callback, 200},
+		})
+		client.Send(fixtures.DefaultPolicyReport, fixtures.CompleteTargetSendResult)
+	})
+	t.Run("SendHeartbeat", func(t *testing.T) {
+		t.Parallel()
+		callback := func(req *http.Request) {
+			payload := parsePayload(t, req)
+			assert.True(t, strings.HasPrefix(payload.Body, "Policy Reporter Heartbeat"))
+			assert.Contains(t, payload.Body, "Cluster: prod")
+		}
+
+		client := matrix.NewClient(matrix.Options{
+			Host:        "https://matrix.example.com",
+			AccessToken: "token",
+			RoomID:      "!room:example.com",
+			Keepalive:   &v1alpha1.KeepaliveConfig{Interval: "5m", Params: map[string]string{"cluster": "prod"}},
+			HTTPClient:  testClient{callback, 200},
+		})
+		client.SendHeartbeat()
+	})
+	t.Run("Type", func(t *testing.T) {
+		t.Parallel()
+		client := matrix.NewClient(matrix.Options{})
+
+		assert.Equal(t, target.SingleSend, client.Type())
+	})
+}
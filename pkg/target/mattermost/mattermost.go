@@ -0,0 +1,174 @@
+package mattermost
+
+import (
+	"maps"
+	"slices"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/kyverno/policy-reporter/pkg/crd/api/targetconfig/v1alpha1"
+	"github.com/kyverno/policy-reporter/pkg/helper"
+	"github.com/kyverno/policy-reporter/pkg/openreports"
+	"github.com/kyverno/policy-reporter/pkg/target"
+	"github.com/kyverno/policy-reporter/pkg/target/formatting"
+	"github.com/kyverno/policy-reporter/pkg/target/http"
+)
+
+// Options to configure the Mattermost target
+type Options struct {
+	target.ClientOptions
+	Webhook      string
+	Channel      string
+	Username     string
+	IconURL      string
+	Headers      map[string]string
+	CustomFields map[string]string
+	HTTPClient   http.Client
+	Keepalive    *v1alpha1.KeepaliveConfig
+}
+
+// Payload of a Mattermost incoming webhook
+type Payload struct {
+	Channel     string       `json:"channel,omitempty"`
+	Username    string       `json:"username,omitempty"`
+	IconURL     string       `json:"icon_url,omitempty"`
+	Text        string       `json:"text,omitempty"`
+	Attachments []Attachment `json:"attachments,omitempty"`
+}
+
+type Attachment struct {
+	Fallback string  `json:"fallback"`
+	Color    string  `json:"color"`
+	Title    string  `json:"title"`
+	Text     string  `json:"text"`
+	Fields   []Field `json:"fields"`
+}
+
+type Field struct {
+	Short bool   `json:"short"`
+	Title string `json:"title"`
+	Value string `json:"value"`
+}
+
+type client struct {
+	target.BaseClient
+	webhook      string
+	channel      string
+	username     string
+	iconURL      string
+	headers      map[string]string
+	customFields map[string]string
+	client       http.Client
+	keepalive    *v1alpha1.KeepaliveConfig
+}
+
+func (m *client) newPayload(result openreports.ResultAdapter) Payload {
+	fields := []Field{{true, "Policy", result.Policy}}
+
+	if result.Rule != "" {
+		fields = append(fields, Field{true, "Rule", result.Rule})
+	}
+	fields = append(fields, Field{true, "Status", string(result.Result)})
+	if result.Severity != "" {
+		fields = append(fields, Field{true, "Severity", string(result.Severity)})
+	}
+	if result.Category != "" {
+		fields = append(fields, Field{true, "Category", result.Category})
+	}
+	if result.Source != "" {
+		fields = append(fields, Field{true, "Source", result.Source})
+	}
+
+	if result.HasResource() {
+		res := result.GetResource()
+
+		fields = append(fields, Field{true, "Kind", res.Kind}, Field{true, "Name", res.Name})
+		if res.Namespace != "" {
+			fields = append(fields, Field{true, "Namespace", res.Namespace})
+		}
+		if res.APIVersion != "" {
+			fields = append(fields, Field{true, "API Version", res.APIVersion})
+		}
+	}
+
+	for _, property := range slices.Sorted(maps.Keys(result.Properties)) {
+		fields = append(fields, Field{true, helper.Title(property), result.Properties[property]})
+	}
+	for _, property := range slices.Sorted(maps.Keys(m.customFields)) {
+		fields = append(fields, Field{true, helper.Title(property), m.customFields[property]})
+	}
+
+	return Payload{
+		Channel:  m.channel,
+		Username: m.username,
+		IconURL:  m.iconURL,
+		Attachments: []Attachment{{
+			Fallback: "New Policy Report Result: " + result.Policy,
+			Color:    formatting.SeverityColor(result.Severity),
+			Title:    "New Policy Report Result",
+			Text:     result.Description,
+			Fields:   fields,
+		}},
+	}
+}
+
+func (m *client) Send(report openreports.ReportInterface, result openreports.ResultAdapter) {
+	m.post(m.newPayload(result), m.Name())
+}
+
+func (m *client) SendHeartbeat() {
+	fields := make([]Field, 0)
+	if m.keepalive != nil {
+		for k, v := range m.keepalive.Params {
+			fields = append(fields, Field{true, helper.Title(k), v})
+		}
+	}
+
+	m.post(Payload{
+		Channel:  m.channel,
+		Username: m.username,
+		IconURL:  m.iconURL,
+		Attachments: []Attachment{{
+			Fallback: "Policy Reporter Heartbeat",
+			Color:    formatting.SeverityColor(""),
+			Title:    "Policy Reporter Heartbeat",
+			Text:     time.Now().Format(time.RFC3339),
+			Fields:   fields,
+		}},
+	}, m.Name()+"-heartbeat")
+}
+
+func (m *client) post(payload Payload, name string) {
+	req, err := http.CreateJSONRequest("POST", m.webhook, payload)
+	if err != nil {
+		zap.L().Error(name+": PUSH FAILED", zap.Error(err))
+		return
+	}
+
+	for header, value := range m.headers {
+		req.Header.Set(header, value)
+	}
+
+	resp, err := m.client.Do(req)
+	http.ProcessHTTPResponse(name, resp, err)
+}
+
+func (m *client) Type() target.ClientType {
+	return target.SingleSend
+}
+
+// NewClient creates a new mattermost.client to send Results to a Mattermost incoming webhook
+func NewClient(options Options) target.Client {
+	return &client{
+		BaseClient:   target.NewBaseClient(options.ClientOptions),
+		webhook:      options.Webhook,
+		channel:      options.Channel,
+		username:     options.Username,
+		iconURL:      options.IconURL,
+		headers:      options.Headers,
+		customFields: options.CustomFields,
+		client:       options.HTTPClient,
+		keepalive:    options.Keepalive,
+	}
+}
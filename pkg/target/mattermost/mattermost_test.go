@@ -0,0 +1,91 @@
+package mattermost_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kyverno/policy-reporter/pkg/crd/api/targetconfig/v1alpha1"
+	"github.com/kyverno/policy-reporter/pkg/fixtures"
+	"github.com/kyverno/policy-reporter/pkg/target"
+	"github.com/kyverno/policy-reporter/pkg/target/formatting"
+	"github.com/kyverno/policy-reporter/pkg/target/mattermost"
+)
+
+type testClient struct {
+	callback   func(req *http.Request)
+	statusCode int
+}
+
+func (c testClient) Do(req *http.Request) (*http.Response, error) {
+	c.callback(req)
+
+	return &http.Response{
+		StatusCode: c.statusCode,
+	}, nil
+}
+
+func parsePayload(t *testing.T, req *http.Request) mattermost.Payload {
+	payload := mattermost.Payload{}
+	if err := json.NewDecoder(req.Body).Decode(&payload); err != nil {
+		t.Fatal(err)
+	}
+
+	return payload
+}
+
+func Test_MattermostTarget(t *testing.T) {
+	t.Parallel()
+	t.Run("Send", func(t *testing.T) {
+		t.Parallel()
+		callback := func(req *http.Request) {
+			assert.Equal(t, "https://mattermost.example.com/hooks/xxx", req.URL.String())
+			assert.Equal(t, "application/json; charset=utf-8", req.Header.Get("Content-Type"))
+			assert.Equal(t, "1234", req.Header.Get("X-Code"))
+
+			payload := parsePayload(t, req)
+			assert.Equal(t, "policy-reporter", payload.Channel)
+			assert.Equal(t, "Policy Reporter", payload.Username)
+			assert.Len(t, payload.Attachments, 1)
+			assert.Equal(t, formatting.SeverityColors["high"], payload.Attachments[0].Color)
+			fields := payload.Attachments[0].Fields
+			assert.Equal(t, []mattermost.Field{{Short: true, Title: "Account", Value: "prod"}, {Short: true, Title: "Cluster", Value: "name"}}, fields[len(fields)-2:], "custom fields should be sorted")
+		}
+
+		client := mattermost.NewClient(mattermost.Options{
+			ClientOptions: target.ClientOptions{
+				Name: "Mattermost",
+			},
+			Webhook:      "https://mattermost.example.com/hooks/xxx",
+			Channel:      "policy-reporter",
+			Username:     "Policy Reporter",
+			Headers:      map[string]string{"X-Code": "1234"},
+			CustomFields: map[string]string{"cluster": "name", "account": "prod"},
+			HTTPClient:   testClient{callback, 200},
+		})
+		client.Send(fixtures.DefaultPolicyReport, fixtures.CompleteTargetSendResult)
+	})
+	t.Run("SendHeartbeat", func(t *testing.T) {
+		t.Parallel()
+		callback := func(req *http.Request) {
+			payload := parsePayload(t, req)
+			assert.Equal(t, "Policy Reporter Heartbeat", payload.Attachments[0].Title)
+			assert.Equal(t, []mattermost.Field{{Short: true, Title: "Cluster", Value: "prod"}}, payload.Attachments[0].Fields)
+		}
+
+		client := mattermost.NewClient(mattermost.Options{
+			Webhook:    "https://mattermost.example.com/hooks/xxx",
+			Keepalive:  &v1alpha1.KeepaliveConfig{Interval: "5m", Params: map[string]string{"cluster": "prod"}},
+			HTTPClient: testClient{callback, 200},
+		})
+		client.SendHeartbeat()
+	})
+	t.Run("Type", func(t *testing.T) {
+		t.Parallel()
+		client := mattermost.NewClient(mattermost.Options{})
+
+		assert.Equal(t, target.SingleSend, client.Type())
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
@@ -15,9 +16,14 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/aws/aws-sdk-go-v2/service/securityhub"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	snstypes "github.com/aws/aws-sdk-go-v2/service/sns/types"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	sqstypes "github.com/aws/aws-sdk-go-v2/service/sqs/types"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
 	"go.uber.org/zap"
 
+	"github.com/kyverno/policy-reporter/pkg/openreports"
 	"github.com/kyverno/policy-reporter/pkg/target/http"
 )
 
@@ -26,6 +32,51 @@ type Client interface {
 	Upload(body *bytes.Buffer, key string) error
 }
 
+// Message to publish to an AWS messaging service
+type Message struct {
+	Body *bytes.Buffer
+	// Attributes are published as String message attributes, used by SNS subscription filter policies
+	Attributes map[string]string
+	// GroupID is used as MessageGroupId for FIFO topics and queues
+	GroupID string
+	// DeduplicationID is used as MessageDeduplicationId for FIFO topics and queues
+	DeduplicationID string
+}
+
+// NewMessage creates a Message for the given result.
+// FIFO messages are grouped by the resource namespace, cluster scoped results share the "cluster" group.
+func NewMessage(body *bytes.Buffer, result openreports.ResultAdapter) Message {
+	attributes := map[string]string{
+		"severity": string(result.Severity),
+		"policy":   result.Policy,
+		"rule":     result.Rule,
+		"status":   string(result.Result),
+		"source":   result.Source,
+	}
+
+	groupID := "cluster"
+	if res := result.GetResource(); res != nil {
+		attributes["namespace"] = res.Namespace
+		attributes["kind"] = res.Kind
+
+		if res.Namespace != "" {
+			groupID = res.Namespace
+		}
+	}
+
+	return Message{
+		Body:            body,
+		Attributes:      attributes,
+		GroupID:         groupID,
+		DeduplicationID: fmt.Sprintf("%s-%d", result.GetID(), result.Timestamp.Seconds),
+	}
+}
+
+type MessageClient interface {
+	// Publish the given Message to the configured AWS messaging service
+	Publish(message Message) error
+}
+
 type s3Client struct {
 	bucket               string
 	client               *s3.Client
@@ -143,6 +194,134 @@ func NewHubClient(accessKeyID, secretAccessKey, region, endpoint string) *securi
 	})
 }
 
+// NewAssumeRoleHubClient creates a new SecurityHub client which assumes the given role, used to send findings into other accounts
+func NewAssumeRoleHubClient(accessKeyID, secretAccessKey, region, endpoint, roleARN, externalID string) *securityhub.Client {
+	config, err := createConfig(accessKeyID, secretAccessKey, region)
+	if err != nil {
+		zap.L().Error("error while creating config", zap.Error(err))
+		return nil
+	}
+
+	if roleARN != "" {
+		zap.L().Debug("configure AWS credentals provider", zap.String("provider", "AssumeRoleProvider"), zap.String("roleArn", roleARN))
+		config.Credentials = aws.NewCredentialsCache(stscreds.NewAssumeRoleProvider(sts.NewFromConfig(config), roleARN, func(o *stscreds.AssumeRoleOptions) {
+			if externalID != "" {
+				o.ExternalID = aws.String(externalID)
+			}
+		}))
+	}
+
+	return securityhub.NewFromConfig(config, func(o *securityhub.Options) {
+		if endpoint != "" {
+			o.BaseEndpoint = &endpoint
+		}
+	})
+}
+
+type snsClient struct {
+	topicARN string
+	fifo     bool
+	client   *sns.Client
+}
+
+func (s *snsClient) Publish(message Message) error {
+	input := &sns.PublishInput{
+		TopicArn:          aws.String(s.topicARN),
+		Message:           aws.String(message.Body.String()),
+		MessageAttributes: make(map[string]snstypes.MessageAttributeValue, len(message.Attributes)),
+	}
+
+	for key, value := range message.Attributes {
+		if value == "" {
+			continue
+		}
+
+		input.MessageAttributes[key] = snstypes.MessageAttributeValue{
+			DataType:    aws.String("String"),
+			StringValue: aws.String(value),
+		}
+	}
+
+	if s.fifo {
+		input.MessageGroupId = aws.String(message.GroupID)
+		input.MessageDeduplicationId = aws.String(message.DeduplicationID)
+	}
+
+	_, err := s.client.Publish(context.TODO(), input)
+	return err
+}
+
+// NewSNSClient creates a new SNS.client to publish Results to a SNS topic
+func NewSNSClient(accessKeyID, secretAccessKey, region, endpoint, topicARN string) MessageClient {
+	config, err := createConfig(accessKeyID, secretAccessKey, region)
+	if err != nil {
+		zap.L().Error("error while creating config", zap.Error(err))
+		return nil
+	}
+
+	return &snsClient{
+		topicARN: topicARN,
+		fifo:     strings.HasSuffix(topicARN, ".fifo"),
+		client: sns.NewFromConfig(config, func(o *sns.Options) {
+			if endpoint != "" {
+				o.BaseEndpoint = &endpoint
+			}
+		}),
+	}
+}
+
+type sqsClient struct {
+	queueURL string
+	fifo     bool
+	client   *sqs.Client
+}
+
+func (s *sqsClient) Publish(message Message) error {
+	input := &sqs.SendMessageInput{
+		QueueUrl:          aws.String(s.queueURL),
+		MessageBody:       aws.String(message.Body.String()),
+		MessageAttributes: make(map[string]sqstypes.MessageAttributeValue, len(message.Attributes)),
+	}
+
+	for key, value := range message.Attributes {
+		if value == "" {
+			continue
+		}
+
+		input.MessageAttributes[key] = sqstypes.MessageAttributeValue{
+			DataType:    aws.String("String"),
+			StringValue: aws.String(value),
+		}
+	}
+
+	if s.fifo {
+		input.MessageGroupId = aws.String(message.GroupID)
+		input.MessageDeduplicationId = aws.String(message.DeduplicationID)
+	}
+
+	_, err := s.client.SendMessage(context.TODO(), input)
+	return err
+}
+
+// NewSQSClient creates a new SQS.client to send Results to a SQS queue
+func NewSQSClient(accessKeyID, secretAccessKey, region, endpoint, queueURL string) MessageClient {
+	config, err := createConfig(accessKeyID, secretAccessKey, region)
+	if err != nil {
+		zap.L().Error("error while creating config", zap.Error(err))
+		return nil
+	}
+
+	return &sqsClient{
+		queueURL: queueURL,
+		fifo:     strings.HasSuffix(queueURL, ".fifo"),
+		client: sqs.NewFromConfig(config, func(o *sqs.Options) {
+			if endpoint != "" {
+				o.BaseEndpoint = &endpoint
+			}
+		}),
+	}
+}
+
 func createConfig(accessKeyID, secretAccessKey, region string) (aws.Config, error) {
 	roleARN := os.Getenv("AWS_ROLE_ARN")
 	webIdentity := os.Getenv("AWS_WEB_IDENTITY_TOKEN_FILE")
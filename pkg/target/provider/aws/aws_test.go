@@ -1,10 +1,12 @@
 package aws_test
 
 import (
+	"bytes"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/kyverno/policy-reporter/pkg/fixtures"
 	"github.com/kyverno/policy-reporter/pkg/helper"
 	"github.com/kyverno/policy-reporter/pkg/target/provider/aws"
 )
@@ -29,3 +31,31 @@ func TestSecurityHubClient(t *testing.T) {
 
 	assert.NotNil(t, client)
 }
+
+func TestSNSClient(t *testing.T) {
+	t.Parallel()
+	client := aws.NewSNSClient("access", "secret", "eu-central-1", "http://sns.aws.com", "arn:aws:sns:eu-central-1:123456789012:policy-reporter")
+
+	assert.NotNil(t, client)
+}
+
+func TestSQSClient(t *testing.T) {
+	t.Parallel()
+	client := aws.NewSQSClient("access", "secret", "eu-central-1", "http://sqs.aws.com", "https://sqs.eu-central-1.amazonaws.com/123456789012/policy-reporter.fifo")
+
+	assert.NotNil(t, client)
+}
+
+func TestNewMessage(t *testing.T) {
+	t.Parallel()
+	message := aws.NewMessage(new(bytes.Buffer), fixtures.CompleteTargetSendResult)
+
+	assert.Equal(t, "default", message.GroupID)
+	assert.Equal(t, "high", message.Attributes["severity"])
+	assert.Equal(t, "require-requests-and-limits-required", message.Attributes["policy"])
+	assert.Equal(t, "default", message.Attributes["namespace"])
+
+	message = aws.NewMessage(new(bytes.Buffer), fixtures.MinimalTargetSendResult)
+
+	assert.Equal(t, "cluster", message.GroupID)
+}
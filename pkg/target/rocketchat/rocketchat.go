@@ -0,0 +1,173 @@
+package rocketchat
+
+import (
+	"maps"
+	"slices"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/kyverno/policy-reporter/pkg/crd/api/targetconfig/v1alpha1"
+	"github.com/kyverno/policy-reporter/pkg/helper"
+	"github.com/kyverno/policy-reporter/pkg/openreports"
+	"github.com/kyverno/policy-reporter/pkg/target"
+	"github.com/kyverno/policy-reporter/pkg/target/formatting"
+	"github.com/kyverno/policy-reporter/pkg/target/http"
+)
+
+// Options to configure the Rocket.Chat target
+type Options struct {
+	target.ClientOptions
+	Webhook      string
+	Channel      string
+	Alias        string
+	Avatar       string
+	Headers      map[string]string
+	CustomFields map[string]string
+	HTTPClient   http.Client
+	Keepalive    *v1alpha1.KeepaliveConfig
+}
+
+// Payload of a Rocket.Chat incoming webhook
+type Payload struct {
+	Channel     string       `json:"channel,omitempty"`
+	Alias       string       `json:"alias,omitempty"`
+	Avatar      string       `json:"avatar,omitempty"`
+	Text        string       `json:"text"`
+	Attachments []Attachment `json:"attachments,omitempty"`
+}
+
+type Attachment struct {
+	Color  string  `json:"color"`
+	Title  string  `json:"title"`
+	Text   string  `json:"text"`
+	Fields []Field `json:"fields"`
+}
+
+type Field struct {
+	Short bool   `json:"short"`
+	Title string `json:"title"`
+	Value string `json:"value"`
+}
+
+type client struct {
+	target.BaseClient
+	webhook      string
+	channel      string
+	alias        string
+	avatar       string
+	headers      map[string]string
+	customFields map[string]string
+	client       http.Client
+	keepalive    *v1alpha1.KeepaliveConfig
+}
+
+func (r *client) newPayload(result openreports.ResultAdapter) Payload {
+	fields := []Field{{true, "Policy", result.Policy}}
+
+	if result.Rule != "" {
+		fields = append(fields, Field{true, "Rule", result.Rule})
+	}
+	fields = append(fields, Field{true, "Status", string(result.Result)})
+	if result.Severity != "" {
+		fields = append(fields, Field{true, "Severity", string(result.Severity)})
+	}
+	if result.Category != "" {
+		fields = append(fields, Field{true, "Category", result.Category})
+	}
+	if result.Source != "" {
+		fields = append(fields, Field{true, "Source", result.Source})
+	}
+
+	if result.HasResource() {
+		res := result.GetResource()
+
+		fields = append(fields, Field{true, "Kind", res.Kind}, Field{true, "Name", res.Name})
+		if res.Namespace != "" {
+			fields = append(fields, Field{true, "Namespace", res.Namespace})
+		}
+		if res.APIVersion != "" {
+			fields = append(fields, Field{true, "API Version", res.APIVersion})
+		}
+	}
+
+	for _, property := range slices.Sorted(maps.Keys(result.Properties)) {
+		fields = append(fields, Field{true, helper.Title(property), result.Properties[property]})
+	}
+	for _, property := range slices.Sorted(maps.Keys(r.customFields)) {
+		fields = append(fields, Field{true, helper.Title(property), r.customFields[property]})
+	}
+
+	return Payload{
+		Channel: r.channel,
+		Alias:   r.alias,
+		Avatar:  r.avatar,
+		Text:    "New Policy Report Result",
+		Attachments: []Attachment{{
+			Color:  formatting.SeverityColor(result.Severity),
+			Title:  result.Policy,
+			Text:   result.Description,
+			Fields: fields,
+		}},
+	}
+}
+
+func (r *client) Send(report openreports.ReportInterface, result openreports.ResultAdapter) {
+	r.post(r.newPayload(result), r.Name())
+}
+
+func (r *client) SendHeartbeat() {
+	fields := make([]Field, 0)
+	if r.keepalive != nil {
+		for k, v := range r.keepalive.Params {
+			fields = append(fields, Field{true, helper.Title(k), v})
+		}
+	}
+
+	r.post(Payload{
+		Channel: r.channel,
+		Alias:   r.alias,
+		Avatar:  r.avatar,
+		Text:    "Policy Reporter Heartbeat",
+		Attachments: []Attachment{{
+			Color:  formatting.SeverityColor(""),
+			Title:  "Heartbeat",
+			Text:   time.Now().Format(time.RFC3339),
+			Fields: fields,
+		}},
+	}, r.Name()+"-heartbeat")
+}
+
+func (r *client) post(payload Payload, name string) {
+	req, err := http.CreateJSONRequest("POST", r.webhook, payload)
+	if err != nil {
+		zap.L().Error(name+": PUSH FAILED", zap.Error(err))
+		return
+	}
+
+	for header, value := range r.headers {
+		req.Header.Set(header, value)
+	}
+
+	resp, err := r.client.Do(req)
+	http.ProcessHTTPResponse(name, resp, err)
+}
+
+func (r *client) Type() target.ClientType {
+	return target.SingleSend
+}
+
+// NewClient creates a new rocketchat.client to send Results to a Rocket.Chat incoming webhook
+func NewClient(options Options) target.Client {
+	return &client{
+		BaseClient:   target.NewBaseClient(options.ClientOptions),
+		webhook:      options.Webhook,
+		channel:      options.Channel,
+		alias:        options.Alias,
+		avatar:       options.Avatar,
+		headers:      options.Headers,
+		customFields: options.CustomFields,
+		client:       options.HTTPClient,
+		keepalive:    options.Keepalive,
+	}
+}
@@ -2,11 +2,14 @@ package securityhub
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	hub "github.com/aws/aws-sdk-go-v2/service/securityhub"
 	"github.com/aws/aws-sdk-go-v2/service/securityhub/types"
+	"github.com/kyverno/go-wildcard"
 	"github.com/openreports/reports-api/apis/openreports.io/v1alpha1"
 	"go.uber.org/zap"
 
@@ -14,10 +17,24 @@ import (
 	"github.com/kyverno/policy-reporter/pkg/helper"
 	"github.com/kyverno/policy-reporter/pkg/openreports"
 	"github.com/kyverno/policy-reporter/pkg/target"
+	"github.com/kyverno/policy-reporter/pkg/validate"
 )
 
 var schema = toPointer("2018-10-08")
 
+const (
+	// DefaultRequirementsAnnotation is the policy annotation with a comma separated list of related compliance requirements
+	DefaultRequirementsAnnotation = "policy-reporter.kyverno.io/related-requirements"
+	// RemediationProperty is the result property used as remediation recommendation text
+	RemediationProperty = "remediation"
+	// RemediationURLProperty is the result property used as remediation recommendation url
+	RemediationURLProperty = "remediationUrl"
+	// ImageProperty is the result property used as container image of the affected resource
+	ImageProperty = "image"
+	// ContainerProperty is the result property used as container name of the affected resource
+	ContainerProperty = "container"
+)
+
 type HubClient interface {
 	BatchImportFindings(ctx context.Context, params *hub.BatchImportFindingsInput, optFns ...func(*hub.Options)) (*hub.BatchImportFindingsOutput, error)
 	GetFindings(ctx context.Context, params *hub.GetFindingsInput, optFns ...func(*hub.Options)) (*hub.GetFindingsOutput, error)
@@ -28,6 +45,20 @@ type PolrClient interface {
 	Get(ctx context.Context, name, namespace string) (openreports.ReportInterface, error)
 }
 
+// PolicyClient resolves the annotations of the policy which produced a result
+type PolicyClient interface {
+	Annotations(ctx context.Context, policy, namespace string) (map[string]string, error)
+}
+
+// Route sends findings of matching namespaces or report labels to a different account and region
+type Route struct {
+	Namespaces   validate.RuleSets
+	ReportLabels map[string]string
+	Client       HubClient
+	AccountID    string
+	Region       string
+}
+
 // Options to configure the SecurityHub target
 type Options struct {
 	target.ClientOptions
@@ -39,25 +70,73 @@ type Options struct {
 	CompanyName  string
 	Delay        time.Duration
 	Synchronize  bool
+	Routes       []Route
+	Policies     PolicyClient
+	// RequirementsAnnotation of the policy mapped to Compliance.RelatedRequirements
+	RequirementsAnnotation string
 }
 
-type client struct {
-	target.BaseClient
-	customFields map[string]string
+type destination struct {
 	hub          HubClient
 	accountID    string
 	region       string
-	productName  string
-	companyName  string
-	delay        time.Duration
-	synchronize  bool
 	arn          *string
+	namespaces   validate.RuleSets
+	reportLabels map[string]string
+}
+
+func (d *destination) matches(namespace string, labels map[string]string) bool {
+	if d.namespaces.Count() > 0 && !validate.Namespace(namespace, d.namespaces) {
+		return false
+	}
+
+	for key, pattern := range d.reportLabels {
+		value, ok := labels[key]
+		if !ok || !wildcard.Match(pattern, value) {
+			return false
+		}
+	}
+
+	return true
+}
+
+type client struct {
+	target.BaseClient
+	customFields           map[string]string
+	productName            string
+	companyName            string
+	delay                  time.Duration
+	synchronize            bool
+	defaultDestination     *destination
+	routes                 []*destination
+	policies               PolicyClient
+	requirementsAnnotation string
+}
+
+// destination resolves the first matching route for the given report, falls back to the default account and region
+func (c *client) destination(polr openreports.ReportInterface, results []openreports.ResultAdapter) *destination {
+	namespace := polr.GetNamespace()
+	if namespace == "" && len(results) > 0 && results[0].HasResource() {
+		namespace = results[0].GetResource().Namespace
+	}
+
+	for _, route := range c.routes {
+		if route.matches(namespace, polr.GetLabels()) {
+			return route
+		}
+	}
+
+	return c.defaultDestination
+}
+
+func (c *client) destinations() []*destination {
+	return append([]*destination{c.defaultDestination}, c.routes...)
 }
 
-func (c *client) mapFindings(polr openreports.ReportInterface, results []openreports.ResultAdapter) []types.AwsSecurityFinding {
+func (c *client) mapFindings(dest *destination, polr openreports.ReportInterface, results []openreports.ResultAdapter) []types.AwsSecurityFinding {
 	var accID *string
-	if c.accountID != "" {
-		accID = toPointer(c.accountID)
+	if dest.accountID != "" {
+		accID = toPointer(dest.accountID)
 	}
 
 	return helper.Map(results, func(result openreports.ResultAdapter) types.AwsSecurityFinding {
@@ -77,7 +156,7 @@ func (c *client) mapFindings(polr openreports.ReportInterface, results []openrep
 			Id:            toPointer(result.GetID()),
 			AwsAccountId:  accID,
 			SchemaVersion: schema,
-			ProductArn:    c.arn,
+			ProductArn:    dest.arn,
 			GeneratorId:   toPointer(fmt.Sprintf("%s/%s", result.Source, generator)),
 			Types:         []string{mapType(result.Source)},
 			CreatedAt:     toPointer(t.Format("2006-01-02T15:04:05.999999999Z07:00")),
@@ -90,19 +169,22 @@ func (c *client) mapFindings(polr openreports.ReportInterface, results []openrep
 			ProductName: &c.productName,
 			CompanyName: &c.companyName,
 			Compliance: &types.Compliance{
-				Status: types.ComplianceStatusFailed,
+				Status:              types.ComplianceStatusFailed,
+				RelatedRequirements: c.mapRelatedRequirements(result),
 			},
+			Remediation: mapRemediation(result),
 			Workflow: &types.Workflow{
 				Status: types.WorkflowStatusNew,
 			},
 			Resources: []types.Resource{
 				{
 					Type:      toPointer("Other"),
-					Region:    &c.region,
+					Region:    &dest.region,
 					Partition: types.PartitionAws,
 					Id:        mapResourceID(result),
 					Details: &types.ResourceDetails{
-						Other: c.mapOtherDetails(polr, result),
+						Other:     c.mapOtherDetails(polr, result),
+						Container: mapContainerDetails(result),
 					},
 				},
 			},
@@ -137,7 +219,9 @@ func (c *client) BatchSend(polr openreports.ReportInterface, results []openrepor
 		return
 	}
 
-	list, err := c.getFindingsByIDs(context.Background(), polr, toResourceIDFilter(polr, results), "")
+	dest := c.destination(polr, results)
+
+	list, err := c.getFindingsByIDs(context.Background(), dest, polr, toResourceIDFilter(polr, results), "")
 	if err != nil {
 		zap.L().Error(c.Name()+": failed to get findings", zap.Error(err))
 		return
@@ -152,7 +236,7 @@ func (c *client) BatchSend(polr openreports.ReportInterface, results []openrepor
 	})
 
 	if len(findings) > 0 {
-		updated, err := c.batchUpdate(context.Background(), findings, types.WorkflowStatusNew)
+		updated, err := c.batchUpdate(context.Background(), dest, findings, types.WorkflowStatusNew)
 		if err != nil {
 			zap.L().Error(c.Name()+": PUSH FAILED", zap.Error(err))
 			return
@@ -174,15 +258,15 @@ func (c *client) BatchSend(polr openreports.ReportInterface, results []openrepor
 		return
 	}
 
-	res, err := c.hub.BatchImportFindings(context.Background(), &hub.BatchImportFindingsInput{
-		Findings: c.mapFindings(polr, results),
+	res, err := dest.hub.BatchImportFindings(context.Background(), &hub.BatchImportFindingsInput{
+		Findings: c.mapFindings(dest, polr, results),
 	})
 	if err != nil {
 		zap.L().Error(c.Name()+": PUSH FAILED", zap.Error(err), zap.Any("response", res))
 		return
 	}
 
-	zap.L().Info(c.Name()+": PUSH OK", zap.Int32("imported", *res.SuccessCount), zap.Int32("failed", *res.FailedCount), zap.String("report", polr.GetKey()))
+	zap.L().Info(c.Name()+": PUSH OK", zap.Int32("imported", *res.SuccessCount), zap.Int32("failed", *res.FailedCount), zap.String("report", polr.GetKey()), zap.String("account", dest.accountID), zap.String("region", dest.region))
 }
 
 func (c *client) Reset(ctx context.Context) error {
@@ -192,33 +276,39 @@ func (c *client) Reset(ctx context.Context) error {
 
 	zap.L().Info(c.Name() + ": START SYNC")
 
-	list, err := c.getFindings(ctx)
-	if err != nil {
-		zap.L().Error(c.Name()+": failed to get findings", zap.Error(err))
-		return err
-	}
+	var errs []error
 
-	if len(list) == 0 {
-		zap.L().Info(c.Name() + ": no findings to sync")
-		return nil
-	}
+	for _, dest := range c.destinations() {
+		list, err := c.getFindings(ctx, dest)
+		if err != nil {
+			zap.L().Error(c.Name()+": failed to get findings", zap.Error(err), zap.String("account", dest.accountID))
+			errs = append(errs, fmt.Errorf("%s: %w", dest.accountID, err))
+			continue
+		}
 
-	findings := helper.Map(list, func(f types.AwsSecurityFinding) types.AwsSecurityFindingIdentifier {
-		return types.AwsSecurityFindingIdentifier{
-			Id:         f.Id,
-			ProductArn: f.ProductArn,
+		if len(list) == 0 {
+			zap.L().Info(c.Name()+": no findings to sync", zap.String("account", dest.accountID))
+			continue
 		}
-	})
 
-	count, err := c.batchUpdate(ctx, findings, types.WorkflowStatusResolved)
-	if err != nil {
-		zap.L().Error(c.Name()+": failed to sync findings", zap.Error(err))
-		return err
-	}
+		findings := helper.Map(list, func(f types.AwsSecurityFinding) types.AwsSecurityFindingIdentifier {
+			return types.AwsSecurityFindingIdentifier{
+				Id:         f.Id,
+				ProductArn: f.ProductArn,
+			}
+		})
 
-	zap.L().Info(c.Name()+": FINISHED SYNC", zap.Int("updated", count))
+		count, err := c.batchUpdate(ctx, dest, findings, types.WorkflowStatusResolved)
+		if err != nil {
+			zap.L().Error(c.Name()+": failed to sync findings", zap.Error(err), zap.String("account", dest.accountID))
+			errs = append(errs, fmt.Errorf("%s: %w", dest.accountID, err))
+			continue
+		}
 
-	return nil
+		zap.L().Info(c.Name()+": FINISHED SYNC", zap.Int("updated", count), zap.String("account", dest.accountID))
+	}
+
+	return errors.Join(errs...)
 }
 
 func (c *client) CleanUp(ctx context.Context, report openreports.ReportInterface) {
@@ -235,8 +325,9 @@ func (c *client) CleanUp(ctx context.Context, report openreports.ReportInterface
 	}
 
 	resourceIds := toResourceIDFilter(report, report.GetResults())
+	dest := c.destination(report, report.GetResults())
 
-	findings, err := c.getFindingsByIDs(ctx, report, resourceIds, "")
+	findings, err := c.getFindingsByIDs(ctx, dest, report, resourceIds, "")
 	if err != nil {
 		zap.L().Error(c.Name()+": failed to get findings", zap.Error(err))
 		return
@@ -272,7 +363,7 @@ func (c *client) CleanUp(ctx context.Context, report openreports.ReportInterface
 		})
 	}
 
-	count, err := c.batchUpdate(ctx, list, types.WorkflowStatusResolved)
+	count, err := c.batchUpdate(ctx, dest, list, types.WorkflowStatusResolved)
 	if err != nil {
 		zap.L().Error(c.Name()+": failed to batch resolve findings", zap.Error(err))
 		return
@@ -324,15 +415,47 @@ func (c *client) mapOtherDetails(polr openreports.ReportInterface, result openre
 	return details
 }
 
-func (c *client) getFindings(ctx context.Context) ([]types.AwsSecurityFinding, error) {
+func (c *client) mapRelatedRequirements(result openreports.ResultAdapter) []string {
+	// related requirements are only resolved from Kyverno policy annotations
+	if c.policies == nil || result.Policy == "" || !strings.EqualFold(result.Source, "kyverno") {
+		return nil
+	}
+
+	name, namespace := result.Policy, ""
+	if parts := strings.SplitN(result.Policy, "/", 2); len(parts) == 2 {
+		namespace, name = parts[0], parts[1]
+	}
+
+	annotations, err := c.policies.Annotations(context.Background(), name, namespace)
+	if err != nil {
+		zap.L().Debug(c.Name()+": failed to resolve policy annotations", zap.String("policy", result.Policy), zap.Error(err))
+		return nil
+	}
+
+	value, ok := annotations[c.requirementsAnnotation]
+	if !ok || value == "" {
+		return nil
+	}
+
+	requirements := make([]string, 0)
+	for _, r := range strings.Split(value, ",") {
+		if r = strings.TrimSpace(r); r != "" {
+			requirements = append(requirements, r)
+		}
+	}
+
+	return requirements
+}
+
+func (c *client) getFindings(ctx context.Context, dest *destination) ([]types.AwsSecurityFinding, error) {
 	list := make([]types.AwsSecurityFinding, 0)
 
 	var token *string
 
 	for {
-		resp, err := c.hub.GetFindings(ctx, &hub.GetFindingsInput{
+		resp, err := dest.hub.GetFindings(ctx, &hub.GetFindingsInput{
 			NextToken: token,
-			Filters:   c.BaseFilter(nil),
+			Filters:   c.baseFilter(dest, nil),
 		})
 		if err != nil {
 			return nil, err
@@ -351,7 +474,7 @@ func (c *client) getFindings(ctx context.Context) ([]types.AwsSecurityFinding, e
 	}
 }
 
-func (c *client) batchUpdate(ctx context.Context, findings []types.AwsSecurityFindingIdentifier, status types.WorkflowStatus) (int, error) {
+func (c *client) batchUpdate(ctx context.Context, dest *destination, findings []types.AwsSecurityFindingIdentifier, status types.WorkflowStatus) (int, error) {
 	if len(findings) == 0 {
 		return 0, nil
 	}
@@ -360,7 +483,7 @@ func (c *client) batchUpdate(ctx context.Context, findings []types.AwsSecurityFi
 
 	var updated int
 	for _, chunk := range chunks {
-		response, err := c.hub.BatchUpdateFindings(ctx, &hub.BatchUpdateFindingsInput{
+		response, err := dest.hub.BatchUpdateFindings(ctx, &hub.BatchUpdateFindingsInput{
 			FindingIdentifiers: chunk,
 			Workflow: &types.WorkflowUpdate{
 				Status: status,
@@ -376,13 +499,13 @@ func (c *client) batchUpdate(ctx context.Context, findings []types.AwsSecurityFi
 	return updated, nil
 }
 
-func (c *client) getFindingsByIDs(ctx context.Context, report openreports.ReportInterface, resources []types.StringFilter, status string) ([]types.AwsSecurityFinding, error) {
+func (c *client) getFindingsByIDs(ctx context.Context, dest *destination, report openreports.ReportInterface, resources []types.StringFilter, status string) ([]types.AwsSecurityFinding, error) {
 	list := make([]types.AwsSecurityFinding, 0)
 
 	chunks := helper.ChunkSlice(resources, 20)
 
 	for _, res := range chunks {
-		filter := c.BaseFilter(report)
+		filter := c.baseFilter(dest, report)
 		if len(res) > 0 {
 			filter.ResourceId = res
 		}
@@ -399,7 +522,7 @@ func (c *client) getFindingsByIDs(ctx context.Context, report openreports.Report
 		var token *string
 
 		for {
-			resp, err := c.hub.GetFindings(ctx, &hub.GetFindingsInput{
+			resp, err := dest.hub.GetFindings(ctx, &hub.GetFindingsInput{
 				NextToken: token,
 				Filters:   filter,
 			})
@@ -423,7 +546,12 @@ func (c *client) getFindingsByIDs(ctx context.Context, report openreports.Report
 	return list, nil
 }
 
+// BaseFilter for findings of the default account and region
 func (c *client) BaseFilter(report openreports.ReportInterface) *types.AwsSecurityFindingFilters {
+	return c.baseFilter(c.defaultDestination, report)
+}
+
+func (c *client) baseFilter(dest *destination, report openreports.ReportInterface) *types.AwsSecurityFindingFilters {
 	source := ""
 	if report != nil {
 		source = report.GetSource()
@@ -433,19 +561,19 @@ func (c *client) BaseFilter(report openreports.ReportInterface) *types.AwsSecuri
 		ProductArn: []types.StringFilter{
 			{
 				Comparison: types.StringFilterComparisonEquals,
-				Value:      c.arn,
+				Value:      dest.arn,
 			},
 		},
 		AwsAccountId: []types.StringFilter{
 			{
 				Comparison: types.StringFilterComparisonEquals,
-				Value:      &c.accountID,
+				Value:      &dest.accountID,
 			},
 		},
 		Region: []types.StringFilter{
 			{
 				Comparison: types.StringFilterComparisonEquals,
-				Value:      &c.region,
+				Value:      &dest.region,
 			},
 		},
 		Type: []types.StringFilter{
@@ -495,17 +623,36 @@ func NewClient(options Options) *client {
 		options.Delay = 2 * time.Second
 	}
 
+	if options.RequirementsAnnotation == "" {
+		options.RequirementsAnnotation = DefaultRequirementsAnnotation
+	}
+
 	return &client{
-		target.NewBaseClient(options.ClientOptions),
-		options.CustomFields,
-		options.Client,
-		options.AccountID,
-		options.Region,
-		options.ProductName,
-		options.CompanyName,
-		options.Delay,
-		options.Synchronize,
-		toPointer("arn:aws:securityhub:" + options.Region + ":" + options.AccountID + ":product/" + options.AccountID + "/default"),
+		BaseClient:             target.NewBaseClient(options.ClientOptions),
+		customFields:           options.CustomFields,
+		productName:            options.ProductName,
+		companyName:            options.CompanyName,
+		delay:                  options.Delay,
+		synchronize:            options.Synchronize,
+		defaultDestination:     newDestination(options.Client, options.AccountID, options.Region),
+		policies:               options.Policies,
+		requirementsAnnotation: options.RequirementsAnnotation,
+		routes: helper.Map(options.Routes, func(r Route) *destination {
+			dest := newDestination(r.Client, r.AccountID, r.Region)
+			dest.namespaces = r.Namespaces
+			dest.reportLabels = r.ReportLabels
+
+			return dest
+		}),
+	}
+}
+
+func newDestination(client HubClient, accountID, region string) *destination {
+	return &destination{
+		hub:       client,
+		accountID: accountID,
+		region:    region,
+		arn:       toPointer("arn:aws:securityhub:" + region + ":" + accountID + ":product/" + accountID + "/default"),
 	}
 }
 
@@ -543,6 +690,46 @@ func mapResourceID(result openreports.ResultAdapter) *string {
 	return toPointer(result.GetID())
 }
 
+func mapRemediation(result openreports.ResultAdapter) *types.Remediation {
+	text := result.Properties[RemediationProperty]
+	url := result.Properties[RemediationURLProperty]
+	if text == "" && url == "" {
+		return nil
+	}
+
+	recommendation := &types.Recommendation{}
+	if text != "" {
+		// SecurityHub limits the recommendation text to 512 characters
+		if runes := []rune(text); len(runes) > 512 {
+			text = string(runes[:509]) + "..."
+		}
+
+		recommendation.Text = toPointer(text)
+	}
+	if url != "" {
+		recommendation.Url = toPointer(url)
+	}
+
+	return &types.Remediation{Recommendation: recommendation}
+}
+
+func mapContainerDetails(result openreports.ResultAdapter) *types.ContainerDetails {
+	image := result.Properties[ImageProperty]
+	if image == "" {
+		return nil
+	}
+
+	details := &types.ContainerDetails{
+		ImageName: toPointer(image),
+	}
+
+	if name := result.Properties[ContainerProperty]; name != "" {
+		details.Name = toPointer(name)
+	}
+
+	return details
+}
+
 func mapType(source string) string {
 	if source == "" {
 		return "Software and Configuration Checks/Kubernetes Policies"
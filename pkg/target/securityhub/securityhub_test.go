@@ -2,15 +2,20 @@ package securityhub_test
 
 import (
 	"context"
+	"errors"
+	"strings"
 	"testing"
+	"unicode/utf8"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	hub "github.com/aws/aws-sdk-go-v2/service/securityhub"
 	"github.com/aws/aws-sdk-go-v2/service/securityhub/types"
+	"github.com/stretchr/testify/assert"
 
 	"github.com/kyverno/policy-reporter/pkg/crd/api/policyreport/v1alpha2"
 	"github.com/kyverno/policy-reporter/pkg/fixtures"
 	"github.com/kyverno/policy-reporter/pkg/target/securityhub"
+	"github.com/kyverno/policy-reporter/pkg/validate"
 )
 
 type client struct {
@@ -19,6 +24,7 @@ type client struct {
 
 	send     func(findings []types.AwsSecurityFinding)
 	findings []types.AwsSecurityFinding
+	err      error
 }
 
 func (c *client) BatchImportFindings(ctx context.Context, params *hub.BatchImportFindingsInput, optFns ...func(*hub.Options)) (*hub.BatchImportFindingsOutput, error) {
@@ -36,6 +42,10 @@ func (c *client) BatchImportFindings(ctx context.Context, params *hub.BatchImpor
 
 func (c *client) GetFindings(ctx context.Context, params *hub.GetFindingsInput, optFns ...func(*hub.Options)) (*hub.GetFindingsOutput, error) {
 	c.fetched = true
+	if c.err != nil {
+		return nil, c.err
+	}
+
 	return &hub.GetFindingsOutput{
 		Findings: c.findings,
 	}, nil
@@ -208,3 +218,235 @@ func TestSecurityHub(t *testing.T) {
 		}
 	})
 }
+
+type policyClient struct {
+	annotations map[string]string
+	err         error
+}
+
+func (p *policyClient) Annotations(ctx context.Context, policy, namespace string) (map[string]string, error) {
+	return p.annotations, p.err
+}
+
+func TestSecurityHubRoutes(t *testing.T) {
+	t.Parallel()
+	t.Run("send to matching namespace route", func(t *testing.T) {
+		t.Parallel()
+		defaultHub := &client{}
+		routedHub := &client{
+			send: func(findings []types.AwsSecurityFinding) {
+				if *findings[0].AwsAccountId != "team-account" {
+					t.Errorf("unexpected accountId: %s", *findings[0].AwsAccountId)
+				}
+				if *findings[0].ProductArn != "arn:aws:securityhub:us-east-1:team-account:product/team-account/default" {
+					t.Errorf("unexpected product arn: %s", *findings[0].ProductArn)
+				}
+				if *findings[0].Resources[0].Region != "us-east-1" {
+					t.Errorf("unexpected region: %s", *findings[0].Resources[0].Region)
+				}
+			},
+		}
+
+		c := securityhub.NewClient(securityhub.Options{
+			AccountID:   "accountId",
+			Region:      "eu-central-1",
+			ProductName: "Policy Reporter",
+			CompanyName: "Kyverno",
+			Client:      defaultHub,
+			Routes: []securityhub.Route{{
+				Namespaces: validate.RuleSets{Include: []string{"te*"}},
+				AccountID:  "team-account",
+				Region:     "us-east-1",
+				Client:     routedHub,
+			}},
+		})
+
+		c.BatchSend(fixtures.DefaultPolicyReport, fixtures.DefaultPolicyReport.GetResults())
+
+		if defaultHub.batched {
+			t.Error("expected default hub was not called")
+		}
+		if !routedHub.batched {
+			t.Error("expected routed hub was called")
+		}
+	})
+	t.Run("fallback to default destination", func(t *testing.T) {
+		t.Parallel()
+		defaultHub := &client{}
+		routedHub := &client{}
+
+		c := securityhub.NewClient(securityhub.Options{
+			AccountID:   "accountId",
+			Region:      "eu-central-1",
+			ProductName: "Policy Reporter",
+			CompanyName: "Kyverno",
+			Client:      defaultHub,
+			Routes: []securityhub.Route{{
+				Namespaces:   validate.RuleSets{Include: []string{"test"}},
+				ReportLabels: map[string]string{"team": "a*"},
+				AccountID:    "team-account",
+				Region:       "us-east-1",
+				Client:       routedHub,
+			}},
+		})
+
+		c.BatchSend(fixtures.DefaultPolicyReport, fixtures.DefaultPolicyReport.GetResults())
+
+		if !defaultHub.batched {
+			t.Error("expected default hub was called")
+		}
+		if routedHub.batched {
+			t.Error("expected routed hub was not called")
+		}
+	})
+	t.Run("reset all destinations", func(t *testing.T) {
+		t.Parallel()
+		defaultHub := &client{}
+		routedHub := &client{}
+
+		c := securityhub.NewClient(securityhub.Options{
+			AccountID:   "accountId",
+			Region:      "eu-central-1",
+			ProductName: "Policy Reporter",
+			CompanyName: "Kyverno",
+			Client:      defaultHub,
+			Synchronize: true,
+			Routes: []securityhub.Route{{
+				Namespaces: validate.RuleSets{Include: []string{"test"}},
+				AccountID:  "team-account",
+				Client:     routedHub,
+			}},
+		})
+
+		if err := c.Reset(context.TODO()); err != nil {
+			t.Fatal(err)
+		}
+
+		if !defaultHub.fetched {
+			t.Error("expected default hub was fetched")
+		}
+		if !routedHub.fetched {
+			t.Error("expected routed hub was fetched")
+		}
+	})
+	t.Run("reset continues after failed destination", func(t *testing.T) {
+		t.Parallel()
+		defaultHub := &client{err: errors.New("access denied")}
+		routedHub := &client{}
+
+		c := securityhub.NewClient(securityhub.Options{
+			AccountID:   "accountId",
+			Region:      "eu-central-1",
+			Client:      defaultHub,
+			Synchronize: true,
+			Routes: []securityhub.Route{{
+				Namespaces: validate.RuleSets{Include: []string{"test"}},
+				AccountID:  "team-account",
+				Client:     routedHub,
+			}},
+		})
+
+		err := c.Reset(context.TODO())
+
+		assert.ErrorContains(t, err, "access denied")
+		assert.True(t, routedHub.fetched, "expected routed hub was fetched")
+	})
+}
+
+func TestSecurityHubEnrichment(t *testing.T) {
+	t.Parallel()
+	t.Run("map remediation, container and requirements", func(t *testing.T) {
+		t.Parallel()
+		result := fixtures.CompleteTargetSendResult
+		result.Properties = map[string]string{
+			securityhub.RemediationProperty:    "set resource requests and limits",
+			securityhub.RemediationURLProperty: "https://kyverno.io/policies/best-practices/require-pod-requests-limits/",
+			securityhub.ImageProperty:          "nginx:1.25",
+			securityhub.ContainerProperty:      "nginx",
+		}
+
+		c := securityhub.NewClient(securityhub.Options{
+			AccountID:   "accountId",
+			Region:      "eu-central-1",
+			ProductName: "Policy Reporter",
+			CompanyName: "Kyverno",
+			Policies: &policyClient{annotations: map[string]string{
+				securityhub.DefaultRequirementsAnnotation: "CIS 5.1.1, NIST 800-53 CM-6",
+			}},
+			Client: &client{
+				send: func(findings []types.AwsSecurityFinding) {
+					finding := findings[0]
+
+					assert.Equal(t, []string{"CIS 5.1.1", "NIST 800-53 CM-6"}, finding.Compliance.RelatedRequirements)
+					assert.Equal(t, "set resource requests and limits", *finding.Remediation.Recommendation.Text)
+					assert.Equal(t, "https://kyverno.io/policies/best-practices/require-pod-requests-limits/", *finding.Remediation.Recommendation.Url)
+					assert.Equal(t, "nginx:1.25", *finding.Resources[0].Details.Container.ImageName)
+					assert.Equal(t, "nginx", *finding.Resources[0].Details.Container.Name)
+				},
+			},
+		})
+
+		c.Send(fixtures.DefaultPolicyReport, result)
+	})
+	t.Run("skip missing enrichments", func(t *testing.T) {
+		t.Parallel()
+		c := securityhub.NewClient(securityhub.Options{
+			AccountID:   "accountId",
+			Region:      "eu-central-1",
+			ProductName: "Policy Reporter",
+			CompanyName: "Kyverno",
+			Policies:    &policyClient{err: errors.New("not found")},
+			Client: &client{
+				send: func(findings []types.AwsSecurityFinding) {
+					finding := findings[0]
+
+					assert.Nil(t, finding.Compliance.RelatedRequirements)
+					assert.Nil(t, finding.Remediation)
+					assert.Nil(t, finding.Resources[0].Details.Container)
+				},
+			},
+		})
+
+		c.Send(fixtures.DefaultPolicyReport, fixtures.CompleteTargetSendResult)
+	})
+	t.Run("skip requirements of other sources", func(t *testing.T) {
+		t.Parallel()
+		result := fixtures.CompleteTargetSendResult
+		result.Source = "Trivy"
+
+		c := securityhub.NewClient(securityhub.Options{
+			AccountID: "accountId",
+			Region:    "eu-central-1",
+			Policies: &policyClient{annotations: map[string]string{
+				securityhub.DefaultRequirementsAnnotation: "CIS 5.1.1",
+			}},
+			Client: &client{
+				send: func(findings []types.AwsSecurityFinding) {
+					assert.Nil(t, findings[0].Compliance.RelatedRequirements)
+				},
+			},
+		})
+
+		c.Send(fixtures.DefaultPolicyReport, result)
+	})
+	t.Run("truncate remediation by characters", func(t *testing.T) {
+		t.Parallel()
+		result := fixtures.CompleteTargetSendResult
+		result.Properties = map[string]string{securityhub.RemediationProperty: strings.Repeat("ä", 600)}
+
+		c := securityhub.NewClient(securityhub.Options{
+			AccountID: "accountId",
+			Region:    "eu-central-1",
+			Client: &client{
+				send: func(findings []types.AwsSecurityFinding) {
+					text := *findings[0].Remediation.Recommendation.Text
+
+					assert.True(t, utf8.ValidString(text))
+					assert.Equal(t, 512, utf8.RuneCountInString(text))
+				},
+			},
+		})
+
+		c.Send(fixtures.DefaultPolicyReport, result)
+	})
+}
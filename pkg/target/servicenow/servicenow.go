@@ -0,0 +1,412 @@
+package servicenow
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"go.uber.org/zap"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+
+	"github.com/kyverno/policy-reporter/pkg/helper"
+	"github.com/kyverno/policy-reporter/pkg/openreports"
+	"github.com/kyverno/policy-reporter/pkg/target"
+	targethttp "github.com/kyverno/policy-reporter/pkg/target/http"
+)
+
+const (
+	// IncidentTable of the ITSM incident application
+	IncidentTable = "incident"
+	// SecurityIncidentTable of the Security Incident Response application
+	SecurityIncidentTable = "sn_si_incident"
+
+	// CorrelationIDField stores the result ID to correlate records with policy results
+	CorrelationIDField = "correlation_id"
+	// CorrelationDisplayField stores the report key to find all records of a report
+	CorrelationDisplayField = "correlation_display"
+
+	tokenPath = "/oauth_token.do"
+
+	pageSize = 1000
+
+	shortDescriptionTemplate = "{{ if .result.ResourceString }}{{ .result.ResourceString }}: {{ end }}Policy Violation: {{ .result.Policy }}"
+	descriptionTemplate      = `{{ .result.Description }}
+
+Policy: {{ .result.Policy }}
+{{- if .result.Rule }}
+Rule: {{ .result.Rule }}
+{{- end }}
+Status: {{ .result.Result }}
+Severity: {{ .result.Severity }}
+{{- if .result.Category }}
+Category: {{ .result.Category }}
+{{- end }}
+Source: {{ .result.Source }}
+{{- if .result.ResourceString }}
+Resource: {{ .result.ResourceString }}
+{{- end }}
+{{- range $key, $value := .result.Properties }}
+{{ $key }}: {{ $value }}
+{{- end }}`
+	closeNotes = "Policy violation is no longer reported by Policy Reporter"
+)
+
+// DefaultFields maps ServiceNow fields to templates, used if no field mapping is configured
+var DefaultFields = map[string]string{
+	"short_description": shortDescriptionTemplate,
+	"description":       descriptionTemplate,
+	"urgency":           "{{ .priority }}",
+	"impact":            "{{ .priority }}",
+}
+
+// DefaultResolveFields per table, used to resolve records of no longer existing violations
+var DefaultResolveFields = map[string]map[string]string{
+	IncidentTable: {
+		"state":       "6",
+		"close_code":  "Solved (Permanently)",
+		"close_notes": closeNotes,
+	},
+	SecurityIncidentTable: {
+		"state":       "3",
+		"close_notes": closeNotes,
+	},
+}
+
+// Options to configure the ServiceNow target
+type Options struct {
+	target.ClientOptions
+	Host          string
+	Table         string
+	Username      string
+	Password      string
+	ClientID      string
+	ClientSecret  string
+	Fields        map[string]string
+	ResolveFields map[string]string
+	Headers       map[string]string
+	CustomFields  map[string]string
+	HTTPClient    targethttp.Client
+}
+
+// Record of the ServiceNow Table API
+type Record struct {
+	SysID         string `json:"sys_id"`
+	CorrelationID string `json:"correlation_id"`
+}
+
+type recordList struct {
+	Result []Record `json:"result"`
+}
+
+type client struct {
+	target.BaseClient
+	host          string
+	table         string
+	username      string
+	password      string
+	tokenSource   oauth2.TokenSource
+	fields        map[string]*template.Template
+	resolveFields map[string]string
+	headers       map[string]string
+	customFields  map[string]string
+	client        targethttp.Client
+}
+
+func (c *client) Send(report openreports.ReportInterface, result openreports.ResultAdapter) {
+	c.BatchSend(report, []openreports.ResultAdapter{result})
+}
+
+// BatchSend creates a record for each failed result without an active record of the same result ID
+func (c *client) BatchSend(report openreports.ReportInterface, results []openreports.ResultAdapter) {
+	results = helper.Filter(results, isViolation)
+	if len(results) == 0 {
+		return
+	}
+
+	records, err := c.activeRecords(context.Background(), report)
+	if err != nil {
+		zap.L().Error(c.Name()+": failed to get active records", zap.Error(err), zap.String("report", report.GetKey()))
+		return
+	}
+
+	existing := make(map[string]bool, len(records))
+	for _, r := range records {
+		existing[r.CorrelationID] = true
+	}
+
+	for _, result := range results {
+		if existing[result.GetID()] {
+			continue
+		}
+
+		fields, err := c.mapFields(report, result)
+		if err != nil {
+			zap.L().Error(c.Name()+": failed to map fields", zap.Error(err), zap.String("policy", result.Policy))
+			continue
+		}
+
+		resp, err := c.do(context.Background(), http.MethodPost, c.tableURL(""), fields)
+		targethttp.ProcessHTTPResponse(c.Name(), resp, err)
+
+		existing[result.GetID()] = true
+	}
+}
+
+// CleanUp resolves all active records of the report whose violation no longer exists
+func (c *client) CleanUp(ctx context.Context, report openreports.ReportInterface) {
+	records, err := c.activeRecords(ctx, report)
+	if err != nil {
+		zap.L().Error(c.Name()+": failed to get active records", zap.Error(err), zap.String("report", report.GetKey()))
+		return
+	}
+
+	if len(records) == 0 {
+		return
+	}
+
+	current := make(map[string]bool)
+	for _, result := range report.GetResults() {
+		if isViolation(result) && c.Validate(report, result) {
+			current[result.GetID()] = true
+		}
+	}
+
+	for _, r := range records {
+		if current[r.CorrelationID] {
+			continue
+		}
+
+		resp, err := c.do(ctx, http.MethodPatch, c.tableURL(r.SysID), c.resolveFields)
+		targethttp.ProcessHTTPResponse(c.Name()+"-resolve", resp, err)
+	}
+}
+
+func (c *client) Type() target.ClientType {
+	return target.SyncSend
+}
+
+// activeRecords pages through all active records of the report, the instance may return less records than requested per page
+func (c *client) activeRecords(ctx context.Context, report openreports.ReportInterface) ([]Record, error) {
+	records := make([]Record, 0)
+
+	for {
+		page, err := c.recordPage(ctx, report, len(records))
+		if err != nil {
+			return nil, err
+		}
+
+		if len(page) == 0 {
+			return records, nil
+		}
+
+		records = append(records, page...)
+	}
+}
+
+func (c *client) recordPage(ctx context.Context, report openreports.ReportInterface, offset int) ([]Record, error) {
+	query := url.Values{}
+	query.Set("sysparm_query", fmt.Sprintf("active=true^%s=%s^ORDERBYsys_id", CorrelationDisplayField, report.GetKey()))
+	query.Set("sysparm_fields", "sys_id,"+CorrelationIDField)
+	query.Set("sysparm_limit", strconv.Itoa(pageSize))
+	query.Set("sysparm_offset", strconv.Itoa(offset))
+
+	resp, err := c.do(ctx, http.MethodGet, c.tableURL("")+"?"+query.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, string(body))
+	}
+
+	list := recordList{}
+	if err := json.NewDecoder(resp.Body).Decode(&list); err != nil {
+		return nil, err
+	}
+
+	return list.Result, nil
+}
+
+func (c *client) mapFields(report openreports.ReportInterface, result openreports.ResultAdapter) (map[string]string, error) {
+	values := map[string]any{
+		"result":      &result,
+		"report":      report.GetKey(),
+		"namespace":   report.GetNamespace(),
+		"customfield": c.customFields,
+		"priority":    MapPriority(result),
+	}
+
+	fields := make(map[string]string, len(c.fields)+2)
+	for field, tmpl := range c.fields {
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, values); err != nil {
+			return nil, fmt.Errorf("failed to execute %s template: %w", field, err)
+		}
+
+		fields[field] = strings.TrimSpace(buf.String())
+	}
+
+	fields[CorrelationIDField] = result.GetID()
+	fields[CorrelationDisplayField] = report.GetKey()
+
+	return fields, nil
+}
+
+func (c *client) tableURL(sysID string) string {
+	u := fmt.Sprintf("%s/api/now/table/%s", c.host, c.table)
+	if sysID != "" {
+		u += "/" + sysID
+	}
+
+	return u
+}
+
+func (c *client) do(ctx context.Context, method, u string, payload any) (*http.Response, error) {
+	var req *http.Request
+	var err error
+
+	if payload != nil {
+		req, err = targethttp.CreateJSONRequest(method, u, payload)
+	} else {
+		req, err = http.NewRequest(method, u, nil)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+
+	for k, v := range c.headers {
+		req.Header.Set(k, v)
+	}
+
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", "Policy-Reporter")
+
+	if c.tokenSource != nil {
+		token, err := c.tokenSource.Token()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get oauth token: %w", err)
+		}
+
+		token.SetAuthHeader(req)
+	} else if c.username != "" {
+		req.SetBasicAuth(c.username, c.password)
+	}
+
+	return c.client.Do(req)
+}
+
+// MapPriority maps the result severity to the ServiceNow urgency and impact scale
+func MapPriority(result openreports.ResultAdapter) string {
+	switch result.Severity {
+	case openreports.SeverityCritical, openreports.SeverityHigh:
+		return "1"
+	case openreports.SeverityMedium:
+		return "2"
+	default:
+		return "3"
+	}
+}
+
+func isViolation(result openreports.ResultAdapter) bool {
+	return result.Result != openreports.StatusPass && result.Result != openreports.StatusSkip
+}
+
+// passwordTokenSource requests tokens with the resource owner password grant
+type passwordTokenSource struct {
+	ctx      context.Context
+	config   *oauth2.Config
+	username string
+	password string
+}
+
+func (s *passwordTokenSource) Token() (*oauth2.Token, error) {
+	return s.config.PasswordCredentialsToken(s.ctx, s.username, s.password)
+}
+
+func newTokenSource(options Options, host string) oauth2.TokenSource {
+	ctx := context.Background()
+	if c, ok := options.HTTPClient.(*http.Client); ok {
+		ctx = context.WithValue(ctx, oauth2.HTTPClient, c)
+	}
+
+	if options.Username == "" {
+		config := &clientcredentials.Config{
+			ClientID:     options.ClientID,
+			ClientSecret: options.ClientSecret,
+			TokenURL:     host + tokenPath,
+			AuthStyle:    oauth2.AuthStyleInParams,
+		}
+
+		return config.TokenSource(ctx)
+	}
+
+	return oauth2.ReuseTokenSource(nil, &passwordTokenSource{
+		ctx: ctx,
+		config: &oauth2.Config{
+			ClientID:     options.ClientID,
+			ClientSecret: options.ClientSecret,
+			Endpoint:     oauth2.Endpoint{TokenURL: host + tokenPath, AuthStyle: oauth2.AuthStyleInParams},
+		},
+		username: options.Username,
+		password: options.Password,
+	})
+}
+
+// NewClient creates a new ServiceNow client
+func NewClient(options Options) (target.Client, error) {
+	host := strings.TrimSuffix(options.Host, "/")
+	table := helper.Defaults(options.Table, IncidentTable)
+
+	mapping := maps.Clone(DefaultFields)
+	maps.Copy(mapping, options.Fields)
+
+	fields := make(map[string]*template.Template, len(mapping))
+	for field, value := range mapping {
+		tmpl, err := template.New(field).Parse(value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s template: %w", field, err)
+		}
+
+		fields[field] = tmpl
+	}
+
+	resolveFields := options.ResolveFields
+	if len(resolveFields) == 0 {
+		resolveFields = DefaultResolveFields[table]
+	}
+	if len(resolveFields) == 0 {
+		resolveFields = DefaultResolveFields[IncidentTable]
+	}
+
+	var tokenSource oauth2.TokenSource
+	if options.ClientID != "" {
+		tokenSource = newTokenSource(options, host)
+	}
+
+	return &client{
+		BaseClient:    target.NewBaseClient(options.ClientOptions),
+		host:          host,
+		table:         table,
+		username:      options.Username,
+		password:      options.Password,
+		tokenSource:   tokenSource,
+		fields:        fields,
+		resolveFields: resolveFields,
+		headers:       options.Headers,
+		customFields:  options.CustomFields,
+		client:        options.HTTPClient,
+	}, nil
+}
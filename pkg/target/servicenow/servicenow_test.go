@@ -0,0 +1,220 @@
+package servicenow_test
+
+import (
+	"context"
+	"encoding/json"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kyverno/policy-reporter/pkg/fixtures"
+	"github.com/kyverno/policy-reporter/pkg/openreports"
+	"github.com/kyverno/policy-reporter/pkg/target"
+	"github.com/kyverno/policy-reporter/pkg/target/servicenow"
+)
+
+// instance is a minimal stand-in of the ServiceNow Table API
+type instance struct {
+	mx      sync.Mutex
+	records map[string]map[string]string
+	auth    []string
+}
+
+func newInstance(t *testing.T) (*instance, *httptest.Server) {
+	i := &instance{records: make(map[string]map[string]string)}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/oauth_token.do", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "client", r.FormValue("client_id"))
+		assert.Equal(t, "secret", r.FormValue("client_secret"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token":"token","token_type":"Bearer","expires_in":1800}`))
+	})
+	mux.HandleFunc("/api/now/table/incident", func(w http.ResponseWriter, r *http.Request) {
+		i.mx.Lock()
+		defer i.mx.Unlock()
+		i.auth = append(i.auth, r.Header.Get("Authorization"))
+
+		switch r.Method {
+		case http.MethodGet:
+			query := r.URL.Query().Get("sysparm_query")
+			list := make([]servicenow.Record, 0)
+			for _, id := range slices.Sorted(maps.Keys(i.records)) {
+				fields := i.records[id]
+				if fields["state"] == "6" || !strings.Contains(query, "correlation_display="+fields["correlation_display"]+"^") {
+					continue
+				}
+
+				list = append(list, servicenow.Record{SysID: id, CorrelationID: fields["correlation_id"]})
+			}
+
+			// return a single record per page like an instance with a low response limit
+			offset, _ := strconv.Atoi(r.URL.Query().Get("sysparm_offset"))
+			list = list[min(offset, len(list)):min(offset+1, len(list))]
+
+			json.NewEncoder(w).Encode(map[string]any{"result": list})
+		case http.MethodPost:
+			fields := map[string]string{}
+			json.NewDecoder(r.Body).Decode(&fields)
+
+			id := fields["correlation_id"]
+			i.records[id] = fields
+
+			w.WriteHeader(http.StatusCreated)
+		}
+	})
+	mux.HandleFunc("/api/now/table/incident/", func(w http.ResponseWriter, r *http.Request) {
+		i.mx.Lock()
+		defer i.mx.Unlock()
+
+		assert.Equal(t, http.MethodPatch, r.Method)
+
+		fields := map[string]string{}
+		json.NewDecoder(r.Body).Decode(&fields)
+
+		id := strings.TrimPrefix(r.URL.Path, "/api/now/table/incident/")
+		for k, v := range fields {
+			i.records[id][k] = v
+		}
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return i, server
+}
+
+func Test_ServiceNowTarget(t *testing.T) {
+	t.Parallel()
+	t.Run("Create Records without Duplicates", func(t *testing.T) {
+		t.Parallel()
+		instance, server := newInstance(t)
+
+		client, err := servicenow.NewClient(servicenow.Options{
+			ClientOptions: target.ClientOptions{
+				Name: "ServiceNow",
+			},
+			Host:       server.URL,
+			Username:   "admin",
+			Password:   "password",
+			HTTPClient: server.Client(),
+		})
+		assert.Nil(t, err)
+
+		client.BatchSend(fixtures.DefaultPolicyReport, fixtures.DefaultPolicyReport.GetResults())
+		client.BatchSend(fixtures.DefaultPolicyReport, fixtures.DefaultPolicyReport.GetResults())
+
+		assert.Len(t, instance.records, len(fixtures.DefaultPolicyReport.GetResults()))
+
+		result := fixtures.DefaultPolicyReport.GetResults()[0]
+		record := instance.records[result.GetID()]
+		assert.Equal(t, "test/policy-report", record["correlation_display"])
+		assert.Equal(t, "Basic YWRtaW46cGFzc3dvcmQ=", instance.auth[0])
+	})
+	t.Run("Resolve Records of fixed Violations", func(t *testing.T) {
+		t.Parallel()
+		instance, server := newInstance(t)
+
+		client, err := servicenow.NewClient(servicenow.Options{
+			Host:       server.URL,
+			Username:   "admin",
+			Password:   "password",
+			HTTPClient: server.Client(),
+		})
+		assert.Nil(t, err)
+
+		client.BatchSend(fixtures.DefaultPolicyReport, fixtures.DefaultPolicyReport.GetResults())
+
+		report := &openreports.ReportAdapter{
+			Report:  fixtures.DefaultPolicyReport.Report,
+			Results: fixtures.DefaultPolicyReport.GetResults()[1:],
+		}
+
+		client.CleanUp(context.Background(), report)
+
+		resolved := instance.records[fixtures.DefaultPolicyReport.GetResults()[0].GetID()]
+		assert.Equal(t, "6", resolved["state"])
+		assert.Equal(t, "Solved (Permanently)", resolved["close_code"])
+
+		active := instance.records[fixtures.DefaultPolicyReport.GetResults()[1].GetID()]
+		assert.Equal(t, "", active["state"])
+	})
+	t.Run("Field Mapping Templates", func(t *testing.T) {
+		t.Parallel()
+		instance, server := newInstance(t)
+
+		client, err := servicenow.NewClient(servicenow.Options{
+			Host:     server.URL,
+			Username: "admin",
+			Password: "password",
+			Fields: map[string]string{
+				"assignment_group":  "{{ .customfield.group }}",
+				"short_description": "{{ .result.Policy }} in {{ .namespace }}",
+			},
+			CustomFields: map[string]string{"group": "platform"},
+			HTTPClient:   server.Client(),
+		})
+		assert.Nil(t, err)
+
+		client.Send(fixtures.DefaultPolicyReport, fixtures.CompleteTargetSendResult)
+
+		record := instance.records[fixtures.CompleteTargetSendResult.GetID()]
+		assert.Equal(t, "platform", record["assignment_group"])
+		assert.Equal(t, "require-requests-and-limits-required in test", record["short_description"])
+		assert.Equal(t, "1", record["urgency"])
+		assert.Contains(t, record["description"], "Resource: default/deployment/nginx")
+	})
+	t.Run("OAuth Client Credentials", func(t *testing.T) {
+		t.Parallel()
+		instance, server := newInstance(t)
+
+		client, err := servicenow.NewClient(servicenow.Options{
+			Host:         server.URL,
+			ClientID:     "client",
+			ClientSecret: "secret",
+			HTTPClient:   server.Client(),
+		})
+		assert.Nil(t, err)
+
+		client.Send(fixtures.DefaultPolicyReport, fixtures.CompleteTargetSendResult)
+
+		assert.Len(t, instance.records, 1)
+		assert.Equal(t, "Bearer token", instance.auth[0])
+	})
+	t.Run("Invalid Template", func(t *testing.T) {
+		t.Parallel()
+		_, err := servicenow.NewClient(servicenow.Options{
+			Fields: map[string]string{"short_description": "{{ .result.Policy "},
+		})
+
+		assert.NotNil(t, err)
+	})
+	t.Run("Type", func(t *testing.T) {
+		t.Parallel()
+		client, _ := servicenow.NewClient(servicenow.Options{})
+
+		assert.Equal(t, target.SyncSend, client.Type())
+	})
+}
+
+func Test_MapPriority(t *testing.T) {
+	t.Parallel()
+	result := fixtures.CompleteTargetSendResult
+
+	result.Severity = openreports.SeverityCritical
+	assert.Equal(t, "1", servicenow.MapPriority(result))
+
+	result.Severity = openreports.SeverityMedium
+	assert.Equal(t, "2", servicenow.MapPriority(result))
+
+	result.Severity = openreports.SeverityInfo
+	assert.Equal(t, "3", servicenow.MapPriority(result))
+}
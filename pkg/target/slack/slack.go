@@ -3,7 +3,6 @@ package slack
 import (
 	"fmt"
 
-	"github.com/openreports/reports-api/apis/openreports.io/v1alpha1"
 	"github.com/slack-go/slack"
 	"go.uber.org/zap"
 
@@ -33,14 +32,6 @@ type client struct {
 	headers      map[string]string
 }
 
-var colors = map[v1alpha1.ResultSeverity]string{
-	openreports.SeverityInfo:     "#68c2ff",
-	openreports.SeverityLow:      "#36a64f",
-	openreports.SeverityMedium:   "#f2c744",
-	openreports.SeverityHigh:     "#b80707",
-	openreports.SeverityCritical: "#e20b0b",
-}
-
 func (s *client) message(result openreports.ResultAdapter) *slack.WebhookMessage {
 	p := &slack.WebhookMessage{
 		Attachments: make([]slack.Attachment, 0, 1),
@@ -52,7 +43,7 @@ func (s *client) message(result openreports.ResultAdapter) *slack.WebhookMessage
 	}
 
 	att := slack.Attachment{
-		Color: colors[result.Severity],
+		Color: formatting.SeverityColors[result.Severity],
 		Blocks: slack.Blocks{
 			BlockSet: make([]slack.Block, 0),
 		},
@@ -186,7 +177,7 @@ func (s *client) batchMessage(polr openreports.ReportInterface, results []openre
 	}
 
 	att := slack.Attachment{
-		Color: colors[openreports.SeverityInfo],
+		Color: formatting.SeverityColors[openreports.SeverityInfo],
 		Blocks: slack.Blocks{
 			BlockSet: make([]slack.Block, 0),
 		},
@@ -220,7 +211,7 @@ func (s *client) batchMessage(polr openreports.ReportInterface, results []openre
 
 	for _, result := range results {
 		resultAttachment := slack.Attachment{
-			Color: colors[result.Severity],
+			Color: formatting.SeverityColors[result.Severity],
 			Blocks: slack.Blocks{
 				BlockSet: make([]slack.Block, 0),
 			},
@@ -0,0 +1,69 @@
+package sns
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"go.uber.org/zap"
+
+	"github.com/kyverno/policy-reporter/pkg/openreports"
+	"github.com/kyverno/policy-reporter/pkg/target"
+	"github.com/kyverno/policy-reporter/pkg/target/http"
+	"github.com/kyverno/policy-reporter/pkg/target/provider/aws"
+)
+
+// Options to configure the SNS target
+type Options struct {
+	target.ClientOptions
+	CustomFields map[string]string
+	SNS          aws.MessageClient
+}
+
+type client struct {
+	target.BaseClient
+	customFields map[string]string
+	sns          aws.MessageClient
+}
+
+func (c *client) Send(report openreports.ReportInterface, result openreports.ResultAdapter) {
+	if len(c.customFields) > 0 {
+		props := make(map[string]string, 0)
+
+		for property, value := range c.customFields {
+			props[property] = value
+		}
+
+		for property, value := range result.Properties {
+			props[property] = value
+		}
+
+		result.Properties = props
+	}
+
+	body := new(bytes.Buffer)
+
+	if err := json.NewEncoder(body).Encode(http.NewJSONResult(result)); err != nil {
+		zap.L().Error("failed to encode result", zap.String("name", c.Name()), zap.Error(err))
+		return
+	}
+
+	if err := c.sns.Publish(aws.NewMessage(body, result)); err != nil {
+		zap.L().Error("sns publish error", zap.String("name", c.Name()), zap.Error(err))
+		return
+	}
+
+	zap.L().Info("PUSH OK", zap.String("name", c.Name()))
+}
+
+func (c *client) Type() target.ClientType {
+	return target.SingleSend
+}
+
+// NewClient creates a new SNS.client to publish Results to an AWS SNS topic
+func NewClient(options Options) target.Client {
+	return &client{
+		target.NewBaseClient(options.ClientOptions),
+		options.CustomFields,
+		options.SNS,
+	}
+}
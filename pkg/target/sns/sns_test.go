@@ -0,0 +1,64 @@
+package sns_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kyverno/policy-reporter/pkg/fixtures"
+	"github.com/kyverno/policy-reporter/pkg/target"
+	"github.com/kyverno/policy-reporter/pkg/target/provider/aws"
+	"github.com/kyverno/policy-reporter/pkg/target/sns"
+)
+
+type testClient struct {
+	err      error
+	callback func(message aws.Message)
+}
+
+func (c *testClient) Publish(message aws.Message) error {
+	if c.callback != nil {
+		c.callback(message)
+	}
+
+	return c.err
+}
+
+func Test_SNSTarget(t *testing.T) {
+	t.Parallel()
+	t.Run("Send", func(t *testing.T) {
+		t.Parallel()
+		callback := func(message aws.Message) {
+			assert.Contains(t, message.Body.String(), `"cluster":"name"`)
+			assert.Equal(t, "default", message.GroupID)
+			assert.Equal(t, "high", message.Attributes["severity"])
+			assert.Equal(t, "default", message.Attributes["namespace"])
+		}
+
+		client := sns.NewClient(sns.Options{
+			ClientOptions: target.ClientOptions{
+				Name: "SNS",
+			},
+			CustomFields: map[string]string{"cluster": "name"},
+			SNS:          &testClient{nil, callback},
+		})
+		client.Send(fixtures.DefaultPolicyReport, fixtures.CompleteTargetSendResult)
+
+		if len(fixtures.CompleteTargetSendResult.Properties) > 1 || fixtures.CompleteTargetSendResult.Properties["cluster"] != "" {
+			t.Error("expected customFields are not added to the actuel result")
+		}
+	})
+	t.Run("Name", func(t *testing.T) {
+		t.Parallel()
+		client := sns.NewClient(sns.Options{
+			ClientOptions: target.ClientOptions{
+				Name: "SNS",
+			},
+			SNS: &testClient{},
+		})
+
+		if client.Name() != "SNS" {
+			t.Errorf("Unexpected Name %s", client.Name())
+		}
+	})
+}
@@ -0,0 +1,69 @@
+package sqs
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"go.uber.org/zap"
+
+	"github.com/kyverno/policy-reporter/pkg/openreports"
+	"github.com/kyverno/policy-reporter/pkg/target"
+	"github.com/kyverno/policy-reporter/pkg/target/http"
+	"github.com/kyverno/policy-reporter/pkg/target/provider/aws"
+)
+
+// Options to configure the SQS target
+type Options struct {
+	target.ClientOptions
+	CustomFields map[string]string
+	SQS          aws.MessageClient
+}
+
+type client struct {
+	target.BaseClient
+	customFields map[string]string
+	sqs          aws.MessageClient
+}
+
+func (c *client) Send(report openreports.ReportInterface, result openreports.ResultAdapter) {
+	if len(c.customFields) > 0 {
+		props := make(map[string]string, 0)
+
+		for property, value := range c.customFields {
+			props[property] = value
+		}
+
+		for property, value := range result.Properties {
+			props[property] = value
+		}
+
+		result.Properties = props
+	}
+
+	body := new(bytes.Buffer)
+
+	if err := json.NewEncoder(body).Encode(http.NewJSONResult(result)); err != nil {
+		zap.L().Error("failed to encode result", zap.String("name", c.Name()), zap.Error(err))
+		return
+	}
+
+	if err := c.sqs.Publish(aws.NewMessage(body, result)); err != nil {
+		zap.L().Error("sqs send error", zap.String("name", c.Name()), zap.Error(err))
+		return
+	}
+
+	zap.L().Info("PUSH OK", zap.String("name", c.Name()))
+}
+
+func (c *client) Type() target.ClientType {
+	return target.SingleSend
+}
+
+// NewClient creates a new SQS.client to send Results to an AWS SQS queue
+func NewClient(options Options) target.Client {
+	return &client{
+		target.NewBaseClient(options.ClientOptions),
+		options.CustomFields,
+		options.SQS,
+	}
+}
@@ -0,0 +1,64 @@
+package sqs_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kyverno/policy-reporter/pkg/fixtures"
+	"github.com/kyverno/policy-reporter/pkg/target"
+	"github.com/kyverno/policy-reporter/pkg/target/provider/aws"
+	"github.com/kyverno/policy-reporter/pkg/target/sqs"
+)
+
+type testClient struct {
+	err      error
+	callback func(message aws.Message)
+}
+
+func (c *testClient) Publish(message aws.Message) error {
+	if c.callback != nil {
+		c.callback(message)
+	}
+
+	return c.err
+}
+
+func Test_SQSTarget(t *testing.T) {
+	t.Parallel()
+	t.Run("Send", func(t *testing.T) {
+		t.Parallel()
+		callback := func(message aws.Message) {
+			assert.Contains(t, message.Body.String(), `"cluster":"name"`)
+			assert.Equal(t, "default", message.GroupID)
+			assert.Equal(t, "high", message.Attributes["severity"])
+			assert.Equal(t, "default", message.Attributes["namespace"])
+		}
+
+		client := sqs.NewClient(sqs.Options{
+			ClientOptions: target.ClientOptions{
+				Name: "SQS",
+			},
+			CustomFields: map[string]string{"cluster": "name"},
+			SQS:          &testClient{nil, callback},
+		})
+		client.Send(fixtures.DefaultPolicyReport, fixtures.CompleteTargetSendResult)
+
+		if len(fixtures.CompleteTargetSendResult.Properties) > 1 || fixtures.CompleteTargetSendResult.Properties["cluster"] != "" {
+			t.Error("expected customFields are not added to the actuel result")
+		}
+	})
+	t.Run("Name", func(t *testing.T) {
+		t.Parallel()
+		client := sqs.NewClient(sqs.Options{
+			ClientOptions: target.ClientOptions{
+				Name: "SQS",
+			},
+			SQS: &testClient{},
+		})
+
+		if client.Name() != "SQS" {
+			t.Errorf("Unexpected Name %s", client.Name())
+		}
+	})
+}
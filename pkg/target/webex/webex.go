@@ -0,0 +1,236 @@
+package webex
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/openreports/reports-api/apis/openreports.io/v1alpha1"
+	"go.uber.org/zap"
+
+	targetconfig "github.com/kyverno/policy-reporter/pkg/crd/api/targetconfig/v1alpha1"
+	"github.com/kyverno/policy-reporter/pkg/helper"
+	"github.com/kyverno/policy-reporter/pkg/openreports"
+	"github.com/kyverno/policy-reporter/pkg/target"
+	"github.com/kyverno/policy-reporter/pkg/target/http"
+)
+
+const (
+	// DefaultHost of the Webex messaging API
+	DefaultHost = "https://webexapis.com"
+
+	messagesPath    = "/v1/messages"
+	cardContentType = "application/vnd.microsoft.card.adaptive"
+)
+
+// adaptive cards only support named container styles, severities are mapped
+// onto the closest style of the slack color scheme
+var styles = map[v1alpha1.ResultSeverity]string{
+	openreports.SeverityInfo:     "accent",
+	openreports.SeverityLow:      "good",
+	openreports.SeverityMedium:   "warning",
+	openreports.SeverityHigh:     "attention",
+	openreports.SeverityCritical: "attention",
+}
+
+// Options to configure the Webex target
+type Options struct {
+	target.ClientOptions
+	Host         string
+	Token        string
+	RoomID       string
+	Headers      map[string]string
+	CustomFields map[string]string
+	HTTPClient   http.Client
+	Keepalive    *targetconfig.KeepaliveConfig
+}
+
+// Payload of the Webex create message API
+type Payload struct {
+	RoomID      string       `json:"roomId"`
+	Markdown    string       `json:"markdown"`
+	Attachments []Attachment `json:"attachments,omitempty"`
+}
+
+type Attachment struct {
+	ContentType string `json:"contentType"`
+	Content     Card   `json:"content"`
+}
+
+type Card struct {
+	Schema  string      `json:"$schema"`
+	Type    string      `json:"type"`
+	Version string      `json:"version"`
+	Body    []Container `json:"body"`
+}
+
+type Container struct {
+	Type  string `json:"type"`
+	Style string `json:"style,omitempty"`
+	Bleed bool   `json:"bleed,omitempty"`
+	Items []any  `json:"items"`
+}
+
+type TextBlock struct {
+	Type   string `json:"type"`
+	Text   string `json:"text"`
+	Size   string `json:"size,omitempty"`
+	Weight string `json:"weight,omitempty"`
+	Wrap   bool   `json:"wrap"`
+}
+
+type FactSet struct {
+	Type  string `json:"type"`
+	Facts []Fact `json:"facts"`
+}
+
+type Fact struct {
+	Title string `json:"title"`
+	Value string `json:"value"`
+}
+
+// Style returns the adaptive card container style for the given severity
+func Style(severity v1alpha1.ResultSeverity) string {
+	if style, ok := styles[severity]; ok {
+		return style
+	}
+
+	return styles[openreports.SeverityInfo]
+}
+
+type client struct {
+	target.BaseClient
+	host         string
+	token        string
+	roomID       string
+	headers      map[string]string
+	customFields map[string]string
+	client       http.Client
+	keepalive    *targetconfig.KeepaliveConfig
+}
+
+func (w *client) newPayload(result openreports.ResultAdapter) Payload {
+	facts := []Fact{{"Policy", result.Policy}}
+
+	if result.Rule != "" {
+		facts = append(facts, Fact{"Rule", result.Rule})
+	}
+	facts = append(facts, Fact{"Status", string(result.Result)})
+	if result.Severity != "" {
+		facts = append(facts, Fact{"Severity", string(result.Severity)})
+	}
+	if result.Category != "" {
+		facts = append(facts, Fact{"Category", result.Category})
+	}
+	if result.Source != "" {
+		facts = append(facts, Fact{"Source", result.Source})
+	}
+	if result.HasResource() {
+		facts = append(facts, Fact{"Resource", result.ResourceString()})
+	}
+
+	for _, property := range slices.Sorted(maps.Keys(result.Properties)) {
+		facts = append(facts, Fact{helper.Title(property), result.Properties[property]})
+	}
+	for _, property := range slices.Sorted(maps.Keys(w.customFields)) {
+		facts = append(facts, Fact{helper.Title(property), w.customFields[property]})
+	}
+
+	markdown := strings.Builder{}
+	fmt.Fprintf(&markdown, "**[Policy Reporter] [%s] %s**", result.Severity, helper.Defaults(result.Policy, result.Rule))
+	if result.HasResource() {
+		fmt.Fprintf(&markdown, "\n\n**Resource**: %s", result.ResourceString())
+	}
+	if result.Description != "" {
+		fmt.Fprintf(&markdown, "\n\n%s", result.Description)
+	}
+
+	return Payload{
+		RoomID:   w.roomID,
+		Markdown: markdown.String(),
+		Attachments: []Attachment{newCard(
+			Style(result.Severity),
+			"New Policy Report Result",
+			result.Description,
+			facts,
+		)},
+	}
+}
+
+func (w *client) Send(report openreports.ReportInterface, result openreports.ResultAdapter) {
+	w.post(w.newPayload(result), w.Name())
+}
+
+func (w *client) SendHeartbeat() {
+	facts := make([]Fact, 0)
+	if w.keepalive != nil {
+		for _, k := range slices.Sorted(maps.Keys(w.keepalive.Params)) {
+			facts = append(facts, Fact{helper.Title(k), w.keepalive.Params[k]})
+		}
+	}
+
+	timestamp := time.Now().Format(time.RFC3339)
+
+	w.post(Payload{
+		RoomID:      w.roomID,
+		Markdown:    "**Policy Reporter Heartbeat**: " + timestamp,
+		Attachments: []Attachment{newCard(Style(""), "Policy Reporter Heartbeat", timestamp, facts)},
+	}, w.Name()+"-heartbeat")
+}
+
+func (w *client) post(payload Payload, name string) {
+	req, err := http.CreateJSONRequest("POST", w.host+messagesPath, payload)
+	if err != nil {
+		zap.L().Error(name+": PUSH FAILED", zap.Error(err))
+		return
+	}
+
+	for header, value := range w.headers {
+		req.Header.Set(header, value)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+w.token)
+
+	resp, err := w.client.Do(req)
+	http.ProcessHTTPResponse(name, resp, err)
+}
+
+func (w *client) Type() target.ClientType {
+	return target.SingleSend
+}
+
+func newCard(style, title, text string, facts []Fact) Attachment {
+	items := []any{TextBlock{Type: "TextBlock", Text: title, Size: "Medium", Weight: "Bolder", Wrap: true}}
+	if text != "" {
+		items = append(items, TextBlock{Type: "TextBlock", Text: text, Wrap: true})
+	}
+
+	return Attachment{
+		ContentType: cardContentType,
+		Content: Card{
+			Schema:  "http://adaptivecards.io/schemas/adaptive-card.json",
+			Type:    "AdaptiveCard",
+			Version: "1.3",
+			Body: []Container{
+				{Type: "Container", Style: style, Bleed: true, Items: items},
+				{Type: "Container", Items: []any{FactSet{Type: "FactSet", Facts: facts}}},
+			},
+		},
+	}
+}
+
+// NewClient creates a new webex.client to send Results as cards into a Webex room
+func NewClient(options Options) target.Client {
+	return &client{
+		BaseClient:   target.NewBaseClient(options.ClientOptions),
+		host:         strings.TrimSuffix(helper.Defaults(options.Host, DefaultHost), "/"),
+		token:        options.Token,
+		roomID:       options.RoomID,
+		headers:      options.Headers,
+		customFields: options.CustomFields,
+		client:       options.HTTPClient,
+		keepalive:    options.Keepalive,
+	}
+}
@@ -0,0 +1,101 @@
+package webex_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kyverno/policy-reporter/pkg/crd/api/targetconfig/v1alpha1"
+	"github.com/kyverno/policy-reporter/pkg/fixtures"
+	"github.com/kyverno/policy-reporter/pkg/openreports"
+	"github.com/kyverno/policy-reporter/pkg/target"
+	"github.com/kyverno/policy-reporter/pkg/target/webex"
+)
+
+type testClient struct {
+	callback   func(req *http.Request)
+	statusCode int
+}
+
+func (c testClient) Do(req *http.Request) (*http.Response, error) {
+	c.callback(req)
+
+	return &http.Response{
+		StatusCode: c.statusCode,
+	}, nil
+}
+
+func parsePayload(t *testing.T, req *http.Request) map[string]any {
+	payload := map[string]any{}
+	if err := json.NewDecoder(req.Body).Decode(&payload); err != nil {
+		t.Fatal(err)
+	}
+
+	return payload
+}
+
+func Test_WebexTarget(t *testing.T) {
+	t.Parallel()
+	t.Run("Send", func(t *testing.T) {
+		t.Parallel()
+		callback := func(req *http.Request) {
+			assert.Equal(t, "https://webexapis.com/v1/messages", req.URL.String())
+			assert.Equal(t, "Bearer token", req.Header.Get("Authorization"))
+
+			payload := parsePayload(t, req)
+			assert.Equal(t, "room", payload["roomId"])
+			assert.Contains(t, payload["markdown"], "require-requests-and-limits-required")
+
+			attachment := payload["attachments"].([]any)[0].(map[string]any)
+			assert.Equal(t, "application/vnd.microsoft.card.adaptive", attachment["contentType"])
+
+			header := attachment["content"].(map[string]any)["body"].([]any)[0].(map[string]any)
+			assert.Equal(t, "attention", header["style"])
+		}
+
+		client := webex.NewClient(webex.Options{
+			ClientOptions: target.ClientOptions{
+				Name: "Webex",
+			},
+			Token:      "token",
+			RoomID:     "room",
+			HTTPClient: testClient{callback, 200},
+		})
+		client.Send(fixtures.DefaultPolicyReport, fixtures.CompleteTargetSendResult)
+	})
+	t.Run("SendHeartbeat", func(t *testing.T) {
+		t.Parallel()
+		callback := func(req *http.Request) {
+			assert.Equal(t, "https://webex.example.com/v1/messages", req.URL.String())
+
+			payload := parsePayload(t, req)
+			assert.Contains(t, payload["markdown"], "Policy Reporter Heartbeat")
+		}
+
+		client := webex.NewClient(webex.Options{
+			Host:       "https://webex.example.com/",
+			Token:      "token",
+			RoomID:     "room",
+			Keepalive:  &v1alpha1.KeepaliveConfig{Interval: "5m", Params: map[string]string{"cluster": "prod"}},
+			HTTPClient: testClient{callback, 200},
+		})
+		client.SendHeartbeat()
+	})
+	t.Run("Type", func(t *testing.T) {
+		t.Parallel()
+		client := webex.NewClient(webex.Options{})
+
+		assert.Equal(t, target.SingleSend, client.Type())
+	})
+}
+
+func Test_Style(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "accent", webex.Style(openreports.SeverityInfo))
+	assert.Equal(t, "good", webex.Style(openreports.SeverityLow))
+	assert.Equal(t, "warning", webex.Style(openreports.SeverityMedium))
+	assert.Equal(t, "attention", webex.Style(openreports.SeverityCritical))
+	assert.Equal(t, "accent", webex.Style(""))
+}
@@ -14,6 +14,8 @@ import (
 	informer "github.com/kyverno/policy-reporter/pkg/crd/client/informers/externalversions"
 	"github.com/kyverno/policy-reporter/pkg/listener"
 	"github.com/kyverno/policy-reporter/pkg/openreports"
+	"github.com/kyverno/policy-reporter/pkg/routing"
+	"github.com/kyverno/policy-reporter/pkg/silence"
 	"github.com/kyverno/policy-reporter/pkg/target"
 )
 
@@ -23,6 +25,8 @@ type Client struct {
 	informer       cache.SharedIndexInformer
 	orClient       reports.OpenreportsV1alpha1Interface
 	wgpolicyClient v1alpha2.Wgpolicyk8sV1alpha2Interface
+	router         *routing.Router
+	silences       *silence.Store
 }
 
 func (c *Client) ConfigureInformer() {
@@ -61,7 +65,7 @@ func (c *Client) ConfigureInformer() {
 
 				switch t.Client.Type() {
 				case target.SingleSend:
-					listener := listener.NewSendResultListener(target.NewCollection(t))
+					listener := listener.NewSendResultListener(target.NewCollection(t), c.router, c.silences)
 					for _, polr := range reports {
 						for _, res := range polr.GetResults() {
 							listener(polr, res, false)
@@ -69,13 +73,13 @@ func (c *Client) ConfigureInformer() {
 					}
 
 				case target.BatchSend:
-					listener := listener.NewSendScopeResultsListener(target.NewCollection(t))
+					listener := listener.NewSendScopeResultsListener(target.NewCollection(t), c.router, c.silences)
 					for _, polr := range reports {
 						listener(polr, polr.GetResults(), false)
 					}
 
 				case target.SyncSend:
-					listener := listener.NewSendSyncResultsListener(target.NewCollection(t))
+					listener := listener.NewSendSyncResultsListener(target.NewCollection(t), c.silences)
 					for _, polr := range reports {
 						listener(polr)
 					}
@@ -173,6 +177,7 @@ func (c *Client) Run(stopChan chan struct{}) {
 
 func NewClient(tcClient crds.Interface, f target.Factory, targets *target.Collection,
 	orClient reports.OpenreportsV1alpha1Interface, wgpolicyClient v1alpha2.Wgpolicyk8sV1alpha2Interface,
+	router *routing.Router, silences *silence.Store,
 ) *Client {
 	tcInformer := informer.NewSharedInformerFactory(tcClient, 0)
 	return &Client{
@@ -181,5 +186,7 @@ func NewClient(tcClient crds.Interface, f target.Factory, targets *target.Collec
 		collection:     targets,
 		orClient:       orClient,
 		wgpolicyClient: wgpolicyClient,
+		router:         router,
+		silences:       silences,
 	}
 }
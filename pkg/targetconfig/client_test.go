@@ -62,9 +62,9 @@ func Test_TargetConfig_TargetCreation(t *testing.T) {
 
 	kclient, tclient := NewFakeClient()
 	collection := target.NewCollection()
-	factory := factory.NewFactory(secrets.NewClient(newSecretClient()), target.NewResultFilterFactory(nil))
+	factory := factory.NewFactory(secrets.NewClient(newSecretClient()), target.NewResultFilterFactory(nil, nil))
 
-	client := targetconfig.NewClient(kclient, factory, collection, nil, nil)
+	client := targetconfig.NewClient(kclient, factory, collection, nil, nil, nil, nil)
 	client.ConfigureInformer()
 
 	go func() {
@@ -106,9 +106,9 @@ func Test_TargetConfig_TargetUpdates(t *testing.T) {
 
 	kclient, tclient := NewFakeClient()
 	collection := target.NewCollection()
-	factory := factory.NewFactory(secrets.NewClient(newSecretClient()), target.NewResultFilterFactory(nil))
+	factory := factory.NewFactory(secrets.NewClient(newSecretClient()), target.NewResultFilterFactory(nil, nil))
 
-	client := targetconfig.NewClient(kclient, factory, collection, nil, nil)
+	client := targetconfig.NewClient(kclient, factory, collection, nil, nil, nil, nil)
 	client.ConfigureInformer()
 
 	go func() {
@@ -165,9 +165,9 @@ func Test_TargetConfig_TargetDeletion(t *testing.T) {
 
 	kclient, tclient := NewFakeClient()
 	collection := target.NewCollection()
-	factory := factory.NewFactory(secrets.NewClient(newSecretClient()), target.NewResultFilterFactory(nil))
+	factory := factory.NewFactory(secrets.NewClient(newSecretClient()), target.NewResultFilterFactory(nil, nil))
 
-	client := targetconfig.NewClient(kclient, factory, collection, nil, nil)
+	client := targetconfig.NewClient(kclient, factory, collection, nil, nil, nil, nil)
 	client.ConfigureInformer()
 
 	go func() {
@@ -1,6 +1,8 @@
 package validate
 
 import (
+	"strings"
+
 	"github.com/kyverno/go-wildcard"
 
 	"github.com/kyverno/policy-reporter/pkg/helper"
@@ -51,3 +53,35 @@ func ContainsRuleSet(value string, rules RuleSets) bool {
 
 	return true
 }
+
+// Labels validates a label map against "key:value" rules, the value supports wildcards and defaults to "*"
+func Labels(labels map[string]string, rules RuleSets) bool {
+	if len(rules.Include) > 0 {
+		for _, rule := range rules.Include {
+			if matchLabel(labels, rule) {
+				return true
+			}
+		}
+
+		return false
+	} else if len(rules.Exclude) > 0 {
+		for _, rule := range rules.Exclude {
+			if matchLabel(labels, rule) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func matchLabel(labels map[string]string, rule string) bool {
+	parts := strings.SplitN(rule, ":", 2)
+	if len(parts) == 1 {
+		parts = append(parts, "*")
+	}
+
+	value, ok := labels[strings.TrimSpace(parts[0])]
+
+	return ok && wildcard.Match(strings.TrimSpace(parts[1]), value)
+}
@@ -103,6 +103,30 @@ func Test_Validations(t *testing.T) {
 			t.Errorf("Unexpected Validation Result")
 		}
 	})
+	t.Run("Labels Include Rule match", func(t *testing.T) {
+		t.Parallel()
+		if !validate.Labels(map[string]string{"app": "nginx"}, validate.RuleSets{Include: []string{"app:ngi*"}}) {
+			t.Errorf("Unexpected Validation Result")
+		}
+	})
+	t.Run("Labels Include Rule mismatch", func(t *testing.T) {
+		t.Parallel()
+		if validate.Labels(map[string]string{}, validate.RuleSets{Include: []string{"app"}}) {
+			t.Errorf("Unexpected Validation Result")
+		}
+	})
+	t.Run("Labels Exclude Rule match", func(t *testing.T) {
+		t.Parallel()
+		if validate.Labels(map[string]string{"policy-reporter.io/ignore": "true"}, validate.RuleSets{Exclude: []string{"policy-reporter.io/ignore:true"}}) {
+			t.Errorf("Unexpected Validation Result")
+		}
+	})
+	t.Run("Labels Exclude Rule mismatch", func(t *testing.T) {
+		t.Parallel()
+		if !validate.Labels(map[string]string{"policy-reporter.io/ignore": "false"}, validate.RuleSets{Exclude: []string{"policy-reporter.io/ignore:true"}}) {
+			t.Errorf("Unexpected Validation Result")
+		}
+	})
 }
 
 func Test_RulesCount(t *testing.T) {